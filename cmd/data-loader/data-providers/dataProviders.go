@@ -7,6 +7,15 @@ import (
 	"gofr.dev/pkg/gofr"
 )
 
+const (
+	InstrumentTypeEquity = "Equity"
+	InstrumentTypeETF    = "ETF"
+	InstrumentTypeIndex  = "Index"
+	InstrumentTypeBond   = "Bond"
+	InstrumentTypeGold   = "Gold"
+	InstrumentTypeSilver = "Silver"
+)
+
 const (
 	DhanHQ Provider = iota
 	SmartAPI
@@ -14,20 +23,39 @@ const (
 )
 
 type DataProvider interface {
-	LTP(ctx *gofr.Context, isin string) (*LTPData, error)
-	LTPBulk(ctx *gofr.Context, isins []string) ([]*LTPData, error)
-	OHLC(ctx *gofr.Context, isin string) (*OHLCData, error)
-	OHLCBulk(ctx *gofr.Context, isins []string) ([]*OHLCData, error)
-	HistoricalOHLC(ctx *gofr.Context, isin string, startDate, endDate time.Time) ([]*HistoricalOHLC, error)
+	LTP(ctx *gofr.Context, instrument *Instrument) (*LTPData, error)
+	LTPBulk(ctx *gofr.Context, instruments []*Instrument) ([]*LTPData, error)
+	OHLC(ctx *gofr.Context, instrument *Instrument) (*OHLCData, error)
+	OHLCBulk(ctx *gofr.Context, instruments []*Instrument) ([]*OHLCData, error)
+	HistoricalOHLC(ctx *gofr.Context, instrument *Instrument, startDate, endDate time.Time) ([]*HistoricalOHLC, error)
+	Tradability(ctx *gofr.Context, instrument *Instrument) (*TradabilityData, error)
+}
+
+// Instrument identifies a security to the provider, indices have no ISIN and are identified by their index symbol instead.
+type Instrument struct {
+	ISIN   string
+	Symbol string
+	Type   string
+}
+
+// Key is the ISIN of the instrument, or its symbol for an index.
+func (i *Instrument) Key() string {
+	if i.Type == InstrumentTypeIndex {
+		return i.Symbol
+	}
+
+	return i.ISIN
 }
 
 type LTPData struct {
-	ISIN string
-	LTP  float64
+	ISIN   string
+	Symbol string
+	LTP    float64
 }
 
 type OHLCData struct {
 	ISIN   string
+	Symbol string
 	Open   float64
 	High   float64
 	Low    float64
@@ -35,6 +63,15 @@ type OHLCData struct {
 	Volume int
 }
 
+type TradabilityData struct {
+	ISIN                 string
+	LotSize              int
+	TickSize             float64
+	SurveillanceFlag     string
+	SurveillanceCategory string
+	MTFLeverage          float64
+}
+
 type HistoricalOHLC struct {
 	Date time.Time
 	*OHLCData
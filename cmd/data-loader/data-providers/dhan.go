@@ -19,13 +19,33 @@ import (
 //go:embed dhan-scrip-master.csv
 var dhanMasterScrip string
 
+//go:embed dhan-index-master.csv
+var dhanIndexMaster string
+
+type dhanSegment struct {
+	exchangeSegment string
+	instrument      string
+}
+
+// dhanSegments routes instrument types onto dhan exchange segments, exchange traded funds over any asset trade as NSE equities.
+var dhanSegments = map[string]dhanSegment{
+	InstrumentTypeEquity: {exchangeSegment: "NSE_EQ", instrument: "EQUITY"},
+	InstrumentTypeETF:    {exchangeSegment: "NSE_EQ", instrument: "EQUITY"},
+	InstrumentTypeBond:   {exchangeSegment: "NSE_EQ", instrument: "EQUITY"},
+	InstrumentTypeGold:   {exchangeSegment: "NSE_EQ", instrument: "EQUITY"},
+	InstrumentTypeSilver: {exchangeSegment: "NSE_EQ", instrument: "EQUITY"},
+	InstrumentTypeIndex:  {exchangeSegment: "IDX_I", instrument: "INDEX"},
+}
+
 type client struct {
-	apiKey                string
-	clientID              string
-	isinSecurityIDMapping map[string]int
-	securityIDISINMapping map[int]string
-	lastAPICallTime       time.Time
-	mu                    *sync.Mutex
+	apiKey                 string
+	clientID               string
+	isinSecurityIDMapping  map[string]int
+	securityIDISINMapping  map[int]string
+	indexSecurityIDMapping map[string]int
+	isinTradability        map[string]*TradabilityData
+	lastAPICallTime        time.Time
+	mu                     *sync.Mutex
 }
 
 func NewDhanHQClient(app *gofr.App) (*client, error) {
@@ -46,6 +66,7 @@ func NewDhanHQClient(app *gofr.App) (*client, error) {
 
 	isinSecurityIDMapping := make(map[string]int)
 	securityIDISINMapping := make(map[int]string)
+	isinTradability := make(map[string]*TradabilityData)
 	headers := records[0]
 
 	for _, row := range records[1:] {
@@ -55,31 +76,88 @@ func NewDhanHQClient(app *gofr.App) (*client, error) {
 
 		isinSecurityIDMapping[isin] = securityID
 		securityIDISINMapping[securityID] = isin
+		isinTradability[isin] = buildTradability(isin, headers, row)
+	}
+
+	records, err = csv.NewReader(strings.NewReader(dhanIndexMaster)).ReadAll()
+	if err != nil {
+		return nil, errors.New("failed to read dhan index master")
+	}
+
+	indexSecurityIDMapping := make(map[string]int)
+	headers = records[0]
+
+	for _, row := range records[1:] {
+		securityID, _ := strconv.Atoi(row[slices.Index(headers, "SECURITY_ID")])
+
+		indexSecurityIDMapping[row[slices.Index(headers, "SYMBOL_NAME")]] = securityID
 	}
 
 	app.AddHTTPService("dhan-api", "https://api.dhan.co")
 
 	return &client{
-		apiKey:                apiKey,
-		clientID:              clientID,
-		isinSecurityIDMapping: isinSecurityIDMapping,
-		securityIDISINMapping: securityIDISINMapping,
-		lastAPICallTime:       time.Date(2001, 1, 1, 1, 1, 1, 1, time.UTC),
-		mu:                    &sync.Mutex{},
+		apiKey:                 apiKey,
+		clientID:               clientID,
+		isinSecurityIDMapping:  isinSecurityIDMapping,
+		securityIDISINMapping:  securityIDISINMapping,
+		indexSecurityIDMapping: indexSecurityIDMapping,
+		isinTradability:        isinTradability,
+		lastAPICallTime:        time.Date(2001, 1, 1, 1, 1, 1, 1, time.UTC),
+		mu:                     &sync.Mutex{},
 	}, nil
 }
 
-func (c *client) LTP(ctx *gofr.Context, isin string) (*LTPData, error) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+func (c *client) Tradability(_ *gofr.Context, instrument *Instrument) (*TradabilityData, error) {
+	if instrument.Type == InstrumentTypeIndex {
+		return nil, errors.New("indices are not traded")
+	}
+
+	tradability, ok := c.isinTradability[instrument.ISIN]
+	if !ok {
+		return nil, errors.New("isin not found in dhan master scrip")
+	}
+
+	return tradability, nil
+}
+
+// buildTradability reads the trading attributes of a scrip master row, the master quotes tick size in paise and
+// uses NA for a missing surveillance category.
+func buildTradability(isin string, headers, row []string) *TradabilityData {
+	lotSize, _ := strconv.ParseFloat(row[slices.Index(headers, "LOT_SIZE")], 64)
+	tickSize, _ := strconv.ParseFloat(row[slices.Index(headers, "TICK_SIZE")], 64)
+	mtfLeverage, _ := strconv.ParseFloat(row[slices.Index(headers, "MTF_LEVERAGE")], 64)
+
+	category := row[slices.Index(headers, "ASM_GSM_CATEGORY")]
+	if category == "NA" {
+		category = ""
+	}
+
+	return &TradabilityData{
+		ISIN:                 isin,
+		LotSize:              int(lotSize),
+		TickSize:             tickSize / 100,
+		SurveillanceFlag:     row[slices.Index(headers, "ASM_GSM_FLAG")],
+		SurveillanceCategory: category,
+		MTFLeverage:          mtfLeverage,
+	}
+}
+
+func (c *client) LTP(ctx *gofr.Context, instrument *Instrument) (*LTPData, error) {
+	segment, securityID, err := c.resolve(instrument)
+	if err != nil {
+		return nil, err
+	}
 
 	payload := map[string][]int{
-		"NSE_EQ": {c.isinSecurityIDMapping[isin]},
+		segment.exchangeSegment: {securityID},
 	}
 
 	body, _ := json.Marshal(payload)
 	headers := map[string]string{"Content-Type": "application/json", "access-token": c.apiKey, "client-id": c.clientID}
 
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	if time.Now().UTC().Sub(c.lastAPICallTime) <= time.Second {
 		time.Sleep(time.Second)
 	}
@@ -100,10 +178,8 @@ func (c *client) LTP(ctx *gofr.Context, isin string) (*LTPData, error) {
 	}
 
 	var res struct {
-		Data struct {
-			NseEQ map[string]struct {
-				LTP float64 `json:"last_price"`
-			} `json:"NSE_EQ"`
+		Data map[string]map[string]struct {
+			LTP float64 `json:"last_price"`
 		} `json:"data"`
 	}
 
@@ -113,23 +189,18 @@ func (c *client) LTP(ctx *gofr.Context, isin string) (*LTPData, error) {
 	}
 
 	return &LTPData{
-		ISIN: isin,
-		LTP:  res.Data.NseEQ[strconv.Itoa(c.isinSecurityIDMapping[isin])].LTP,
+		ISIN:   instrument.ISIN,
+		Symbol: instrument.Symbol,
+		LTP:    res.Data[segment.exchangeSegment][strconv.Itoa(securityID)].LTP,
 	}, nil
 }
 
-func (c *client) LTPBulk(ctx *gofr.Context, isins []string) ([]*LTPData, error) {
-	if len(isins) > 1000 {
+func (c *client) LTPBulk(ctx *gofr.Context, instruments []*Instrument) ([]*LTPData, error) {
+	if len(instruments) > 1000 {
 		return nil, errors.New("max limit is 1000 for bulk ltp fetch")
 	}
 
-	payload := map[string][]int{
-		"NSE_EQ": make([]int, len(isins)),
-	}
-
-	for i := range isins {
-		payload["NSE_EQ"][i] = c.isinSecurityIDMapping[isins[i]]
-	}
+	payload, segments, securityIDs := c.buildBulkPayload(ctx, instruments)
 
 	body, _ := json.Marshal(payload)
 	headers := map[string]string{"Content-Type": "application/json", "access-token": c.apiKey, "client-id": c.clientID}
@@ -157,10 +228,8 @@ func (c *client) LTPBulk(ctx *gofr.Context, isins []string) ([]*LTPData, error)
 	}
 
 	var res struct {
-		Data struct {
-			NseEQ map[string]struct {
-				LTP float64 `json:"last_price"`
-			} `json:"NSE_EQ"`
+		Data map[string]map[string]struct {
+			LTP float64 `json:"last_price"`
 		} `json:"data"`
 	}
 
@@ -171,27 +240,33 @@ func (c *client) LTPBulk(ctx *gofr.Context, isins []string) ([]*LTPData, error)
 
 	var ltpData []*LTPData
 
-	for i := range isins {
-		securityID := c.isinSecurityIDMapping[isins[i]]
+	for i := range instruments {
+		key := instruments[i].Key()
 
-		data, ok := res.Data.NseEQ[strconv.Itoa(securityID)]
+		data, ok := res.Data[segments[key]][strconv.Itoa(securityIDs[key])]
 		if !ok {
-			ctx.Warnf(fmt.Sprintf("missing data for %s, POST /v2/marketfeed/ltp", isins[i]))
+			ctx.Warnf(fmt.Sprintf("missing data for %s, POST /v2/marketfeed/ltp", key))
 			continue
 		}
 
 		ltpData = append(ltpData, &LTPData{
-			ISIN: isins[i],
-			LTP:  data.LTP,
+			ISIN:   instruments[i].ISIN,
+			Symbol: instruments[i].Symbol,
+			LTP:    data.LTP,
 		})
 	}
 
 	return ltpData, nil
 }
 
-func (c *client) OHLC(ctx *gofr.Context, isin string) (*OHLCData, error) {
+func (c *client) OHLC(ctx *gofr.Context, instrument *Instrument) (*OHLCData, error) {
+	segment, securityID, err := c.resolve(instrument)
+	if err != nil {
+		return nil, err
+	}
+
 	payload := map[string][]int{
-		"NSE_EQ": {c.isinSecurityIDMapping[isin]},
+		segment.exchangeSegment: {securityID},
 	}
 
 	body, _ := json.Marshal(payload)
@@ -220,16 +295,14 @@ func (c *client) OHLC(ctx *gofr.Context, isin string) (*OHLCData, error) {
 	}
 
 	var res struct {
-		Data struct {
-			NseEQ map[string]struct {
-				Volume int `json:"volume"`
-				Ohlc   struct {
-					Open  float64 `json:"open"`
-					High  float64 `json:"high"`
-					Low   float64 `json:"low"`
-					Close float64 `json:"close"`
-				} `json:"ohlc"`
-			} `json:"NSE_EQ"`
+		Data map[string]map[string]struct {
+			Volume int `json:"volume"`
+			Ohlc   struct {
+				Open  float64 `json:"open"`
+				High  float64 `json:"high"`
+				Low   float64 `json:"low"`
+				Close float64 `json:"close"`
+			} `json:"ohlc"`
 		} `json:"data"`
 	}
 
@@ -238,13 +311,14 @@ func (c *client) OHLC(ctx *gofr.Context, isin string) (*OHLCData, error) {
 		return nil, errors.New("unexpected resp POST /v2/marketfeed/quote, err: " + err.Error())
 	}
 
-	stats, ok := res.Data.NseEQ[strconv.Itoa(c.isinSecurityIDMapping[isin])]
+	stats, ok := res.Data[segment.exchangeSegment][strconv.Itoa(securityID)]
 	if !ok {
-		return nil, errors.New("missing ohlc data /v2/marketfeed/quote, err: " + err.Error())
+		return nil, errors.New("missing ohlc data /v2/marketfeed/quote for " + instrument.Key())
 	}
 
 	return &OHLCData{
-		ISIN:   isin,
+		ISIN:   instrument.ISIN,
+		Symbol: instrument.Symbol,
 		Open:   stats.Ohlc.Open,
 		High:   stats.Ohlc.High,
 		Low:    stats.Ohlc.Low,
@@ -253,18 +327,12 @@ func (c *client) OHLC(ctx *gofr.Context, isin string) (*OHLCData, error) {
 	}, nil
 }
 
-func (c *client) OHLCBulk(ctx *gofr.Context, isins []string) ([]*OHLCData, error) {
-	if len(isins) > 1000 {
+func (c *client) OHLCBulk(ctx *gofr.Context, instruments []*Instrument) ([]*OHLCData, error) {
+	if len(instruments) > 1000 {
 		return nil, errors.New("max limit is 1000 for bulk ltp fetch")
 	}
 
-	payload := map[string][]int{
-		"NSE_EQ": make([]int, len(isins)),
-	}
-
-	for i := range isins {
-		payload["NSE_EQ"][i] = c.isinSecurityIDMapping[isins[i]]
-	}
+	payload, segments, securityIDs := c.buildBulkPayload(ctx, instruments)
 
 	body, _ := json.Marshal(payload)
 	headers := map[string]string{"Content-Type": "application/json", "access-token": c.apiKey, "client-id": c.clientID}
@@ -292,16 +360,14 @@ func (c *client) OHLCBulk(ctx *gofr.Context, isins []string) ([]*OHLCData, error
 	}
 
 	var res struct {
-		Data struct {
-			NseEQ map[string]struct {
-				Volume float64 `json:"volume"`
-				Ohlc   struct {
-					Open  float64 `json:"open"`
-					High  float64 `json:"high"`
-					Low   float64 `json:"low"`
-					Close float64 `json:"close"`
-				} `json:"ohlc"`
-			} `json:"NSE_EQ"`
+		Data map[string]map[string]struct {
+			Volume float64 `json:"volume"`
+			Ohlc   struct {
+				Open  float64 `json:"open"`
+				High  float64 `json:"high"`
+				Low   float64 `json:"low"`
+				Close float64 `json:"close"`
+			} `json:"ohlc"`
 		} `json:"data"`
 	}
 
@@ -312,17 +378,18 @@ func (c *client) OHLCBulk(ctx *gofr.Context, isins []string) ([]*OHLCData, error
 
 	var ohlcData []*OHLCData
 
-	for i := range isins {
-		securityID := c.isinSecurityIDMapping[isins[i]]
+	for i := range instruments {
+		key := instruments[i].Key()
 
-		data, ok := res.Data.NseEQ[strconv.Itoa(securityID)]
+		data, ok := res.Data[segments[key]][strconv.Itoa(securityIDs[key])]
 		if !ok {
-			ctx.Warnf(fmt.Sprintf("missing data for %s, POST /v2/marketfeed/quote", isins[i]))
+			ctx.Warnf(fmt.Sprintf("missing data for %s, POST /v2/marketfeed/quote", key))
 			continue
 		}
 
 		ohlcData = append(ohlcData, &OHLCData{
-			ISIN:   isins[i],
+			ISIN:   instruments[i].ISIN,
+			Symbol: instruments[i].Symbol,
 			Open:   data.Ohlc.Open,
 			High:   data.Ohlc.High,
 			Low:    data.Ohlc.Low,
@@ -334,11 +401,16 @@ func (c *client) OHLCBulk(ctx *gofr.Context, isins []string) ([]*OHLCData, error
 	return ohlcData, nil
 }
 
-func (c *client) HistoricalOHLC(ctx *gofr.Context, isin string, startDate, endDate time.Time) ([]*HistoricalOHLC, error) {
+func (c *client) HistoricalOHLC(ctx *gofr.Context, instrument *Instrument, startDate, endDate time.Time) ([]*HistoricalOHLC, error) {
+	segment, securityID, err := c.resolve(instrument)
+	if err != nil {
+		return nil, err
+	}
+
 	payload := map[string]any{
-		"securityId":      c.isinSecurityIDMapping[isin],
-		"exchangeSegment": "NSE_EQ",
-		"instrument":      "EQUITY",
+		"securityId":      securityID,
+		"exchangeSegment": segment.exchangeSegment,
+		"instrument":      segment.instrument,
 		"expiryCode":      0,
 		"oi":              false,
 		"fromDate":        startDate.Format(time.DateOnly),
@@ -392,7 +464,8 @@ func (c *client) HistoricalOHLC(ctx *gofr.Context, isin string, startDate, endDa
 		historicalData[i] = &HistoricalOHLC{
 			Date: time.Unix(int64(res.Timestamp[i]), 0).In(istLocation),
 			OHLCData: &OHLCData{
-				ISIN:   isin,
+				ISIN:   instrument.ISIN,
+				Symbol: instrument.Symbol,
 				Open:   res.Open[i],
 				High:   res.High[i],
 				Low:    res.Low[i],
@@ -404,3 +477,43 @@ func (c *client) HistoricalOHLC(ctx *gofr.Context, isin string, startDate, endDa
 
 	return historicalData, nil
 }
+
+func (c *client) resolve(instrument *Instrument) (dhanSegment, int, error) {
+	segment, ok := dhanSegments[instrument.Type]
+	if !ok {
+		return dhanSegment{}, 0, errors.New("unsupported instrument type - " + instrument.Type)
+	}
+
+	mapping := c.isinSecurityIDMapping
+	if instrument.Type == InstrumentTypeIndex {
+		mapping = c.indexSecurityIDMapping
+	}
+
+	securityID, ok := mapping[instrument.Key()]
+	if !ok {
+		return dhanSegment{}, 0, errors.New("instrument not found in dhan master - " + instrument.Key())
+	}
+
+	return segment, securityID, nil
+}
+
+// buildBulkPayload groups instruments by exchange segment for the marketfeed APIs, which answer keyed by segment and security id.
+func (c *client) buildBulkPayload(ctx *gofr.Context, instruments []*Instrument) (map[string][]int, map[string]string, map[string]int) {
+	payload := make(map[string][]int)
+	segments := make(map[string]string)
+	securityIDs := make(map[string]int)
+
+	for i := range instruments {
+		segment, securityID, err := c.resolve(instruments[i])
+		if err != nil {
+			ctx.Warnf(err.Error())
+			continue
+		}
+
+		payload[segment.exchangeSegment] = append(payload[segment.exchangeSegment], securityID)
+		segments[instruments[i].Key()] = segment.exchangeSegment
+		securityIDs[instruments[i].Key()] = securityID
+	}
+
+	return payload, segments, securityIDs
+}
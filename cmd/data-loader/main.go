@@ -8,12 +8,14 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"os"
 	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/service"
 
 	dataProviders "github.com/stratifyr/security-service/cmd/data-loader/data-providers"
 )
@@ -27,10 +29,17 @@ var metricsMaster string
 //go:embed data/market-holidays.csv
 var marketHolidaysMaster string
 
+//go:embed data/index-constituents.csv
+var indexConstituentsMaster string
+
+// bulkUpsertBatchSize keeps each bulk request well under the limit the security-service accepts.
+const bulkUpsertBatchSize = 1000
+
 func main() {
 	app := gofr.NewCMD()
 
-	app.AddHTTPService("security-service", app.Config.Get("SECURITY_SERVICE_HOST"))
+	app.AddHTTPService("security-service", app.Config.Get("SECURITY_SERVICE_HOST"),
+		&service.APIKeyConfig{APIKey: app.Config.Get("SECURITY_SERVICE_API_KEY")})
 
 	client, err := dataProviders.New(app)
 	if err != nil {
@@ -50,6 +59,9 @@ func main() {
 	app.SubCommand("load securities", h.LoadSecurities)
 	app.SubCommand("load metrics", h.LoadMetrics)
 	app.SubCommand("load market-holidays", h.LoadMarketHolidays)
+	app.SubCommand("load index-constituents", h.LoadIndexConstituents)
+	app.SubCommand("load security-fundamentals", h.LoadSecurityFundamentals)
+	app.SubCommand("load tradability", h.LoadTradability)
 	app.SubCommand("load ltp", h.LoadLTP)
 	app.SubCommand("load security-stats", h.LoadSecurityStats)
 	app.SubCommand("load security-metrics", h.LoadSecurityMetrics)
@@ -75,6 +87,7 @@ func (h *marketDataHandler) LoadSecurities(ctx *gofr.Context) (any, error) {
 	idxIndustry := slices.Index(headers, "Industry")
 	idxName := slices.Index(headers, "Company Name")
 	idxTier := slices.Index(headers, "Tier")
+	idxInstrumentType := slices.Index(headers, "Instrument Type")
 
 	for {
 		row, readErr := reader.Read()
@@ -86,12 +99,15 @@ func (h *marketDataHandler) LoadSecurities(ctx *gofr.Context) (any, error) {
 			return nil, errors.New("failed to read securitiesMasterFile row")
 		}
 
-		if err = h.createOrUpdateSecurity(ctx, row[idxISIN], row[idxSymbol], row[idxIndustry], row[idxName], row[idxTier]); err != nil {
-			fmt.Println(fmt.Sprintf("-[%s] fail, %s", row[idxISIN], err))
+		key := (&dataProviders.Instrument{ISIN: row[idxISIN], Symbol: row[idxSymbol], Type: row[idxInstrumentType]}).Key()
+
+		if err = h.createOrUpdateSecurity(ctx, row[idxISIN], row[idxSymbol], row[idxInstrumentType], row[idxIndustry], row[idxName],
+			row[idxTier]); err != nil {
+			fmt.Println(fmt.Sprintf("-[%s] fail, %s", key, err))
 			continue
 		}
 
-		fmt.Println(fmt.Sprintf("-[%s] success", row[idxISIN]))
+		fmt.Println(fmt.Sprintf("-[%s] success", key))
 	}
 
 	return "\nsuccessfully loaded securities", nil
@@ -163,44 +179,240 @@ func (h *marketDataHandler) LoadMarketHolidays(ctx *gofr.Context) (any, error) {
 	return "\nsuccessfully loaded market-holidays", nil
 }
 
+func (h *marketDataHandler) LoadIndexConstituents(ctx *gofr.Context) (any, error) {
+	reader := csv.NewReader(strings.NewReader(indexConstituentsMaster))
+
+	headers, err := reader.Read()
+	if err != nil {
+		return nil, errors.New("failed to read indexConstituentsMasterFile headers")
+	}
+
+	idxIndexName := slices.Index(headers, "Index Name")
+	idxIndexSymbol := slices.Index(headers, "Index Symbol")
+	idxISIN := slices.Index(headers, "ISIN Code")
+	idxWeight := slices.Index(headers, "Weight")
+	idxEffectiveDate := slices.Index(headers, "Effective Date")
+
+	var (
+		indexSymbols []string
+		rowsBySymbol = make(map[string][][]string)
+	)
+
+	for {
+		row, readErr := reader.Read()
+		if readErr == io.EOF {
+			break
+		}
+
+		if readErr != nil {
+			return nil, errors.New("failed to read indexConstituentsMasterFile row")
+		}
+
+		if _, ok := rowsBySymbol[row[idxIndexSymbol]]; !ok {
+			indexSymbols = append(indexSymbols, row[idxIndexSymbol])
+		}
+
+		rowsBySymbol[row[idxIndexSymbol]] = append(rowsBySymbol[row[idxIndexSymbol]], row)
+	}
+
+	for _, indexSymbol := range indexSymbols {
+		rows := rowsBySymbol[indexSymbol]
+
+		indexID, err := h.createOrGetIndex(ctx, rows[0][idxIndexName], indexSymbol)
+		if err != nil {
+			fmt.Println(fmt.Sprintf("-[%s] fail, %s", indexSymbol, err))
+			continue
+		}
+
+		var (
+			effectiveDate string
+			constituents  []map[string]any
+		)
+
+		for _, row := range rows {
+			if effectiveDate == "" {
+				effectiveDate = row[idxEffectiveDate]
+			}
+
+			if row[idxEffectiveDate] != effectiveDate {
+				err = errors.New("constituents of an index must share one effective date")
+				break
+			}
+
+			securityID, exists, checkErr := h.checkIfSecurityAlreadyExists(ctx, "isin", row[idxISIN])
+			if checkErr != nil {
+				err = checkErr
+				break
+			}
+
+			if !exists {
+				err = errors.New("security not found, isin: " + row[idxISIN])
+				break
+			}
+
+			constituent := map[string]any{"securityId": securityID}
+
+			if row[idxWeight] != "" {
+				weight, parseErr := strconv.ParseFloat(row[idxWeight], 64)
+				if parseErr != nil {
+					err = errors.New("invalid weight for isin: " + row[idxISIN])
+					break
+				}
+
+				constituent["weight"] = weight
+			}
+
+			constituents = append(constituents, constituent)
+		}
+
+		if err == nil {
+			err = h.replaceIndexConstituents(ctx, indexID, effectiveDate, constituents)
+		}
+
+		if err != nil {
+			fmt.Println(fmt.Sprintf("-[%s] fail, %s", indexSymbol, err))
+			continue
+		}
+
+		fmt.Println(fmt.Sprintf("-[%s] success", indexSymbol))
+	}
+
+	return "\nsuccessfully loaded index-constituents", nil
+}
+
+func (h *marketDataHandler) LoadSecurityFundamentals(ctx *gofr.Context) (any, error) {
+	if ctx.Param("file") == "" {
+		return nil, errors.New("file is required, pass the path of the fundamentals csv with --file")
+	}
+
+	file, err := os.Open(ctx.Param("file"))
+	if err != nil {
+		return nil, errors.New("failed to open fundamentals file, err: " + err.Error())
+	}
+
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+
+	headers, err := reader.Read()
+	if err != nil {
+		return nil, errors.New("failed to read fundamentalsFile headers")
+	}
+
+	idxISIN := slices.Index(headers, "ISIN Code")
+	idxPeriodEnd := slices.Index(headers, "Period End")
+
+	if idxISIN == -1 || idxPeriodEnd == -1 {
+		return nil, errors.New("fundamentalsFile must have ISIN Code and Period End columns")
+	}
+
+	var columns = map[string]int{
+		"reportedAt":        slices.Index(headers, "Reported At"),
+		"sharesOutstanding": slices.Index(headers, "Shares Outstanding"),
+		"revenue":           slices.Index(headers, "Revenue"),
+		"netIncome":         slices.Index(headers, "Net Income"),
+		"eps":               slices.Index(headers, "EPS"),
+		"bookValue":         slices.Index(headers, "Book Value"),
+		"dividendPerShare":  slices.Index(headers, "Dividend Per Share"),
+	}
+
+	for {
+		row, readErr := reader.Read()
+		if readErr == io.EOF {
+			break
+		}
+
+		if readErr != nil {
+			return nil, errors.New("failed to read fundamentalsFile row")
+		}
+
+		key := row[idxISIN] + " " + row[idxPeriodEnd]
+
+		payload, err := buildFundamentalPayload(row, columns)
+		if err != nil {
+			fmt.Println(fmt.Sprintf("-[%s] fail, %s", key, err))
+			continue
+		}
+
+		if err = h.createOrUpdateSecurityFundamental(ctx, row[idxISIN], row[idxPeriodEnd], payload); err != nil {
+			fmt.Println(fmt.Sprintf("-[%s] fail, %s", key, err))
+			continue
+		}
+
+		fmt.Println(fmt.Sprintf("-[%s] success", key))
+	}
+
+	return "\nsuccessfully loaded security-fundamentals", nil
+}
+
+func (h *marketDataHandler) LoadTradability(ctx *gofr.Context) (any, error) {
+	isinFilter := ctx.Param("isin")
+
+	securityKeys, securityIDMap, instrumentMap, err := h.getSecurityDetails(ctx, isinFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	if isinFilter != "" && !slices.Contains(securityKeys, isinFilter) {
+		return nil, errors.New("security not found with isin - " + isinFilter)
+	}
+
+	for i := range securityKeys {
+		tradability, err := h.client.Tradability(ctx, instrumentMap[securityKeys[i]])
+		if err != nil {
+			fmt.Println(fmt.Sprintf("-[%s] fail, %s", securityKeys[i], err))
+			continue
+		}
+
+		if err = h.updateTradability(ctx, securityIDMap[securityKeys[i]], tradability); err != nil {
+			fmt.Println(fmt.Sprintf("-[%s] fail, %s", securityKeys[i], err))
+			continue
+		}
+
+		fmt.Println(fmt.Sprintf("-[%s] success", securityKeys[i]))
+	}
+
+	return "\nsuccessfully loaded tradability", nil
+}
+
 func (h *marketDataHandler) LoadLTP(ctx *gofr.Context) (any, error) {
 	currentTime := time.Now().In(h.tz)
 	isinFilter := ctx.Param("isin")
 
-	securityISINs, securityIDMap, err := h.getSecurityDetails(ctx, isinFilter)
+	securityKeys, securityIDMap, instrumentMap, err := h.getSecurityDetails(ctx, isinFilter)
 	if err != nil {
 		return nil, err
 	}
 
-	if isinFilter != "" && !slices.Contains(securityISINs, isinFilter) {
+	if isinFilter != "" && !slices.Contains(securityKeys, isinFilter) {
 		return nil, errors.New("security not found with isin - " + isinFilter)
 	}
 
-	ltpData, err := h.client.LTPBulk(ctx, securityISINs)
+	ltpData, err := h.client.LTPBulk(ctx, instrumentsOf(securityKeys, instrumentMap))
 	if err != nil {
 		return nil, errors.New("failed to get ltpData, err: " + err.Error())
 	}
 
-	for i := range securityISINs {
-		securityID := securityIDMap[securityISINs[i]]
+	for i := range securityKeys {
+		securityID := securityIDMap[securityKeys[i]]
 
 		idx := slices.IndexFunc(ltpData, func(data *dataProviders.LTPData) bool {
-			return data.ISIN == securityISINs[i]
+			return data.ISIN == instrumentMap[securityKeys[i]].ISIN && data.Symbol == instrumentMap[securityKeys[i]].Symbol
 		})
 
 		if idx == -1 {
-			fmt.Println(fmt.Sprintf("-[%s] fail, ltp data not found", securityISINs[i]))
+			fmt.Println(fmt.Sprintf("-[%s] fail, ltp data not found", securityKeys[i]))
 			continue
 		}
 
 		data := ltpData[idx]
 
 		if err = h.updateLTP(ctx, securityID, data.LTP); err != nil {
-			fmt.Println(fmt.Sprintf("-[%s] fail, %s", securityISINs[i], err))
+			fmt.Println(fmt.Sprintf("-[%s] fail, %s", securityKeys[i], err))
 			continue
 		}
 
-		fmt.Println(fmt.Sprintf("-[%s] success", securityISINs[i]))
+		fmt.Println(fmt.Sprintf("-[%s] success", securityKeys[i]))
 	}
 
 	return "\nsuccessfully loaded ltp data @ " + currentTime.Format(time.DateTime), nil
@@ -231,12 +443,12 @@ func (h *marketDataHandler) LoadSecurityStats(ctx *gofr.Context) (any, error) {
 
 	isinFilter := ctx.Param("isin")
 
-	securityISINs, securityIDMap, err := h.getSecurityDetails(ctx, ctx.Param("isin"))
+	securityKeys, securityIDMap, instrumentMap, err := h.getSecurityDetails(ctx, ctx.Param("isin"))
 	if err != nil {
 		return nil, err
 	}
 
-	if isinFilter != "" && !slices.Contains(securityISINs, isinFilter) {
+	if isinFilter != "" && !slices.Contains(securityKeys, isinFilter) {
 		return nil, errors.New("security not found with isin - " + isinFilter)
 	}
 
@@ -245,34 +457,39 @@ func (h *marketDataHandler) LoadSecurityStats(ctx *gofr.Context) (any, error) {
 		return nil, err
 	}
 
-	for i := range securityISINs {
-		securityID := securityIDMap[securityISINs[i]]
+	for i := range securityKeys {
+		securityID := securityIDMap[securityKeys[i]]
 
-		historicalData, err := h.client.HistoricalOHLC(ctx, securityISINs[i], startDate, endDate)
+		historicalData, err := h.client.HistoricalOHLC(ctx, instrumentMap[securityKeys[i]], startDate, endDate)
 		if err != nil {
-			fmt.Println(fmt.Sprintf("-[%s] fail, %s", securityISINs[i], err))
+			fmt.Println(fmt.Sprintf("-[%s] fail, %s", securityKeys[i], err))
 			continue
 		}
 
+		var rows []*bulkRow
+
 		for _, date := range marketDays {
 			idx := slices.IndexFunc(historicalData, func(ohlc *dataProviders.HistoricalOHLC) bool {
 				return ohlc.Date.Format(time.DateOnly) == date.Format(time.DateOnly)
 			})
 
 			if idx == -1 {
-				fmt.Println(fmt.Sprintf("--[%s][%s] fail, historical data not found", securityISINs[i], date.Format(time.DateOnly)))
+				fmt.Println(fmt.Sprintf("--[%s][%s] fail, historical data not found", securityKeys[i], date.Format(time.DateOnly)))
 				continue
 			}
 
-			if err = h.createOrUpdateSecurityStat(ctx, securityID, date, historicalData[idx].OHLCData); err != nil {
-				fmt.Println(fmt.Sprintf("--[%s][%s] fail, %s", securityISINs[i], date.Format(time.DateOnly), err))
-				continue
-			}
+			rows = append(rows, &bulkRow{
+				label:   fmt.Sprintf("--[%s][%s]", securityKeys[i], date.Format(time.DateOnly)),
+				payload: securityStatPayload(securityID, date, historicalData[idx].OHLCData),
+			})
+		}
 
-			fmt.Println(fmt.Sprintf("--[%s][%s] success", securityISINs[i], date.Format(time.DateOnly)))
+		if err = h.bulkUpsert(ctx, "security-stats:bulk", rows); err != nil {
+			fmt.Println(fmt.Sprintf("-[%s] fail, %s", securityKeys[i], err))
+			continue
 		}
 
-		fmt.Println(fmt.Sprintf("-[%s] success", securityISINs[i]))
+		fmt.Println(fmt.Sprintf("-[%s] success", securityKeys[i]))
 	}
 
 	return fmt.Println(fmt.Sprintf("\nsuccessfully loaded ohlc data for interval %s to %s", startDate.Format(time.DateOnly), endDate.Format(time.DateOnly)))
@@ -303,16 +520,16 @@ func (h *marketDataHandler) LoadSecurityMetrics(ctx *gofr.Context) (any, error)
 
 	isinFilter := ctx.Param("isin")
 
-	securityISINs, securityIDMap, err := h.getSecurityDetails(ctx, ctx.Param("isin"))
+	securityKeys, securityIDMap, instrumentMap, err := h.getSecurityDetails(ctx, ctx.Param("isin"))
 	if err != nil {
 		return nil, err
 	}
 
-	if isinFilter != "" && !slices.Contains(securityISINs, isinFilter) {
+	if isinFilter != "" && !slices.Contains(securityKeys, isinFilter) {
 		return nil, errors.New("security not found with isin - " + isinFilter)
 	}
 
-	metricIDs, metricNames, err := h.getMetricIDs(ctx)
+	metricIDsMap, metricNames, err := h.getApplicableMetricIDs(ctx, instrumentMap)
 	if err != nil {
 		return nil, err
 	}
@@ -322,25 +539,29 @@ func (h *marketDataHandler) LoadSecurityMetrics(ctx *gofr.Context) (any, error)
 		return nil, err
 	}
 
-	for i := range securityISINs {
-		securityID := securityIDMap[securityISINs[i]]
+	for i := range securityKeys {
+		securityID := securityIDMap[securityKeys[i]]
+		metricIDs := metricIDsMap[instrumentMap[securityKeys[i]].Type]
+
+		var rows []*bulkRow
 
 		for j := range metricIDs {
 			metricID := metricIDs[j]
 
 			for _, date := range marketDays {
-				if err = h.createOrUpdateSecurityMetric(ctx, securityID, metricID, date); err != nil {
-					fmt.Println(fmt.Sprintf("---[%s][%s][%s] fail, %s", securityISINs[i], metricNames[metricID], date.Format(time.DateOnly), err))
-					continue
-				}
-
-				fmt.Println(fmt.Sprintf("---[%s][%s][%s] success", securityISINs[i], metricNames[metricID], date.Format(time.DateOnly)))
+				rows = append(rows, &bulkRow{
+					label:   fmt.Sprintf("--[%s][%s][%s]", securityKeys[i], metricNames[metricID], date.Format(time.DateOnly)),
+					payload: securityMetricPayload(securityID, metricID, date),
+				})
 			}
+		}
 
-			fmt.Println(fmt.Sprintf("--[%s][%s] success", securityISINs[i], metricNames[metricID]))
+		if err = h.bulkUpsert(ctx, "security-metrics:bulk", rows); err != nil {
+			fmt.Println(fmt.Sprintf("-[%s] fail, %s", securityKeys[i], err))
+			continue
 		}
 
-		fmt.Println(fmt.Sprintf("-[%s] success", securityISINs[i]))
+		fmt.Println(fmt.Sprintf("-[%s] success", securityKeys[i]))
 	}
 
 	return fmt.Println(fmt.Sprintf("\nsuccessfully loaded security metrics data for interval %s to %s", startDate.Format(time.DateOnly), endDate.Format(time.DateOnly)))
@@ -360,38 +581,42 @@ func (h *marketDataHandler) LoadTodaysSecurityStats(ctx *gofr.Context) (any, err
 
 	isinFilter := ctx.Param("isin")
 
-	securityISINs, securityIDMap, err := h.getSecurityDetails(ctx, isinFilter)
+	securityKeys, securityIDMap, instrumentMap, err := h.getSecurityDetails(ctx, isinFilter)
 	if err != nil {
 		return nil, err
 	}
 
-	if isinFilter != "" && !slices.Contains(securityISINs, isinFilter) {
+	if isinFilter != "" && !slices.Contains(securityKeys, isinFilter) {
 		return nil, errors.New("security not found with isin - " + isinFilter)
 	}
 
-	ohlcData, err := h.client.OHLCBulk(ctx, securityISINs)
+	ohlcData, err := h.client.OHLCBulk(ctx, instrumentsOf(securityKeys, instrumentMap))
 	if err != nil {
 		return nil, errors.New("failed to get ohlcData, err: " + err.Error())
 	}
 
-	for i := range securityISINs {
-		securityID := securityIDMap[securityISINs[i]]
+	var rows []*bulkRow
+
+	for i := range securityKeys {
+		securityID := securityIDMap[securityKeys[i]]
 
 		idx := slices.IndexFunc(ohlcData, func(data *dataProviders.OHLCData) bool {
-			return data.ISIN == securityISINs[i]
+			return data.ISIN == instrumentMap[securityKeys[i]].ISIN && data.Symbol == instrumentMap[securityKeys[i]].Symbol
 		})
 
 		if idx == -1 {
-			fmt.Println(fmt.Sprintf("-[%s] fail, ltp data not found", securityISINs[i]))
+			fmt.Println(fmt.Sprintf("-[%s] fail, ltp data not found", securityKeys[i]))
 			continue
 		}
 
-		if err = h.createOrUpdateSecurityStat(ctx, securityID, today, ohlcData[idx]); err != nil {
-			fmt.Println(fmt.Sprintf("-[%s] fail, %s", securityISINs[i], err))
-			continue
-		}
+		rows = append(rows, &bulkRow{
+			label:   fmt.Sprintf("-[%s]", securityKeys[i]),
+			payload: securityStatPayload(securityID, today, ohlcData[idx]),
+		})
+	}
 
-		fmt.Println(fmt.Sprintf("-[%s] success", securityISINs[i]))
+	if err = h.bulkUpsert(ctx, "security-stats:bulk", rows); err != nil {
+		return nil, err
 	}
 
 	return "\nsuccessfully loaded ohlc data @ " + today.Format(time.DateOnly), nil
@@ -411,67 +636,80 @@ func (h *marketDataHandler) LoadTodaysSecurityMetrics(ctx *gofr.Context) (any, e
 
 	isinFilter := ctx.Param("isin")
 
-	securityISINs, securityIDMap, err := h.getSecurityDetails(ctx, isinFilter)
+	securityKeys, securityIDMap, instrumentMap, err := h.getSecurityDetails(ctx, isinFilter)
 	if err != nil {
 		return nil, err
 	}
 
-	if isinFilter != "" && !slices.Contains(securityISINs, isinFilter) {
+	if isinFilter != "" && !slices.Contains(securityKeys, isinFilter) {
 		return nil, errors.New("security not found with isin - " + isinFilter)
 	}
 
-	metricIDs, metricsNames, err := h.getMetricIDs(ctx)
+	metricIDsMap, metricsNames, err := h.getApplicableMetricIDs(ctx, instrumentMap)
 	if err != nil {
 		return nil, err
 	}
 
-	for i := range securityISINs {
-		securityID := securityIDMap[securityISINs[i]]
+	var rows []*bulkRow
+
+	for i := range securityKeys {
+		securityID := securityIDMap[securityKeys[i]]
+		metricIDs := metricIDsMap[instrumentMap[securityKeys[i]].Type]
 
 		for j := range metricIDs {
 			metricID := metricIDs[j]
 
-			if err = h.createSecurityMetric(ctx, securityID, metricID, today); err != nil {
-				fmt.Println(fmt.Sprintf("--[%s][%s] fail, %s", securityISINs[i], metricsNames[metricID], err))
-				continue
-			}
-
-			fmt.Println(fmt.Sprintf("--[%s][%s] success", securityISINs[i], metricsNames[metricID]))
+			rows = append(rows, &bulkRow{
+				label:   fmt.Sprintf("-[%s][%s]", securityKeys[i], metricsNames[metricID]),
+				payload: securityMetricPayload(securityID, metricID, today),
+			})
 		}
+	}
 
-		fmt.Println(fmt.Sprintf("-[%s] success", securityISINs[i]))
+	if err = h.bulkUpsert(ctx, "security-metrics:bulk", rows); err != nil {
+		return nil, err
 	}
 
 	return "\nsuccessfully loaded security metrics data @ " + today.Format(time.DateTime), nil
 }
 
-func (h *marketDataHandler) createOrUpdateSecurity(ctx *gofr.Context, ISIN, symbol, industry, name, tier string) error {
+func (h *marketDataHandler) createOrUpdateSecurity(ctx *gofr.Context, ISIN, symbol, instrumentType, industry, name, tier string) error {
 	tierInt, _ := strconv.Atoi(tier)
 
-	securityID, exists, err := h.checkIfSecurityAlreadyExists(ctx, ISIN)
+	// indices have no ISIN, so they are looked up by their symbol
+	param, value := "isin", ISIN
+	if instrumentType == dataProviders.InstrumentTypeIndex {
+		param, value = "symbol", symbol
+	}
+
+	securityID, exists, err := h.checkIfSecurityAlreadyExists(ctx, param, value)
 	if err != nil {
 		return err
 	}
 
 	if exists {
-		if err = h.updateSecurity(ctx, securityID, ISIN, symbol, industry, name, tierInt); err != nil {
+		if err = h.updateSecurity(ctx, securityID, ISIN, symbol, instrumentType, industry, name, tierInt); err != nil {
 			return err
 		}
 
 		return nil
 	}
 
-	if err = h.createSecurity(ctx, ISIN, symbol, industry, name, tierInt); err != nil {
+	if err = h.createSecurity(ctx, ISIN, symbol, instrumentType, industry, name, tierInt); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (h *marketDataHandler) checkIfSecurityAlreadyExists(ctx *gofr.Context, ISIN string) (int, bool, error) {
+func (h *marketDataHandler) checkIfSecurityAlreadyExists(ctx *gofr.Context, param, value string) (int, bool, error) {
+	if value == "" {
+		return 0, false, errors.New(param + " is required")
+	}
+
 	securityService := ctx.GetHTTPService("security-service")
 
-	resp, err := securityService.Get(ctx, "securities", map[string]any{"isin": ISIN})
+	resp, err := securityService.Get(ctx, "securities", map[string]any{param: value})
 	if err != nil {
 		return 0, false, errors.New("failed GET /security-service/securities, err: " + err.Error())
 	}
@@ -502,14 +740,14 @@ func (h *marketDataHandler) checkIfSecurityAlreadyExists(ctx *gofr.Context, ISIN
 	return 0, false, nil
 }
 
-func (h *marketDataHandler) updateSecurity(ctx *gofr.Context, securityID int, ISIN, symbol, industry, name string, tier int) error {
+func (h *marketDataHandler) updateSecurity(ctx *gofr.Context, securityID int, ISIN, symbol, instrumentType, industry, name string, tier int) error {
 	payload := map[string]any{
-		"userId":   1,
-		"isin":     ISIN,
-		"symbol":   symbol,
-		"industry": industry,
-		"name":     name,
-		"tier":     tier,
+		"isin":           ISIN,
+		"symbol":         symbol,
+		"instrumentType": instrumentType,
+		"industry":       industry,
+		"name":           name,
+		"tier":           tier,
 	}
 
 	body, _ := json.Marshal(payload)
@@ -530,14 +768,14 @@ func (h *marketDataHandler) updateSecurity(ctx *gofr.Context, securityID int, IS
 	return nil
 }
 
-func (h *marketDataHandler) createSecurity(ctx *gofr.Context, ISIN, symbol, industry, name string, tier int) error {
+func (h *marketDataHandler) createSecurity(ctx *gofr.Context, ISIN, symbol, instrumentType, industry, name string, tier int) error {
 	payload := map[string]any{
-		"userId":   1,
-		"isin":     ISIN,
-		"symbol":   symbol,
-		"industry": industry,
-		"name":     name,
-		"tier":     tier,
+		"isin":           ISIN,
+		"symbol":         symbol,
+		"instrumentType": instrumentType,
+		"industry":       industry,
+		"name":           name,
+		"tier":           tier,
 	}
 
 	body, _ := json.Marshal(payload)
@@ -618,9 +856,8 @@ func (h *marketDataHandler) checkIfMetricAlreadyExists(ctx *gofr.Context, typ st
 
 func (h *marketDataHandler) updateMetric(ctx *gofr.Context, metricID int, name string, tier int) error {
 	payload := map[string]any{
-		"userId": 1,
-		"name":   name,
-		"tier":   tier,
+		"name": name,
+		"tier": tier,
 	}
 
 	body, _ := json.Marshal(payload)
@@ -643,7 +880,6 @@ func (h *marketDataHandler) updateMetric(ctx *gofr.Context, metricID int, name s
 
 func (h *marketDataHandler) createMetric(ctx *gofr.Context, name, typ string, period, tier int) error {
 	payload := map[string]any{
-		"userId": 1,
 		"name":   name,
 		"type":   typ,
 		"period": period,
@@ -725,7 +961,6 @@ func (h *marketDataHandler) checkIfMarketHolidayAlreadyExists(ctx *gofr.Context,
 
 func (h *marketDataHandler) updateMarketHoliday(ctx *gofr.Context, marketHolidayID int, description string) error {
 	payload := map[string]any{
-		"userId":      1,
 		"description": description,
 	}
 
@@ -749,7 +984,6 @@ func (h *marketDataHandler) updateMarketHoliday(ctx *gofr.Context, marketHoliday
 
 func (h *marketDataHandler) createMarketHoliday(ctx *gofr.Context, date, description string) error {
 	payload := map[string]any{
-		"userId":      1,
 		"date":        date,
 		"description": description,
 	}
@@ -772,6 +1006,221 @@ func (h *marketDataHandler) createMarketHoliday(ctx *gofr.Context, date, descrip
 	return nil
 }
 
+func (h *marketDataHandler) createOrGetIndex(ctx *gofr.Context, name, symbol string) (int, error) {
+	securityService := ctx.GetHTTPService("security-service")
+
+	resp, err := securityService.Get(ctx, "indices", map[string]any{"symbol": symbol})
+	if err != nil {
+		return 0, errors.New("failed GET /security-service/indices, err: " + err.Error())
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		body, _ := io.ReadAll(resp.Body)
+
+		return 0, errors.New("non 200 resp GET /security-service/indices, resp: " + string(body))
+	}
+
+	var res struct {
+		Data []*struct {
+			ID int `json:"id"`
+		} `json:"data"`
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	if err != nil {
+		return 0, errors.New("unexpected resp GET /security-service/indices, unmarshallErr: " + err.Error())
+	}
+
+	if len(res.Data) > 0 {
+		return res.Data[0].ID, nil
+	}
+
+	body, _ := json.Marshal(map[string]any{
+		"name":   name,
+		"symbol": symbol,
+	})
+
+	createResp, err := securityService.Post(ctx, "indices", nil, body)
+	if err != nil {
+		return 0, errors.New("failed POST /security-service/indices, err: " + err.Error())
+	}
+
+	defer createResp.Body.Close()
+
+	if createResp.StatusCode != 201 {
+		b, _ := io.ReadAll(createResp.Body)
+
+		return 0, errors.New("non 201 resp POST /security-service/indices, resp: " + string(b))
+	}
+
+	var created struct {
+		Data struct {
+			ID int `json:"id"`
+		} `json:"data"`
+	}
+
+	err = json.NewDecoder(createResp.Body).Decode(&created)
+	if err != nil {
+		return 0, errors.New("unexpected resp POST /security-service/indices, unmarshallErr: " + err.Error())
+	}
+
+	return created.Data.ID, nil
+}
+
+func (h *marketDataHandler) replaceIndexConstituents(ctx *gofr.Context, indexID int, effectiveDate string, constituents []map[string]any) error {
+	payload := map[string]any{
+		"effectiveFrom": effectiveDate,
+		"constituents":  constituents,
+	}
+
+	body, _ := json.Marshal(payload)
+
+	resp, err := ctx.GetHTTPService("security-service").Put(ctx, fmt.Sprintf("indices/%d/constituents", indexID), nil, body)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed PUT /security-service/indices/%d/constituents, err: %s", indexID, err))
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		b, _ := io.ReadAll(resp.Body)
+
+		return errors.New(fmt.Sprintf("non 200 resp PUT /security-service/indices/%d/constituents, resp: %s", indexID, string(b)))
+	}
+
+	return nil
+}
+
+func buildFundamentalPayload(row []string, columns map[string]int) (map[string]any, error) {
+	payload := make(map[string]any)
+
+	for field, idx := range columns {
+		if idx == -1 || row[idx] == "" {
+			continue
+		}
+
+		switch field {
+		case "reportedAt":
+			payload[field] = row[idx]
+		case "sharesOutstanding":
+			shares, err := strconv.ParseInt(row[idx], 10, 64)
+			if err != nil {
+				return nil, errors.New("invalid " + field)
+			}
+
+			payload[field] = shares
+		default:
+			value, err := strconv.ParseFloat(row[idx], 64)
+			if err != nil {
+				return nil, errors.New("invalid " + field)
+			}
+
+			payload[field] = value
+		}
+	}
+
+	return payload, nil
+}
+
+func (h *marketDataHandler) createOrUpdateSecurityFundamental(ctx *gofr.Context, ISIN, periodEnd string, payload map[string]any) error {
+	securityID, exists, err := h.checkIfSecurityAlreadyExists(ctx, "isin", ISIN)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return errors.New("security not found, isin: " + ISIN)
+	}
+
+	securityFundamentalID, exists, err := h.checkIfSecurityFundamentalAlreadyExists(ctx, securityID, periodEnd)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return h.updateSecurityFundamental(ctx, securityFundamentalID, payload)
+	}
+
+	payload["securityId"] = securityID
+	payload["periodEnd"] = periodEnd
+
+	return h.createSecurityFundamental(ctx, payload)
+}
+
+func (h *marketDataHandler) checkIfSecurityFundamentalAlreadyExists(ctx *gofr.Context, securityID int, periodEnd string) (int, bool, error) {
+	securityService := ctx.GetHTTPService("security-service")
+
+	resp, err := securityService.Get(ctx, "security-fundamentals", map[string]any{"securityId": securityID, "periodEnd": periodEnd})
+	if err != nil {
+		return 0, false, errors.New("failed GET /security-service/security-fundamentals, err: " + err.Error())
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		body, _ := io.ReadAll(resp.Body)
+
+		return 0, false, errors.New("non 200 resp GET /security-service/security-fundamentals, resp: " + string(body))
+	}
+
+	var res struct {
+		Data []*struct {
+			ID int `json:"id"`
+		} `json:"data"`
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	if err != nil {
+		return 0, false, errors.New("unexpected resp GET /security-service/security-fundamentals, unmarshallErr: " + err.Error())
+	}
+
+	if len(res.Data) > 0 {
+		return res.Data[0].ID, true, nil
+	}
+
+	return 0, false, nil
+}
+
+func (h *marketDataHandler) updateSecurityFundamental(ctx *gofr.Context, securityFundamentalID int, payload map[string]any) error {
+	body, _ := json.Marshal(payload)
+
+	resp, err := ctx.GetHTTPService("security-service").Patch(ctx, fmt.Sprintf("security-fundamentals/%d", securityFundamentalID), nil, body)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed PATCH /security-service/security-fundamentals/%d, err: %s", securityFundamentalID, err))
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		b, _ := io.ReadAll(resp.Body)
+
+		return errors.New(fmt.Sprintf("non 200 resp PATCH /security-service/security-fundamentals/%d, resp: %s", securityFundamentalID, string(b)))
+	}
+
+	return nil
+}
+
+func (h *marketDataHandler) createSecurityFundamental(ctx *gofr.Context, payload map[string]any) error {
+	body, _ := json.Marshal(payload)
+
+	resp, err := ctx.GetHTTPService("security-service").Post(ctx, "security-fundamentals", nil, body)
+	if err != nil {
+		return errors.New("failed POST /security-service/security-fundamentals, err: " + err.Error())
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 201 {
+		b, _ := io.ReadAll(resp.Body)
+
+		return errors.New("non 201 resp POST /security-service/security-fundamentals, resp: " + string(b))
+	}
+
+	return nil
+}
+
 func (h *marketDataHandler) getMarketDays(ctx *gofr.Context, startDate, endDate time.Time) ([]time.Time, error) {
 	securityService := ctx.GetHTTPService("security-service")
 
@@ -806,31 +1255,35 @@ func (h *marketDataHandler) getMarketDays(ctx *gofr.Context, startDate, endDate
 	return marketDays, nil
 }
 
-func (h *marketDataHandler) getSecurityDetails(ctx *gofr.Context, ISIN string) ([]string, map[string]int, error) {
+// getSecurityDetails lists the securities keyed by their instrument key, the ISIN or the symbol for an index.
+func (h *marketDataHandler) getSecurityDetails(ctx *gofr.Context, ISIN string) ([]string, map[string]int, map[string]*dataProviders.Instrument, error) {
 	var (
 		securityIDMap = make(map[string]int)
-		securityISINs = make([]string, 0)
+		instrumentMap = make(map[string]*dataProviders.Instrument)
+		securityKeys  = make([]string, 0)
 	)
 
 	securityService := ctx.GetHTTPService("security-service")
 
 	for page := 1; ; page++ {
-		resp, err := securityService.Get(ctx, "securities", map[string]any{"userId": 1, "isin": ISIN, "page": page, "perPage": 100})
+		resp, err := securityService.Get(ctx, "securities", map[string]any{"isin": ISIN, "page": page, "perPage": 100})
 		if err != nil {
-			return nil, nil, errors.New("failed GET /security-service/securities, err: " + err.Error())
+			return nil, nil, nil, errors.New("failed GET /security-service/securities, err: " + err.Error())
 		}
 
 		if resp.StatusCode != 200 {
 			body, _ := io.ReadAll(resp.Body)
 			resp.Body.Close()
 
-			return nil, nil, errors.New("non 200 resp GET /security-service/securities, resp: " + string(body))
+			return nil, nil, nil, errors.New("non 200 resp GET /security-service/securities, resp: " + string(body))
 		}
 
 		var res struct {
 			Data []*struct {
-				ID   int    `json:"id"`
-				ISIN string `json:"isin"`
+				ID             int    `json:"id"`
+				ISIN           string `json:"isin"`
+				Symbol         string `json:"symbol"`
+				InstrumentType string `json:"instrumentType"`
 			} `json:"data"`
 		}
 
@@ -838,7 +1291,7 @@ func (h *marketDataHandler) getSecurityDetails(ctx *gofr.Context, ISIN string) (
 		if err != nil {
 			resp.Body.Close()
 
-			return nil, nil, errors.New("unexpected resp GET /security-service/securities, unmarshalErr: " + err.Error())
+			return nil, nil, nil, errors.New("unexpected resp GET /security-service/securities, unmarshalErr: " + err.Error())
 		}
 
 		resp.Body.Close()
@@ -848,15 +1301,55 @@ func (h *marketDataHandler) getSecurityDetails(ctx *gofr.Context, ISIN string) (
 		}
 
 		for i := range res.Data {
-			securityISINs = append(securityISINs, res.Data[i].ISIN)
-			securityIDMap[res.Data[i].ISIN] = res.Data[i].ID
+			instrument := &dataProviders.Instrument{ISIN: res.Data[i].ISIN, Symbol: res.Data[i].Symbol, Type: res.Data[i].InstrumentType}
+
+			securityKeys = append(securityKeys, instrument.Key())
+			securityIDMap[instrument.Key()] = res.Data[i].ID
+			instrumentMap[instrument.Key()] = instrument
 		}
 	}
 
-	return securityISINs, securityIDMap, nil
+	return securityKeys, securityIDMap, instrumentMap, nil
 }
 
-func (h *marketDataHandler) getMetricIDs(ctx *gofr.Context) ([]int, map[int]string, error) {
+// getApplicableMetricIDs lists the metric ids to load for each instrument type present, leaving out metrics that do not apply to the type.
+func (h *marketDataHandler) getApplicableMetricIDs(ctx *gofr.Context, instrumentMap map[string]*dataProviders.Instrument) (map[string][]int, map[int]string, error) {
+	var (
+		metricIDsMap = make(map[string][]int)
+		metricsNames = make(map[int]string)
+	)
+
+	for _, instrument := range instrumentMap {
+		if _, ok := metricIDsMap[instrument.Type]; ok {
+			continue
+		}
+
+		metricIDs, names, err := h.getMetricIDs(ctx, instrument.Type)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		metricIDsMap[instrument.Type] = metricIDs
+
+		for id, name := range names {
+			metricsNames[id] = name
+		}
+	}
+
+	return metricIDsMap, metricsNames, nil
+}
+
+func instrumentsOf(securityKeys []string, instrumentMap map[string]*dataProviders.Instrument) []*dataProviders.Instrument {
+	instruments := make([]*dataProviders.Instrument, len(securityKeys))
+
+	for i := range securityKeys {
+		instruments[i] = instrumentMap[securityKeys[i]]
+	}
+
+	return instruments
+}
+
+func (h *marketDataHandler) getMetricIDs(ctx *gofr.Context, instrumentType string) ([]int, map[int]string, error) {
 	var (
 		metricIDs    []int
 		metricsNames = make(map[int]string)
@@ -865,7 +1358,7 @@ func (h *marketDataHandler) getMetricIDs(ctx *gofr.Context) ([]int, map[int]stri
 	securityService := ctx.GetHTTPService("security-service")
 
 	for page := 1; ; page++ {
-		resp, err := securityService.Get(ctx, "metrics", map[string]any{"userId": 1, "page": page, "perPage": 100})
+		resp, err := securityService.Get(ctx, "metrics", map[string]any{"instrumentType": instrumentType, "page": page, "perPage": 100})
 		if err != nil {
 			resp.Body.Close()
 
@@ -910,8 +1403,7 @@ func (h *marketDataHandler) getMetricIDs(ctx *gofr.Context) ([]int, map[int]stri
 
 func (h *marketDataHandler) updateLTP(ctx *gofr.Context, securityID int, ltp float64) error {
 	payload := map[string]any{
-		"userId": 1,
-		"ltp":    ltp,
+		"ltp": ltp,
 	}
 
 	body, _ := json.Marshal(payload)
@@ -928,93 +1420,89 @@ func (h *marketDataHandler) updateLTP(ctx *gofr.Context, securityID int, ltp flo
 	return nil
 }
 
-func (h *marketDataHandler) createOrUpdateSecurityStat(ctx *gofr.Context, securityID int, date time.Time, ohlcData *dataProviders.OHLCData) error {
-	securityStatID, statExists, err := h.checkIfStatAlreadyExists(ctx, securityID, date)
-	if err != nil {
-		return err
+func (h *marketDataHandler) updateTradability(ctx *gofr.Context, securityID int, tradability *dataProviders.TradabilityData) error {
+	payload := map[string]any{
+		"lotSize":              tradability.LotSize,
+		"tickSize":             tradability.TickSize,
+		"surveillanceFlag":     tradability.SurveillanceFlag,
+		"surveillanceCategory": tradability.SurveillanceCategory,
+		"mtfLeverage":          tradability.MTFLeverage,
 	}
 
-	if statExists {
-		if err = h.updateSecurityStat(ctx, securityStatID, date, ohlcData); err != nil {
-			return err
-		}
+	body, _ := json.Marshal(payload)
 
-		return nil
+	resp, err := ctx.GetHTTPService("security-service").Put(ctx, fmt.Sprintf("securities/%d/tradability", securityID), nil, body)
+	if err != nil {
+		return fmt.Errorf("failed PUT /security-service/securities/%d/tradability, err: %s", securityID, err)
 	}
 
-	if err = h.createSecurityStat(ctx, securityID, date, ohlcData); err != nil {
-		return err
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("non 200 resp PUT /security-service/securities/%d/tradability, status: %d", securityID, resp.StatusCode)
 	}
 
 	return nil
 }
 
-func (h *marketDataHandler) checkIfStatAlreadyExists(ctx *gofr.Context, securityID int, date time.Time) (int, bool, error) {
-	securityService := ctx.GetHTTPService("security-service")
-
-	resp, err := securityService.Get(ctx, "security-stats", map[string]any{"securityId": securityID, "date": date.Format(time.DateOnly)})
-	if err != nil {
-		return 0, false, errors.New("failed GET /security-service/security-stats, err: " + err.Error())
-	}
+// bulkRow is a single row of a bulk upsert along with the label its outcome is reported under.
+type bulkRow struct {
+	label   string
+	payload map[string]any
+}
 
-	defer resp.Body.Close()
+func (h *marketDataHandler) bulkUpsert(ctx *gofr.Context, path string, rows []*bulkRow) error {
+	for start := 0; start < len(rows); start += bulkUpsertBatchSize {
+		batch := rows[start:min(start+bulkUpsertBatchSize, len(rows))]
 
-	if resp.StatusCode != 200 {
-		body, _ := io.ReadAll(resp.Body)
-
-		return 0, false, errors.New("non 200 resp GET /security-service/security-stats, resp: " + string(body))
-	}
+		payload := make([]map[string]any, len(batch))
 
-	var res struct {
-		Data []*struct {
-			ID int `json:"id"`
-		} `json:"data"`
-	}
+		for i := range batch {
+			payload[i] = batch[i].payload
+		}
 
-	err = json.NewDecoder(resp.Body).Decode(&res)
-	if err != nil {
-		return 0, false, errors.New("unexpected resp GET /security-service/security-stats, unmarshallErr: " + err.Error())
-	}
+		body, _ := json.Marshal(payload)
 
-	if len(res.Data) > 0 {
-		return res.Data[0].ID, true, nil
-	}
+		resp, err := ctx.GetHTTPService("security-service").Put(ctx, path, nil, body)
+		if err != nil {
+			return errors.New(fmt.Sprintf("failed PUT /security-service/%s, err: %s", path, err))
+		}
 
-	return 0, false, nil
-}
+		if resp.StatusCode != 200 {
+			b, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
 
-func (h *marketDataHandler) updateSecurityStat(ctx *gofr.Context, securityStatID int, date time.Time, ohlcData *dataProviders.OHLCData) error {
-	payload := map[string]any{
-		"userId": 1,
-		"date":   date.Format(time.DateOnly),
-		"open":   ohlcData.Open,
-		"close":  ohlcData.Close,
-		"high":   ohlcData.High,
-		"low":    ohlcData.Low,
-		"volume": ohlcData.Volume,
-	}
+			return errors.New(fmt.Sprintf("non 200 resp PUT /security-service/%s, resp: %s", path, string(b)))
+		}
 
-	body, _ := json.Marshal(payload)
+		var res struct {
+			Data []struct {
+				Index  int    `json:"index"`
+				Status string `json:"status"`
+				Error  string `json:"error"`
+			} `json:"data"`
+		}
 
-	resp, err := ctx.GetHTTPService("security-service").Patch(ctx, fmt.Sprintf("security-stats/%d", securityStatID), nil, body)
-	if err != nil {
-		return errors.New(fmt.Sprintf("failed PATCH /security-service/security-stats/%d, err: %s", securityStatID, err))
-	}
+		err = json.NewDecoder(resp.Body).Decode(&res)
+		resp.Body.Close()
 
-	defer resp.Body.Close()
+		if err != nil {
+			return errors.New(fmt.Sprintf("failed to decode PUT /security-service/%s resp, err: %s", path, err))
+		}
 
-	if resp.StatusCode != 200 {
-		b, _ := io.ReadAll(resp.Body)
+		for _, result := range res.Data {
+			if result.Status == "failed" {
+				fmt.Println(fmt.Sprintf("%s fail, %s", batch[result.Index].label, result.Error))
+				continue
+			}
 
-		return errors.New(fmt.Sprintf("non 200 resp POST /security-service/security-stats/%d, resp: %s", securityStatID, string(b)))
+			fmt.Println(fmt.Sprintf("%s success", batch[result.Index].label))
+		}
 	}
 
 	return nil
 }
 
-func (h *marketDataHandler) createSecurityStat(ctx *gofr.Context, securityID int, date time.Time, ohlcData *dataProviders.OHLCData) error {
-	payload := map[string]any{
-		"userId":     1,
+func securityStatPayload(securityID int, date time.Time, ohlcData *dataProviders.OHLCData) map[string]any {
+	return map[string]any{
 		"securityId": securityID,
 		"date":       date.Format(time.DateOnly),
 		"open":       ohlcData.Open,
@@ -1023,126 +1511,12 @@ func (h *marketDataHandler) createSecurityStat(ctx *gofr.Context, securityID int
 		"low":        ohlcData.Low,
 		"volume":     ohlcData.Volume,
 	}
-
-	body, _ := json.Marshal(payload)
-
-	resp, err := ctx.GetHTTPService("security-service").Post(ctx, "security-stats", nil, body)
-	if err != nil {
-		return errors.New("failed POST /security-service/security-stats, err: " + err.Error())
-	}
-
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 201 {
-		b, _ := io.ReadAll(resp.Body)
-
-		return errors.New("non 201 resp POST /security-service/security-stats, resp: " + string(b))
-	}
-
-	return nil
-}
-
-func (h *marketDataHandler) createOrUpdateSecurityMetric(ctx *gofr.Context, securityID, metricID int, date time.Time) error {
-	securityMetricID, metricExists, err := h.checkIfSecurityMetricAlreadyExists(ctx, securityID, metricID, date)
-	if err != nil {
-		return err
-	}
-
-	if metricExists {
-		if err = h.updateSecurityMetric(ctx, securityMetricID); err != nil {
-			return err
-		}
-
-		return nil
-	}
-
-	if err = h.createSecurityMetric(ctx, securityID, metricID, date); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func (h *marketDataHandler) checkIfSecurityMetricAlreadyExists(ctx *gofr.Context, securityID, metricID int, date time.Time) (int, bool, error) {
-	securityService := ctx.GetHTTPService("security-service")
-
-	resp, err := securityService.Get(ctx, "security-metrics", map[string]any{"securityId": securityID, "metricId": metricID, "date": date.Format(time.DateOnly)})
-	if err != nil {
-		return 0, false, errors.New("failed GET /security-service/security-metrics, err: " + err.Error())
-	}
-
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 200 {
-		body, _ := io.ReadAll(resp.Body)
-
-		return 0, false, errors.New("non 200 resp GET /security-service/security-metrics, resp: " + string(body))
-	}
-
-	var res struct {
-		Data []*struct {
-			ID int `json:"id"`
-		} `json:"data"`
-	}
-
-	err = json.NewDecoder(resp.Body).Decode(&res)
-	if err != nil {
-		return 0, false, errors.New("unexpected resp GET /security-service/security-metrics, unmarshallErr: " + err.Error())
-	}
-
-	if len(res.Data) > 0 {
-		return res.Data[0].ID, true, nil
-	}
-
-	return 0, false, nil
-}
-
-func (h *marketDataHandler) updateSecurityMetric(ctx *gofr.Context, securityMetricID int) error {
-	payload := map[string]any{
-		"userId":         1,
-		"recomputeValue": true,
-	}
-
-	body, _ := json.Marshal(payload)
-
-	resp, err := ctx.GetHTTPService("security-service").Patch(ctx, fmt.Sprintf("security-metrics/%d", securityMetricID), nil, body)
-	if err != nil {
-		return errors.New(fmt.Sprintf("failed PATCH /security-service/security-metrics/%d, err: %s", securityMetricID, err))
-	}
-
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 200 {
-		b, _ := io.ReadAll(resp.Body)
-
-		return errors.New(fmt.Sprintf("non 200 resp POST /security-service/security-metrics/%d, resp: %s", securityMetricID, string(b)))
-	}
-
-	return nil
 }
 
-func (h *marketDataHandler) createSecurityMetric(ctx *gofr.Context, securityID, metricID int, date time.Time) error {
-	payload := map[string]any{
-		"userId":     1,
+func securityMetricPayload(securityID, metricID int, date time.Time) map[string]any {
+	return map[string]any{
 		"securityId": securityID,
 		"metricId":   metricID,
 		"date":       date.Format(time.DateOnly),
 	}
-
-	body, _ := json.Marshal(payload)
-
-	resp, err := ctx.GetHTTPService("security-service").Post(ctx, "security-metrics", nil, body)
-	if err != nil {
-		return errors.New("failed POST /security-service/security-metrics, err: " + err.Error())
-	}
-
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 201 {
-		b, _ := io.ReadAll(resp.Body)
-
-		return errors.New("non 201 resp POST /security-service/security-metrics, resp: " + string(b))
-	}
-
-	return nil
 }
@@ -2,7 +2,10 @@ module github.com/stratifyr/security-service
 
 go 1.24
 
-require gofr.dev v1.35.1
+require (
+	github.com/golang-jwt/jwt/v5 v5.2.1
+	gofr.dev v1.35.1
+)
 
 require (
 	cloud.google.com/go v0.118.1 // indirect
@@ -26,7 +29,6 @@ require (
 	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/go-sql-driver/mysql v1.9.0 // indirect
 	github.com/gogo/protobuf v1.3.2 // indirect
-	github.com/golang-jwt/jwt/v5 v5.2.1 // indirect
 	github.com/google/s2a-go v0.1.9 // indirect
 	github.com/google/uuid v1.6.0 // indirect
 	github.com/googleapis/enterprise-certificate-proxy v0.3.5 // indirect
@@ -0,0 +1,198 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+)
+
+var ErrUnauthenticated = errors.New("invalid credentials")
+
+// WebSocketProtocol is the subprotocol a websocket client offers ahead of its bearer token, browsers cannot set headers on
+// websocket upgrades so the token travels as the next offered subprotocol: "Sec-WebSocket-Protocol: bearer, <token>". It is the
+// only subprotocol the server accepts, so the token is never echoed back and, unlike a query param, never lands in access logs.
+const WebSocketProtocol = "bearer"
+
+type APIKeyResolver interface {
+	ResolveAPIKey(ctx *gofr.Context, apiKey string) (*Principal, error)
+}
+
+type Authenticator struct {
+	jwtSecret      []byte
+	apiKeys        map[string]*Principal
+	apiKeyResolver APIKeyResolver
+}
+
+type claims struct {
+	Role string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// NewAuthenticator accepts apiKeys as comma separated name:role:key entries, any other
+// api key is looked up through the apiKeyResolver.
+func NewAuthenticator(jwtSecret, apiKeys string, apiKeyResolver APIKeyResolver) (*Authenticator, error) {
+	a := &Authenticator{
+		jwtSecret:      []byte(jwtSecret),
+		apiKeys:        make(map[string]*Principal),
+		apiKeyResolver: apiKeyResolver,
+	}
+
+	for _, entry := range strings.Split(apiKeys, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+
+		parts := strings.SplitN(strings.TrimSpace(entry), ":", 3)
+		if len(parts) != 3 || parts[2] == "" {
+			return nil, errors.New("invalid api key entry, expected name:role:key")
+		}
+
+		role, ok := RoleFromString(parts[1])
+		if !ok {
+			return nil, errors.New("invalid role for api key " + parts[0])
+		}
+
+		a.apiKeys[parts[2]] = &Principal{Name: parts[0], Role: role}
+	}
+
+	return a, nil
+}
+
+// Authenticate resolves the caller from a bearer token or an api key, returning nil for anonymous calls.
+func (a *Authenticator) Authenticate(ctx *gofr.Context, authorization, apiKey string) (*Principal, error) {
+	if apiKey != "" {
+		return a.authenticateAPIKey(ctx, apiKey)
+	}
+
+	if authorization == "" {
+		return nil, nil
+	}
+
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+
+	return a.authenticateJWT(token)
+}
+
+func (a *Authenticator) Middleware(c *container.Container, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/.well-known/") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := &gofr.Context{Context: r.Context(), Container: c}
+
+		authorization := r.Header.Get("Authorization")
+
+		if authorization == "" && strings.HasPrefix(r.URL.Path, "/ws/") {
+			if token := webSocketToken(r); token != "" {
+				authorization = "Bearer " + token
+			}
+		}
+
+		principal, err := a.Authenticate(ctx, authorization, r.Header.Get("X-Api-Key"))
+		if errors.Is(err, ErrUnauthenticated) {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		if err != nil {
+			c.Logger.Errorf("failed to authenticate request, err: %v", err)
+			writeError(w, http.StatusInternalServerError, "something went wrong!")
+
+			return
+		}
+
+		if principal != nil {
+			r = r.WithContext(WithPrincipal(r.Context(), principal))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (a *Authenticator) authenticateAPIKey(ctx *gofr.Context, apiKey string) (*Principal, error) {
+	for key, principal := range a.apiKeys {
+		if subtle.ConstantTimeCompare([]byte(key), []byte(apiKey)) == 1 {
+			return principal, nil
+		}
+	}
+
+	if a.apiKeyResolver == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	return a.apiKeyResolver.ResolveAPIKey(ctx, apiKey)
+}
+
+func (a *Authenticator) authenticateJWT(token string) (*Principal, error) {
+	if len(a.jwtSecret) == 0 {
+		return nil, ErrUnauthenticated
+	}
+
+	var c claims
+
+	_, err := jwt.ParseWithClaims(token, &c, func(*jwt.Token) (any, error) {
+		return a.jwtSecret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, ErrUnauthenticated
+	}
+
+	userID, err := strconv.Atoi(c.Subject)
+	if err != nil || userID <= 0 {
+		return nil, ErrUnauthenticated
+	}
+
+	role := RoleReader
+
+	if c.Role != "" {
+		var ok bool
+
+		role, ok = RoleFromString(c.Role)
+		if !ok {
+			return nil, ErrUnauthenticated
+		}
+	}
+
+	return &Principal{UserID: userID, Name: c.Subject, Role: role}, nil
+}
+
+// webSocketToken returns the subprotocol offered right after WebSocketProtocol.
+func webSocketToken(r *http.Request) string {
+	var protocols []string
+
+	for _, header := range r.Header.Values("Sec-WebSocket-Protocol") {
+		for _, protocol := range strings.Split(header, ",") {
+			protocols = append(protocols, strings.TrimSpace(protocol))
+		}
+	}
+
+	if i := slices.Index(protocols, WebSocketProtocol); i >= 0 && i+1 < len(protocols) {
+		return protocols[i+1]
+	}
+
+	return ""
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"error": map[string]any{
+			"message": message,
+		},
+	})
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestWebSocketToken(t *testing.T) {
+	tests := []struct {
+		name      string
+		protocols []string
+		want      string
+	}{
+		{name: "no subprotocol"},
+		{name: "token after bearer", protocols: []string{"bearer, eyJ.a.b"}, want: "eyJ.a.b"},
+		{name: "bearer among others", protocols: []string{"json,bearer,eyJ.a.b"}, want: "eyJ.a.b"},
+		{name: "split across headers", protocols: []string{"bearer", "eyJ.a.b"}, want: "eyJ.a.b"},
+		{name: "bearer without token", protocols: []string{"json, bearer"}},
+		{name: "token without bearer", protocols: []string{"eyJ.a.b"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r, _ := http.NewRequest(http.MethodGet, "/ws/prices?token=ignored", http.NoBody)
+
+			for _, protocol := range tc.protocols {
+				r.Header.Add("Sec-WebSocket-Protocol", protocol)
+			}
+
+			if got := webSocketToken(r); got != tc.want {
+				t.Errorf("webSocketToken() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"context"
+	"slices"
+)
+
+const (
+	RoleAdmin  Role = "admin"
+	RoleLoader Role = "loader"
+	RoleReader Role = "reader"
+)
+
+const (
+	ReadMarketData      Permission = "market-data:read"
+	ReadAllTiers        Permission = "tiers:all"
+	WriteSecurities     Permission = "securities:write"
+	WriteMetrics        Permission = "metrics:write"
+	WriteStats          Permission = "stats:write"
+	WriteMarketHolidays Permission = "market-holidays:write"
+	WriteIndustries     Permission = "industries:write"
+	WriteIndices        Permission = "indices:write"
+	WriteFundamentals   Permission = "fundamentals:write"
+
+	ManageServiceCredentials Permission = "service-credentials:manage"
+	ReadAuditEvents          Permission = "audit-events:read"
+
+	WriteAlerts     Permission = "alerts:write"
+	ManageAllAlerts Permission = "alerts:manage"
+
+	WriteWatchlists Permission = "watchlists:write"
+	WritePortfolios Permission = "portfolios:write"
+	RunBacktests    Permission = "backtests:run"
+)
+
+type Role string
+
+type Permission string
+
+var rolePermissions = map[Role][]Permission{
+	RoleAdmin: {
+		ReadMarketData,
+		ReadAllTiers,
+		WriteSecurities,
+		WriteMetrics,
+		WriteStats,
+		WriteMarketHolidays,
+		WriteIndustries,
+		WriteIndices,
+		WriteFundamentals,
+		ManageServiceCredentials,
+		ReadAuditEvents,
+		WriteAlerts,
+		ManageAllAlerts,
+		WriteWatchlists,
+		WritePortfolios,
+		RunBacktests,
+	},
+	RoleLoader: {
+		ReadMarketData,
+		ReadAllTiers,
+		WriteSecurities,
+		WriteMetrics,
+		WriteStats,
+		WriteMarketHolidays,
+		WriteIndices,
+		WriteFundamentals,
+	},
+	RoleReader: {
+		ReadMarketData,
+		WriteAlerts,
+		WriteWatchlists,
+		WritePortfolios,
+		RunBacktests,
+	},
+}
+
+var permissions = []Permission{
+	ReadMarketData,
+	ReadAllTiers,
+	WriteSecurities,
+	WriteMetrics,
+	WriteStats,
+	WriteMarketHolidays,
+	WriteIndustries,
+	WriteIndices,
+	WriteFundamentals,
+	ManageServiceCredentials,
+	ReadAuditEvents,
+	WriteAlerts,
+	ManageAllAlerts,
+	WriteWatchlists,
+	WritePortfolios,
+	RunBacktests,
+}
+
+type Principal struct {
+	UserID int
+	Name   string
+	Role   Role
+	Scopes []Permission
+
+	// ServiceCredentialID is set for callers authenticated with a service credential api key.
+	ServiceCredentialID int
+}
+
+type principalKey struct{}
+
+func (p *Principal) Can(permission Permission) bool {
+	if p == nil {
+		return false
+	}
+
+	return slices.Contains(rolePermissions[p.Role], permission) || slices.Contains(p.Scopes, permission)
+}
+
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(principalKey{}).(*Principal)
+
+	return principal
+}
+
+func RoleFromString(str string) (Role, bool) {
+	role := Role(str)
+
+	_, ok := rolePermissions[role]
+
+	return role, ok
+}
+
+func PermissionFromString(str string) (Permission, bool) {
+	permission := Permission(str)
+
+	return permission, slices.Contains(permissions, permission)
+}
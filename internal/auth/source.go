@@ -0,0 +1,33 @@
+package auth
+
+import "context"
+
+const (
+	SourceREST   Source = "rest"
+	SourceGRPC   Source = "grpc"
+	SourceLoader Source = "loader"
+)
+
+type Source string
+
+type transportKey struct{}
+
+// WithTransport marks the context as served by transport, only servers other than the REST one need to.
+func WithTransport(ctx context.Context, transport Source) context.Context {
+	return context.WithValue(ctx, transportKey{}, transport)
+}
+
+// SourceFromContext derives where a request came from out of the transport serving it and the principal making it, never out of
+// anything the caller sends, so it can be trusted in audit records. Requests made with loader credentials come from the loader, as do
+// requests made with a service credential since those are what the data loader signs in with.
+func SourceFromContext(ctx context.Context) Source {
+	if principal := PrincipalFromContext(ctx); principal != nil && (principal.Role == RoleLoader || principal.ServiceCredentialID != 0) {
+		return SourceLoader
+	}
+
+	if transport, ok := ctx.Value(transportKey{}).(Source); ok {
+		return transport
+	}
+
+	return SourceREST
+}
@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSourceFromContext(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *Principal
+		transport Source
+		want      Source
+	}{
+		{name: "anonymous rest", want: SourceREST},
+		{name: "user over rest", principal: &Principal{UserID: 1, Role: RoleReader}, want: SourceREST},
+		{name: "user over grpc", principal: &Principal{UserID: 1, Role: RoleReader}, transport: SourceGRPC, want: SourceGRPC},
+		{name: "loader api key", principal: &Principal{Name: "loader", Role: RoleLoader}, want: SourceLoader},
+		{name: "service credential", principal: &Principal{Name: "data-loader", Scopes: []Permission{WriteStats}, ServiceCredentialID: 7}, want: SourceLoader},
+		{name: "service credential over grpc", principal: &Principal{Name: "data-loader", ServiceCredentialID: 7}, transport: SourceGRPC, want: SourceLoader},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			if tc.principal != nil {
+				ctx = WithPrincipal(ctx, tc.principal)
+			}
+
+			if tc.transport != "" {
+				ctx = WithTransport(ctx, tc.transport)
+			}
+
+			if got := SourceFromContext(ctx); got != tc.want {
+				t.Errorf("SourceFromContext() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+package handlers
+
+import (
+	"strconv"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
+
+	"github.com/stratifyr/security-service/internal/services"
+)
+
+type AlertRule struct {
+	ID              int     `json:"id"`
+	UserID          int     `json:"userId"`
+	Name            string  `json:"name"`
+	SecurityID      *int    `json:"securityId"`
+	IndexID         *int    `json:"indexId"`
+	Field           string  `json:"field"`
+	MetricID        *int    `json:"metricId"`
+	Operator        string  `json:"operator"`
+	Threshold       float64 `json:"threshold"`
+	WebhookURL      string  `json:"webhookUrl"`
+	WebhookSecret   string  `json:"webhookSecret,omitempty"`
+	Active          bool    `json:"active"`
+	LastTriggeredAt *string `json:"lastTriggeredAt"`
+	CreatedAt       string  `json:"createdAt"`
+	UpdatedAt       string  `json:"updatedAt"`
+}
+
+type AlertRuleCreate struct {
+	Name       string  `json:"name"`
+	SecurityID *int    `json:"securityId"`
+	IndexID    *int    `json:"indexId"`
+	Field      string  `json:"field"`
+	MetricID   *int    `json:"metricId"`
+	Operator   string  `json:"operator"`
+	Threshold  float64 `json:"threshold"`
+	WebhookURL string  `json:"webhookUrl"`
+}
+
+type AlertRuleUpdate struct {
+	Name       string   `json:"name"`
+	Operator   string   `json:"operator"`
+	Threshold  *float64 `json:"threshold"`
+	WebhookURL string   `json:"webhookUrl"`
+	Active     *bool    `json:"active"`
+}
+
+type AlertDelivery struct {
+	ID            int     `json:"id"`
+	AlertRuleID   int     `json:"alertId"`
+	SecurityID    int     `json:"securityId"`
+	Date          string  `json:"date"`
+	Value         float64 `json:"value"`
+	Status        string  `json:"status"`
+	Attempts      int     `json:"attempts"`
+	LastError     string  `json:"lastError,omitempty"`
+	NextAttemptAt string  `json:"nextAttemptAt"`
+	DeliveredAt   *string `json:"deliveredAt"`
+	CreatedAt     string  `json:"createdAt"`
+}
+
+type alertHandler struct {
+	svc services.AlertService
+}
+
+func NewAlertHandler(svc services.AlertService) *alertHandler {
+	return &alertHandler{svc: svc}
+}
+
+func (h *alertHandler) Index(ctx *gofr.Context) (interface{}, error) {
+	var (
+		filter services.AlertRuleFilter
+		err    error
+	)
+
+	if ctx.Param("userId") != "" {
+		filter.UserID, err = strconv.Atoi(ctx.Param("userId"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"userId"}}
+		}
+	}
+
+	if ctx.Param("active") != "" {
+		active, err := strconv.ParseBool(ctx.Param("active"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"active"}}
+		}
+
+		filter.Active = &active
+	}
+
+	page := 1
+	if ctx.Param("page") != "" {
+		page, err = strconv.Atoi(ctx.Param("page"))
+		if err != nil || page < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"page"}}
+		}
+	}
+
+	perPage := 20
+	if ctx.Param("perPage") != "" {
+		perPage, err = strconv.Atoi(ctx.Param("perPage"))
+		if err != nil || perPage < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"perPage"}}
+		}
+	}
+
+	alertRules, count, err := h.svc.Index(ctx, &filter, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp = make([]*AlertRule, len(alertRules))
+
+	for i := range alertRules {
+		resp[i] = h.buildResp(alertRules[i])
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": resp,
+		"meta": map[string]any{
+			"page":    page,
+			"perPage": perPage,
+			"total":   count,
+		},
+	}}, nil
+}
+
+func (h *alertHandler) Read(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	alertRule, err := h.svc.Read(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(alertRule),
+	}}, nil
+}
+
+func (h *alertHandler) Create(ctx *gofr.Context) (interface{}, error) {
+	var payload AlertRuleCreate
+
+	if err := ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	model := &services.AlertRuleCreate{
+		Name:       payload.Name,
+		SecurityID: payload.SecurityID,
+		IndexID:    payload.IndexID,
+		Field:      payload.Field,
+		MetricID:   payload.MetricID,
+		Operator:   payload.Operator,
+		Threshold:  payload.Threshold,
+		WebhookURL: payload.WebhookURL,
+	}
+
+	alertRule, err := h.svc.Create(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(alertRule),
+	}}, nil
+}
+
+func (h *alertHandler) Patch(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var payload AlertRuleUpdate
+
+	if err = ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	model := &services.AlertRuleUpdate{
+		Name:       payload.Name,
+		Operator:   payload.Operator,
+		Threshold:  payload.Threshold,
+		WebhookURL: payload.WebhookURL,
+		Active:     payload.Active,
+	}
+
+	alertRule, err := h.svc.Patch(ctx, id, model)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(alertRule),
+	}}, nil
+}
+
+func (h *alertHandler) Delete(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	err = h.svc.Delete(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (h *alertHandler) Deliveries(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	filter := services.AlertDeliveryFilter{Status: ctx.Param("status")}
+
+	page := 1
+	if ctx.Param("page") != "" {
+		page, err = strconv.Atoi(ctx.Param("page"))
+		if err != nil || page < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"page"}}
+		}
+	}
+
+	perPage := 20
+	if ctx.Param("perPage") != "" {
+		perPage, err = strconv.Atoi(ctx.Param("perPage"))
+		if err != nil || perPage < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"perPage"}}
+		}
+	}
+
+	alertDeliveries, count, err := h.svc.IndexDeliveries(ctx, id, &filter, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp = make([]*AlertDelivery, len(alertDeliveries))
+
+	for i := range alertDeliveries {
+		resp[i] = h.buildDeliveryResp(alertDeliveries[i])
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": resp,
+		"meta": map[string]any{
+			"page":    page,
+			"perPage": perPage,
+			"total":   count,
+		},
+	}}, nil
+}
+
+func (h *alertHandler) RetryDelivery(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	deliveryID, err := strconv.Atoi(ctx.PathParam("deliveryId"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"deliveryId"}}
+	}
+
+	alertDelivery, err := h.svc.RetryDelivery(ctx, id, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildDeliveryResp(alertDelivery),
+	}}, nil
+}
+
+func (h *alertHandler) buildResp(model *services.AlertRule) *AlertRule {
+	resp := &AlertRule{
+		ID:              model.ID,
+		UserID:          model.UserID,
+		Name:            model.Name,
+		SecurityID:      model.SecurityID,
+		IndexID:         model.IndexID,
+		Field:           model.Field,
+		MetricID:        model.MetricID,
+		Operator:        model.Operator,
+		Threshold:       model.Threshold,
+		WebhookURL:      model.WebhookURL,
+		WebhookSecret:   model.WebhookSecret,
+		Active:          model.Active,
+		LastTriggeredAt: formatOptionalTime(model.LastTriggeredAt),
+		CreatedAt:       model.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       model.UpdatedAt.Format(time.RFC3339),
+	}
+
+	return resp
+}
+
+func (h *alertHandler) buildDeliveryResp(model *services.AlertDelivery) *AlertDelivery {
+	resp := &AlertDelivery{
+		ID:            model.ID,
+		AlertRuleID:   model.AlertRuleID,
+		SecurityID:    model.SecurityID,
+		Date:          model.Date.Format(time.DateOnly),
+		Value:         model.Value,
+		Status:        model.Status,
+		Attempts:      model.Attempts,
+		LastError:     model.LastError,
+		NextAttemptAt: model.NextAttemptAt.Format(time.RFC3339),
+		DeliveredAt:   formatOptionalTime(model.DeliveredAt),
+		CreatedAt:     model.CreatedAt.Format(time.RFC3339),
+	}
+
+	return resp
+}
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"strconv"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
+
+	"github.com/stratifyr/security-service/internal/services"
+)
+
+type AuditEvent struct {
+	ID        int                              `json:"id"`
+	Actor     string                           `json:"actor"`
+	ActorType string                           `json:"actorType"`
+	Entity    string                           `json:"entity"`
+	EntityID  int                              `json:"entityId"`
+	Action    string                           `json:"action"`
+	Source    string                           `json:"source"`
+	Changes   map[string]*services.AuditChange `json:"changes"`
+	CreatedAt string                           `json:"createdAt"`
+}
+
+type auditEventHandler struct {
+	svc services.AuditEventService
+}
+
+func NewAuditEventHandler(svc services.AuditEventService) *auditEventHandler {
+	return &auditEventHandler{svc: svc}
+}
+
+func (h *auditEventHandler) Index(ctx *gofr.Context) (interface{}, error) {
+	var (
+		filter services.AuditEventFilter
+		err    error
+	)
+
+	if ctx.Param("actor") != "" {
+		filter.Actor = ctx.Param("actor")
+	}
+
+	if ctx.Param("entity") != "" {
+		filter.Entity = ctx.Param("entity")
+	}
+
+	if ctx.Param("entityId") != "" {
+		filter.EntityID, err = strconv.Atoi(ctx.Param("entityId"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"entityId"}}
+		}
+	}
+
+	if ctx.Param("action") != "" {
+		filter.Action = ctx.Param("action")
+	}
+
+	if ctx.Param("source") != "" {
+		filter.Source = ctx.Param("source")
+	}
+
+	if ctx.Param("from") != "" {
+		filter.CreatedAfter, err = time.Parse(time.RFC3339, ctx.Param("from"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"from"}}
+		}
+	}
+
+	if ctx.Param("to") != "" {
+		filter.CreatedBefore, err = time.Parse(time.RFC3339, ctx.Param("to"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"to"}}
+		}
+	}
+
+	page := 1
+	if ctx.Param("page") != "" {
+		page, err = strconv.Atoi(ctx.Param("page"))
+		if err != nil || page < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"page"}}
+		}
+	}
+
+	perPage := 20
+	if ctx.Param("perPage") != "" {
+		perPage, err = strconv.Atoi(ctx.Param("perPage"))
+		if err != nil || perPage < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"perPage"}}
+		}
+	}
+
+	auditEvents, count, err := h.svc.Index(ctx, &filter, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp = make([]*AuditEvent, len(auditEvents))
+
+	for i := range auditEvents {
+		resp[i] = h.buildResp(auditEvents[i])
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": resp,
+		"meta": map[string]any{
+			"page":    page,
+			"perPage": perPage,
+			"total":   count,
+		},
+	}}, nil
+}
+
+func (h *auditEventHandler) buildResp(model *services.AuditEvent) *AuditEvent {
+	resp := &AuditEvent{
+		ID:        model.ID,
+		Actor:     model.Actor,
+		ActorType: model.ActorType,
+		Entity:    model.Entity,
+		EntityID:  model.EntityID,
+		Action:    model.Action,
+		Source:    model.Source,
+		Changes:   model.Changes,
+		CreatedAt: model.CreatedAt.Format(time.RFC3339),
+	}
+
+	return resp
+}
@@ -0,0 +1,265 @@
+package handlers
+
+import (
+	"strconv"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
+
+	"github.com/stratifyr/security-service/internal/services"
+)
+
+type Backtest struct {
+	ID          int               `json:"id"`
+	UserID      int               `json:"userId"`
+	Name        string            `json:"name"`
+	Strategy    *BacktestStrategy `json:"strategy"`
+	Status      string            `json:"status"`
+	Result      *BacktestResult   `json:"result,omitempty"`
+	Error       string            `json:"error,omitempty"`
+	StartedAt   *string           `json:"startedAt"`
+	CompletedAt *string           `json:"completedAt"`
+	CreatedAt   string            `json:"createdAt"`
+}
+
+type BacktestCreate struct {
+	Name     string            `json:"name"`
+	Strategy *BacktestStrategy `json:"strategy"`
+}
+
+type BacktestStrategy struct {
+	StartDate         string                        `json:"startDate"`
+	EndDate           string                        `json:"endDate"`
+	InitialCapital    float64                       `json:"initialCapital"`
+	Universe          services.BacktestUniverse     `json:"universe"`
+	Entry             []*services.BacktestCondition `json:"entry"`
+	Exit              []*services.BacktestCondition `json:"exit"`
+	StopLossPercent   float64                       `json:"stopLossPercent"`
+	TakeProfitPercent float64                       `json:"takeProfitPercent"`
+	Sizing            services.BacktestSizing       `json:"sizing"`
+}
+
+type BacktestResult struct {
+	FinalEquity float64                `json:"finalEquity"`
+	TotalReturn float64                `json:"totalReturn"`
+	CAGR        float64                `json:"cagr"`
+	MaxDrawdown float64                `json:"maxDrawdown"`
+	Sharpe      float64                `json:"sharpe"`
+	WinRate     float64                `json:"winRate"`
+	TradeCount  int                    `json:"tradeCount"`
+	EquityCurve []*BacktestEquityPoint `json:"equityCurve"`
+	Trades      []*BacktestTrade       `json:"trades"`
+}
+
+type BacktestEquityPoint struct {
+	Date      string  `json:"date"`
+	Equity    float64 `json:"equity"`
+	Cash      float64 `json:"cash"`
+	Positions int     `json:"positions"`
+}
+
+type BacktestTrade struct {
+	SecurityID    int     `json:"securityId"`
+	Symbol        string  `json:"symbol"`
+	Quantity      float64 `json:"quantity"`
+	EntryDate     string  `json:"entryDate"`
+	EntryPrice    float64 `json:"entryPrice"`
+	ExitDate      *string `json:"exitDate"`
+	ExitPrice     float64 `json:"exitPrice"`
+	ExitReason    string  `json:"exitReason"`
+	PnL           float64 `json:"pnl"`
+	ReturnPercent float64 `json:"returnPercent"`
+}
+
+type backtestHandler struct {
+	svc services.BacktestService
+}
+
+func NewBacktestHandler(svc services.BacktestService) *backtestHandler {
+	return &backtestHandler{svc: svc}
+}
+
+func (h *backtestHandler) Index(ctx *gofr.Context) (interface{}, error) {
+	var err error
+
+	page := 1
+	if ctx.Param("page") != "" {
+		page, err = strconv.Atoi(ctx.Param("page"))
+		if err != nil || page < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"page"}}
+		}
+	}
+
+	perPage := 20
+	if ctx.Param("perPage") != "" {
+		perPage, err = strconv.Atoi(ctx.Param("perPage"))
+		if err != nil || perPage < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"perPage"}}
+		}
+	}
+
+	backtests, count, err := h.svc.Index(ctx, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp = make([]*Backtest, len(backtests))
+
+	for i := range backtests {
+		resp[i] = h.buildResp(backtests[i])
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": resp,
+		"meta": map[string]any{
+			"page":    page,
+			"perPage": perPage,
+			"total":   count,
+		},
+	}}, nil
+}
+
+func (h *backtestHandler) Read(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	backtest, err := h.svc.Read(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(backtest),
+	}}, nil
+}
+
+func (h *backtestHandler) Create(ctx *gofr.Context) (interface{}, error) {
+	var payload BacktestCreate
+
+	if err := ctx.Bind(&payload); err != nil || payload.Strategy == nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	startDate, err := time.Parse(time.DateOnly, payload.Strategy.StartDate)
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"startDate"}}
+	}
+
+	endDate, err := time.Parse(time.DateOnly, payload.Strategy.EndDate)
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"endDate"}}
+	}
+
+	model := &services.BacktestCreate{
+		Name: payload.Name,
+		Strategy: &services.BacktestStrategy{
+			StartDate:         startDate,
+			EndDate:           endDate,
+			InitialCapital:    payload.Strategy.InitialCapital,
+			Universe:          payload.Strategy.Universe,
+			Entry:             payload.Strategy.Entry,
+			Exit:              payload.Strategy.Exit,
+			StopLossPercent:   payload.Strategy.StopLossPercent,
+			TakeProfitPercent: payload.Strategy.TakeProfitPercent,
+			Sizing:            payload.Strategy.Sizing,
+		},
+	}
+
+	backtest, err := h.svc.Create(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(backtest),
+	}}, nil
+}
+
+func (h *backtestHandler) Delete(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	err = h.svc.Delete(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (h *backtestHandler) buildResp(model *services.Backtest) *Backtest {
+	resp := &Backtest{
+		ID:          model.ID,
+		UserID:      model.UserID,
+		Name:        model.Name,
+		Status:      model.Status,
+		Error:       model.Error,
+		StartedAt:   formatOptionalTime(model.StartedAt),
+		CompletedAt: formatOptionalTime(model.CompletedAt),
+		CreatedAt:   model.CreatedAt.Format(time.RFC3339),
+	}
+
+	if model.Strategy != nil {
+		resp.Strategy = &BacktestStrategy{
+			StartDate:         model.Strategy.StartDate.Format(time.DateOnly),
+			EndDate:           model.Strategy.EndDate.Format(time.DateOnly),
+			InitialCapital:    model.Strategy.InitialCapital,
+			Universe:          model.Strategy.Universe,
+			Entry:             model.Strategy.Entry,
+			Exit:              model.Strategy.Exit,
+			StopLossPercent:   model.Strategy.StopLossPercent,
+			TakeProfitPercent: model.Strategy.TakeProfitPercent,
+			Sizing:            model.Strategy.Sizing,
+		}
+	}
+
+	if model.Result != nil {
+		resp.Result = &BacktestResult{
+			FinalEquity: model.Result.FinalEquity,
+			TotalReturn: model.Result.TotalReturn,
+			CAGR:        model.Result.CAGR,
+			MaxDrawdown: model.Result.MaxDrawdown,
+			Sharpe:      model.Result.Sharpe,
+			WinRate:     model.Result.WinRate,
+			TradeCount:  model.Result.TradeCount,
+			EquityCurve: make([]*BacktestEquityPoint, len(model.Result.EquityCurve)),
+			Trades:      make([]*BacktestTrade, len(model.Result.Trades)),
+		}
+
+		for i, point := range model.Result.EquityCurve {
+			resp.Result.EquityCurve[i] = &BacktestEquityPoint{
+				Date:      point.Date.Format(time.DateOnly),
+				Equity:    point.Equity,
+				Cash:      point.Cash,
+				Positions: point.Positions,
+			}
+		}
+
+		for i, trade := range model.Result.Trades {
+			resp.Result.Trades[i] = &BacktestTrade{
+				SecurityID:    trade.SecurityID,
+				Symbol:        trade.Symbol,
+				Quantity:      trade.Quantity,
+				EntryDate:     trade.EntryDate.Format(time.DateOnly),
+				EntryPrice:    trade.EntryPrice,
+				ExitPrice:     trade.ExitPrice,
+				ExitReason:    trade.ExitReason,
+				PnL:           trade.PnL,
+				ReturnPercent: trade.ReturnPercent,
+			}
+
+			if trade.ExitDate != nil {
+				exitDate := trade.ExitDate.Format(time.DateOnly)
+				resp.Result.Trades[i].ExitDate = &exitDate
+			}
+		}
+	}
+
+	return resp
+}
@@ -0,0 +1,17 @@
+package handlers
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"github.com/stratifyr/security-service/internal/services"
+)
+
+func RequireFeature(svc services.EntitlementService, feature services.Feature, handler gofr.Handler) gofr.Handler {
+	return func(ctx *gofr.Context) (interface{}, error) {
+		if _, err := svc.Require(ctx, feature); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx)
+	}
+}
@@ -0,0 +1,275 @@
+package handlers
+
+import (
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+
+	"github.com/stratifyr/security-service/internal/parquet"
+	"github.com/stratifyr/security-service/internal/services"
+)
+
+var parquetTypes = map[services.ExportColumnType]parquet.Type{
+	services.ExportString: parquet.String,
+	services.ExportInt:    parquet.Int64,
+	services.ExportFloat:  parquet.Double,
+	services.ExportDate:   parquet.Date,
+}
+
+type exportEncoder interface {
+	Write(values []any) error
+	Close() error
+}
+
+type exportMiddleware struct {
+	svc services.ExportService
+}
+
+func NewExportMiddleware(svc services.ExportService) *exportMiddleware {
+	return &exportMiddleware{svc: svc}
+}
+
+// Handle serves GET /security-stats:export and GET /security-metrics:export. Exports are streamed straight to the response writer
+// and may be gzip encoded, neither of which a gofr handler can do, so they are served here instead of being registered as routes.
+func (m *exportMiddleware) Handle(c *container.Container, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var export func(ctx *gofr.Context, f *services.ExportFilter) (*services.Export, error)
+
+		switch r.URL.Path {
+		case "/security-stats:export":
+			export = m.svc.SecurityStats
+		case "/security-metrics:export":
+			export = m.svc.SecurityMetrics
+		default:
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if r.Method != http.MethodGet {
+			writeExportError(c, w, &services.ErrResp{Code: http.StatusMethodNotAllowed, Message: "method not allowed"})
+			return
+		}
+
+		format, filter, err := parseExportQuery(r.URL.Query())
+		if err != nil {
+			writeExportError(c, w, err)
+			return
+		}
+
+		ctx := &gofr.Context{Context: r.Context(), Container: c}
+
+		result, err := export(ctx, filter)
+		if err != nil {
+			writeExportError(c, w, err)
+			return
+		}
+
+		if err = writeExport(w, r, format, result); err != nil {
+			c.Logger.Errorf("failed to stream %s export, err: %v", result.Name, err)
+		}
+	})
+}
+
+func parseExportQuery(query url.Values) (string, *services.ExportFilter, error) {
+	var (
+		filter services.ExportFilter
+		format = services.ExportFormatCSV
+		err    error
+	)
+
+	if query.Get("format") != "" {
+		format = query.Get("format")
+	}
+
+	if format != services.ExportFormatCSV && format != services.ExportFormatParquet {
+		return "", nil, &services.ErrResp{Code: http.StatusBadRequest, Message: "format must be csv or parquet"}
+	}
+
+	for _, symbol := range strings.Split(query.Get("symbols"), ",") {
+		if symbol = strings.TrimSpace(symbol); symbol != "" {
+			filter.Symbols = append(filter.Symbols, symbol)
+		}
+	}
+
+	if query.Get("metricIds") != "" {
+		for _, value := range strings.Split(query.Get("metricIds"), ",") {
+			metricID, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil {
+				return "", nil, &services.ErrResp{Code: http.StatusBadRequest, Message: "invalid metricIds"}
+			}
+
+			filter.MetricIDs = append(filter.MetricIDs, metricID)
+		}
+	}
+
+	if query.Get("from") != "" {
+		filter.From, err = time.Parse(time.DateOnly, query.Get("from"))
+		if err != nil {
+			return "", nil, &services.ErrResp{Code: http.StatusBadRequest, Message: "invalid from"}
+		}
+	}
+
+	if query.Get("to") != "" {
+		filter.To, err = time.Parse(time.DateOnly, query.Get("to"))
+		if err != nil {
+			return "", nil, &services.ErrResp{Code: http.StatusBadRequest, Message: "invalid to"}
+		}
+	}
+
+	return format, &filter, nil
+}
+
+// writeExport commits to a 200 before the first row is read, so a failure midway can only cut the body short. X-Total-Count lets
+// clients tell a complete CSV from a truncated one, a truncated parquet file or gzip stream is unreadable on its own.
+func writeExport(w http.ResponseWriter, r *http.Request, format string, export *services.Export) error {
+	var out io.Writer = w
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, export.Name, format))
+	w.Header().Set("X-Total-Count", strconv.Itoa(export.Count))
+	w.Header().Set("Vary", "Accept-Encoding")
+
+	if format == services.ExportFormatParquet {
+		w.Header().Set("Content-Type", "application/vnd.apache.parquet")
+	} else {
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	}
+
+	var gz *gzip.Writer
+
+	if acceptsGzip(r.Header.Get("Accept-Encoding")) {
+		w.Header().Set("Content-Encoding", "gzip")
+
+		gz = gzip.NewWriter(w)
+		out = gz
+	}
+
+	w.WriteHeader(http.StatusOK)
+
+	var (
+		encoder exportEncoder
+		err     error
+	)
+
+	if format == services.ExportFormatParquet {
+		encoder, err = newParquetExportEncoder(out, export.Columns)
+	} else {
+		encoder, err = newCSVExportEncoder(out, export.Columns)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err = export.Rows(encoder.Write); err != nil {
+		return err
+	}
+
+	if err = encoder.Close(); err != nil {
+		return err
+	}
+
+	if gz != nil {
+		return gz.Close()
+	}
+
+	return nil
+}
+
+func acceptsGzip(acceptEncoding string) bool {
+	for _, encoding := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(encoding), ";")
+
+		if strings.EqualFold(strings.TrimSpace(name), "gzip") && strings.ReplaceAll(params, " ", "") != "q=0" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func writeExportError(c *container.Container, w http.ResponseWriter, err error) {
+	var (
+		statusErr interface{ StatusCode() int }
+		code      = http.StatusInternalServerError
+		message   = "something went wrong!"
+	)
+
+	if errors.As(err, &statusErr) {
+		code, message = statusErr.StatusCode(), err.Error()
+	}
+
+	if code >= http.StatusInternalServerError {
+		c.Logger.Errorf("failed to export, err: %v", err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"error": map[string]any{
+			"message": message,
+		},
+	})
+}
+
+type csvExportEncoder struct {
+	w       *csv.Writer
+	columns []*services.ExportColumn
+	record  []string
+}
+
+func newCSVExportEncoder(w io.Writer, columns []*services.ExportColumn) (*csvExportEncoder, error) {
+	encoder := &csvExportEncoder{w: csv.NewWriter(w), columns: columns, record: make([]string, len(columns))}
+
+	for i := range columns {
+		encoder.record[i] = columns[i].Name
+	}
+
+	return encoder, encoder.w.Write(encoder.record)
+}
+
+func (e *csvExportEncoder) Write(values []any) error {
+	for i, value := range values {
+		switch v := value.(type) {
+		case string:
+			e.record[i] = v
+		case int:
+			e.record[i] = strconv.Itoa(v)
+		case float64:
+			e.record[i] = strconv.FormatFloat(v, 'f', -1, 64)
+		case time.Time:
+			e.record[i] = v.Format(time.DateOnly)
+		default:
+			return fmt.Errorf("unexpected value %v for column %s", value, e.columns[i].Name)
+		}
+	}
+
+	return e.w.Write(e.record)
+}
+
+func (e *csvExportEncoder) Close() error {
+	e.w.Flush()
+
+	return e.w.Error()
+}
+
+func newParquetExportEncoder(w io.Writer, columns []*services.ExportColumn) (*parquet.Writer, error) {
+	parquetColumns := make([]*parquet.Column, len(columns))
+
+	for i := range columns {
+		parquetColumns[i] = &parquet.Column{Name: columns[i].Name, Type: parquetTypes[columns[i].Type]}
+	}
+
+	return parquet.NewWriter(w, parquetColumns)
+}
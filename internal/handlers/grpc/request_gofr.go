@@ -60,4 +60,340 @@ func (h *SecurityIndexRequestWrapper) HostName() string {
 
 func (h *SecurityIndexRequestWrapper) Params(s string) []string {
 	return nil
+}
+
+type SecurityReadRequestWrapper struct {
+	ctx context.Context
+	*SecurityReadRequest
+}
+
+func (h *SecurityReadRequestWrapper) Context() context.Context {
+	return h.ctx
+}
+
+func (h *SecurityReadRequestWrapper) Param(s string) string {
+	return ""
+}
+
+func (h *SecurityReadRequestWrapper) PathParam(s string) string {
+	return ""
+}
+
+func (h *SecurityReadRequestWrapper) Bind(p interface{}) error {
+	ptr := reflect.ValueOf(p)
+	if ptr.Kind() != reflect.Ptr {
+		return fmt.Errorf("expected a pointer, got %T", p)
+	}
+
+	hValue := reflect.ValueOf(h.SecurityReadRequest).Elem()
+	ptrValue := ptr.Elem()
+
+	for i := 0; i < hValue.NumField(); i++ {
+		field := hValue.Type().Field(i)
+		if field.Name == "state" || field.Name == "sizeCache" || field.Name == "unknownFields" {
+			continue
+		}
+
+		if field.IsExported() {
+			ptrValue.Field(i).Set(hValue.Field(i))
+		}
+	}
+
+	return nil
+}
+
+func (h *SecurityReadRequestWrapper) HostName() string {
+	return ""
+}
+
+func (h *SecurityReadRequestWrapper) Params(s string) []string {
+	return nil
+}
+
+type MetricListRequestWrapper struct {
+	ctx context.Context
+	*MetricListRequest
+}
+
+func (h *MetricListRequestWrapper) Context() context.Context {
+	return h.ctx
+}
+
+func (h *MetricListRequestWrapper) Param(s string) string {
+	return ""
+}
+
+func (h *MetricListRequestWrapper) PathParam(s string) string {
+	return ""
+}
+
+func (h *MetricListRequestWrapper) Bind(p interface{}) error {
+	ptr := reflect.ValueOf(p)
+	if ptr.Kind() != reflect.Ptr {
+		return fmt.Errorf("expected a pointer, got %T", p)
+	}
+
+	hValue := reflect.ValueOf(h.MetricListRequest).Elem()
+	ptrValue := ptr.Elem()
+
+	for i := 0; i < hValue.NumField(); i++ {
+		field := hValue.Type().Field(i)
+		if field.Name == "state" || field.Name == "sizeCache" || field.Name == "unknownFields" {
+			continue
+		}
+
+		if field.IsExported() {
+			ptrValue.Field(i).Set(hValue.Field(i))
+		}
+	}
+
+	return nil
+}
+
+func (h *MetricListRequestWrapper) HostName() string {
+	return ""
+}
+
+func (h *MetricListRequestWrapper) Params(s string) []string {
+	return nil
+}
+
+type SecurityStatListRequestWrapper struct {
+	ctx context.Context
+	*SecurityStatListRequest
+}
+
+func (h *SecurityStatListRequestWrapper) Context() context.Context {
+	return h.ctx
+}
+
+func (h *SecurityStatListRequestWrapper) Param(s string) string {
+	return ""
+}
+
+func (h *SecurityStatListRequestWrapper) PathParam(s string) string {
+	return ""
+}
+
+func (h *SecurityStatListRequestWrapper) Bind(p interface{}) error {
+	ptr := reflect.ValueOf(p)
+	if ptr.Kind() != reflect.Ptr {
+		return fmt.Errorf("expected a pointer, got %T", p)
+	}
+
+	hValue := reflect.ValueOf(h.SecurityStatListRequest).Elem()
+	ptrValue := ptr.Elem()
+
+	for i := 0; i < hValue.NumField(); i++ {
+		field := hValue.Type().Field(i)
+		if field.Name == "state" || field.Name == "sizeCache" || field.Name == "unknownFields" {
+			continue
+		}
+
+		if field.IsExported() {
+			ptrValue.Field(i).Set(hValue.Field(i))
+		}
+	}
+
+	return nil
+}
+
+func (h *SecurityStatListRequestWrapper) HostName() string {
+	return ""
+}
+
+func (h *SecurityStatListRequestWrapper) Params(s string) []string {
+	return nil
+}
+
+type SecurityMetricListRequestWrapper struct {
+	ctx context.Context
+	*SecurityMetricListRequest
+}
+
+func (h *SecurityMetricListRequestWrapper) Context() context.Context {
+	return h.ctx
+}
+
+func (h *SecurityMetricListRequestWrapper) Param(s string) string {
+	return ""
+}
+
+func (h *SecurityMetricListRequestWrapper) PathParam(s string) string {
+	return ""
+}
+
+func (h *SecurityMetricListRequestWrapper) Bind(p interface{}) error {
+	ptr := reflect.ValueOf(p)
+	if ptr.Kind() != reflect.Ptr {
+		return fmt.Errorf("expected a pointer, got %T", p)
+	}
+
+	hValue := reflect.ValueOf(h.SecurityMetricListRequest).Elem()
+	ptrValue := ptr.Elem()
+
+	for i := 0; i < hValue.NumField(); i++ {
+		field := hValue.Type().Field(i)
+		if field.Name == "state" || field.Name == "sizeCache" || field.Name == "unknownFields" {
+			continue
+		}
+
+		if field.IsExported() {
+			ptrValue.Field(i).Set(hValue.Field(i))
+		}
+	}
+
+	return nil
+}
+
+func (h *SecurityMetricListRequestWrapper) HostName() string {
+	return ""
+}
+
+func (h *SecurityMetricListRequestWrapper) Params(s string) []string {
+	return nil
+}
+
+type MarketDayListRequestWrapper struct {
+	ctx context.Context
+	*MarketDayListRequest
+}
+
+func (h *MarketDayListRequestWrapper) Context() context.Context {
+	return h.ctx
+}
+
+func (h *MarketDayListRequestWrapper) Param(s string) string {
+	return ""
+}
+
+func (h *MarketDayListRequestWrapper) PathParam(s string) string {
+	return ""
+}
+
+func (h *MarketDayListRequestWrapper) Bind(p interface{}) error {
+	ptr := reflect.ValueOf(p)
+	if ptr.Kind() != reflect.Ptr {
+		return fmt.Errorf("expected a pointer, got %T", p)
+	}
+
+	hValue := reflect.ValueOf(h.MarketDayListRequest).Elem()
+	ptrValue := ptr.Elem()
+
+	for i := 0; i < hValue.NumField(); i++ {
+		field := hValue.Type().Field(i)
+		if field.Name == "state" || field.Name == "sizeCache" || field.Name == "unknownFields" {
+			continue
+		}
+
+		if field.IsExported() {
+			ptrValue.Field(i).Set(hValue.Field(i))
+		}
+	}
+
+	return nil
+}
+
+func (h *MarketDayListRequestWrapper) HostName() string {
+	return ""
+}
+
+func (h *MarketDayListRequestWrapper) Params(s string) []string {
+	return nil
+}
+
+type MarketHolidayListRequestWrapper struct {
+	ctx context.Context
+	*MarketHolidayListRequest
+}
+
+func (h *MarketHolidayListRequestWrapper) Context() context.Context {
+	return h.ctx
+}
+
+func (h *MarketHolidayListRequestWrapper) Param(s string) string {
+	return ""
+}
+
+func (h *MarketHolidayListRequestWrapper) PathParam(s string) string {
+	return ""
+}
+
+func (h *MarketHolidayListRequestWrapper) Bind(p interface{}) error {
+	ptr := reflect.ValueOf(p)
+	if ptr.Kind() != reflect.Ptr {
+		return fmt.Errorf("expected a pointer, got %T", p)
+	}
+
+	hValue := reflect.ValueOf(h.MarketHolidayListRequest).Elem()
+	ptrValue := ptr.Elem()
+
+	for i := 0; i < hValue.NumField(); i++ {
+		field := hValue.Type().Field(i)
+		if field.Name == "state" || field.Name == "sizeCache" || field.Name == "unknownFields" {
+			continue
+		}
+
+		if field.IsExported() {
+			ptrValue.Field(i).Set(hValue.Field(i))
+		}
+	}
+
+	return nil
+}
+
+func (h *MarketHolidayListRequestWrapper) HostName() string {
+	return ""
+}
+
+func (h *MarketHolidayListRequestWrapper) Params(s string) []string {
+	return nil
+}
+
+type StreamPricesRequestWrapper struct {
+	ctx context.Context
+	*StreamPricesRequest
+}
+
+func (h *StreamPricesRequestWrapper) Context() context.Context {
+	return h.ctx
+}
+
+func (h *StreamPricesRequestWrapper) Param(s string) string {
+	return ""
+}
+
+func (h *StreamPricesRequestWrapper) PathParam(s string) string {
+	return ""
+}
+
+func (h *StreamPricesRequestWrapper) Bind(p interface{}) error {
+	ptr := reflect.ValueOf(p)
+	if ptr.Kind() != reflect.Ptr {
+		return fmt.Errorf("expected a pointer, got %T", p)
+	}
+
+	hValue := reflect.ValueOf(h.StreamPricesRequest).Elem()
+	ptrValue := ptr.Elem()
+
+	for i := 0; i < hValue.NumField(); i++ {
+		field := hValue.Type().Field(i)
+		if field.Name == "state" || field.Name == "sizeCache" || field.Name == "unknownFields" {
+			continue
+		}
+
+		if field.IsExported() {
+			ptrValue.Field(i).Set(hValue.Field(i))
+		}
+	}
+
+	return nil
+}
+
+func (h *StreamPricesRequestWrapper) HostName() string {
+	return ""
+}
+
+func (h *StreamPricesRequestWrapper) Params(s string) []string {
+	return nil
 }
\ No newline at end of file
@@ -23,6 +23,13 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SecurityServiceClient interface {
 	Index(ctx context.Context, in *SecurityIndexRequest, opts ...grpc.CallOption) (*SecurityIndexResponse, error)
+	Read(ctx context.Context, in *SecurityReadRequest, opts ...grpc.CallOption) (*Security, error)
+	ListMetrics(ctx context.Context, in *MetricListRequest, opts ...grpc.CallOption) (*MetricListResponse, error)
+	ListSecurityStats(ctx context.Context, in *SecurityStatListRequest, opts ...grpc.CallOption) (*SecurityStatListResponse, error)
+	ListSecurityMetrics(ctx context.Context, in *SecurityMetricListRequest, opts ...grpc.CallOption) (*SecurityMetricListResponse, error)
+	ListMarketDays(ctx context.Context, in *MarketDayListRequest, opts ...grpc.CallOption) (*MarketDayListResponse, error)
+	ListMarketHolidays(ctx context.Context, in *MarketHolidayListRequest, opts ...grpc.CallOption) (*MarketHolidayListResponse, error)
+	StreamPrices(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (SecurityService_StreamPricesClient, error)
 }
 
 type securityServiceClient struct {
@@ -42,11 +49,104 @@ func (c *securityServiceClient) Index(ctx context.Context, in *SecurityIndexRequ
 	return out, nil
 }
 
+func (c *securityServiceClient) Read(ctx context.Context, in *SecurityReadRequest, opts ...grpc.CallOption) (*Security, error) {
+	out := new(Security)
+	err := c.cc.Invoke(ctx, "/security.SecurityService/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityServiceClient) ListMetrics(ctx context.Context, in *MetricListRequest, opts ...grpc.CallOption) (*MetricListResponse, error) {
+	out := new(MetricListResponse)
+	err := c.cc.Invoke(ctx, "/security.SecurityService/ListMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityServiceClient) ListSecurityStats(ctx context.Context, in *SecurityStatListRequest, opts ...grpc.CallOption) (*SecurityStatListResponse, error) {
+	out := new(SecurityStatListResponse)
+	err := c.cc.Invoke(ctx, "/security.SecurityService/ListSecurityStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityServiceClient) ListSecurityMetrics(ctx context.Context, in *SecurityMetricListRequest, opts ...grpc.CallOption) (*SecurityMetricListResponse, error) {
+	out := new(SecurityMetricListResponse)
+	err := c.cc.Invoke(ctx, "/security.SecurityService/ListSecurityMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityServiceClient) ListMarketDays(ctx context.Context, in *MarketDayListRequest, opts ...grpc.CallOption) (*MarketDayListResponse, error) {
+	out := new(MarketDayListResponse)
+	err := c.cc.Invoke(ctx, "/security.SecurityService/ListMarketDays", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityServiceClient) ListMarketHolidays(ctx context.Context, in *MarketHolidayListRequest, opts ...grpc.CallOption) (*MarketHolidayListResponse, error) {
+	out := new(MarketHolidayListResponse)
+	err := c.cc.Invoke(ctx, "/security.SecurityService/ListMarketHolidays", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityServiceClient) StreamPrices(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (SecurityService_StreamPricesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SecurityService_ServiceDesc.Streams[0], "/security.SecurityService/StreamPrices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &securityServiceStreamPricesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SecurityService_StreamPricesClient interface {
+	Recv() (*PriceUpdate, error)
+	grpc.ClientStream
+}
+
+type securityServiceStreamPricesClient struct {
+	grpc.ClientStream
+}
+
+func (x *securityServiceStreamPricesClient) Recv() (*PriceUpdate, error) {
+	m := new(PriceUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // SecurityServiceServer is the server API for SecurityService service.
 // All implementations must embed UnimplementedSecurityServiceServer
 // for forward compatibility
 type SecurityServiceServer interface {
 	Index(context.Context, *SecurityIndexRequest) (*SecurityIndexResponse, error)
+	Read(context.Context, *SecurityReadRequest) (*Security, error)
+	ListMetrics(context.Context, *MetricListRequest) (*MetricListResponse, error)
+	ListSecurityStats(context.Context, *SecurityStatListRequest) (*SecurityStatListResponse, error)
+	ListSecurityMetrics(context.Context, *SecurityMetricListRequest) (*SecurityMetricListResponse, error)
+	ListMarketDays(context.Context, *MarketDayListRequest) (*MarketDayListResponse, error)
+	ListMarketHolidays(context.Context, *MarketHolidayListRequest) (*MarketHolidayListResponse, error)
+	StreamPrices(*StreamPricesRequest, SecurityService_StreamPricesServer) error
 	mustEmbedUnimplementedSecurityServiceServer()
 }
 
@@ -57,6 +157,27 @@ type UnimplementedSecurityServiceServer struct {
 func (UnimplementedSecurityServiceServer) Index(context.Context, *SecurityIndexRequest) (*SecurityIndexResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Index not implemented")
 }
+func (UnimplementedSecurityServiceServer) Read(context.Context, *SecurityReadRequest) (*Security, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedSecurityServiceServer) ListMetrics(context.Context, *MetricListRequest) (*MetricListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMetrics not implemented")
+}
+func (UnimplementedSecurityServiceServer) ListSecurityStats(context.Context, *SecurityStatListRequest) (*SecurityStatListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecurityStats not implemented")
+}
+func (UnimplementedSecurityServiceServer) ListSecurityMetrics(context.Context, *SecurityMetricListRequest) (*SecurityMetricListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecurityMetrics not implemented")
+}
+func (UnimplementedSecurityServiceServer) ListMarketDays(context.Context, *MarketDayListRequest) (*MarketDayListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMarketDays not implemented")
+}
+func (UnimplementedSecurityServiceServer) ListMarketHolidays(context.Context, *MarketHolidayListRequest) (*MarketHolidayListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMarketHolidays not implemented")
+}
+func (UnimplementedSecurityServiceServer) StreamPrices(*StreamPricesRequest, SecurityService_StreamPricesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPrices not implemented")
+}
 func (UnimplementedSecurityServiceServer) mustEmbedUnimplementedSecurityServiceServer() {}
 
 // UnsafeSecurityServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -88,6 +209,135 @@ func _SecurityService_Index_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SecurityService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecurityReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServiceServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/security.SecurityService/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServiceServer).Read(ctx, req.(*SecurityReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityService_ListMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetricListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServiceServer).ListMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/security.SecurityService/ListMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServiceServer).ListMetrics(ctx, req.(*MetricListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityService_ListSecurityStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecurityStatListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServiceServer).ListSecurityStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/security.SecurityService/ListSecurityStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServiceServer).ListSecurityStats(ctx, req.(*SecurityStatListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityService_ListSecurityMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecurityMetricListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServiceServer).ListSecurityMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/security.SecurityService/ListSecurityMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServiceServer).ListSecurityMetrics(ctx, req.(*SecurityMetricListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityService_ListMarketDays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketDayListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServiceServer).ListMarketDays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/security.SecurityService/ListMarketDays",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServiceServer).ListMarketDays(ctx, req.(*MarketDayListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityService_ListMarketHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketHolidayListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServiceServer).ListMarketHolidays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/security.SecurityService/ListMarketHolidays",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServiceServer).ListMarketHolidays(ctx, req.(*MarketHolidayListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityService_StreamPrices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPricesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SecurityServiceServer).StreamPrices(m, &securityServiceStreamPricesServer{stream})
+}
+
+type SecurityService_StreamPricesServer interface {
+	Send(*PriceUpdate) error
+	grpc.ServerStream
+}
+
+type securityServiceStreamPricesServer struct {
+	grpc.ServerStream
+}
+
+func (x *securityServiceStreamPricesServer) Send(m *PriceUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // SecurityService_ServiceDesc is the grpc.ServiceDesc for SecurityService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -99,7 +349,37 @@ var SecurityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Index",
 			Handler:    _SecurityService_Index_Handler,
 		},
+		{
+			MethodName: "Read",
+			Handler:    _SecurityService_Read_Handler,
+		},
+		{
+			MethodName: "ListMetrics",
+			Handler:    _SecurityService_ListMetrics_Handler,
+		},
+		{
+			MethodName: "ListSecurityStats",
+			Handler:    _SecurityService_ListSecurityStats_Handler,
+		},
+		{
+			MethodName: "ListSecurityMetrics",
+			Handler:    _SecurityService_ListSecurityMetrics_Handler,
+		},
+		{
+			MethodName: "ListMarketDays",
+			Handler:    _SecurityService_ListMarketDays_Handler,
+		},
+		{
+			MethodName: "ListMarketHolidays",
+			Handler:    _SecurityService_ListMarketHolidays_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPrices",
+			Handler:       _SecurityService_StreamPrices_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "security.proto",
 }
@@ -16,14 +16,11 @@ import (
 	"google.golang.org/grpc/status"
 
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
-
-	"github.com/stratifyr/security-service/internal/services"
 )
 
 // NewSecurityServiceGoFrServer creates a new instance of SecurityServiceGoFrServer
-func NewSecurityServiceGoFrServer(svc services.SecurityService) *SecurityServiceGoFrServer {
+func NewSecurityServiceGoFrServer() *SecurityServiceGoFrServer {
 	return &SecurityServiceGoFrServer{
-		svc: svc,
 		health: getOrCreateHealthServer(), // Initialize the health server
 	}
 }
@@ -31,6 +28,13 @@ func NewSecurityServiceGoFrServer(svc services.SecurityService) *SecurityService
 // SecurityServiceServerWithGofr is the interface for the server implementation
 type SecurityServiceServerWithGofr interface {
 	Index(*gofr.Context) (any, error)
+	Read(*gofr.Context) (any, error)
+	ListMetrics(*gofr.Context) (any, error)
+	ListSecurityStats(*gofr.Context) (any, error)
+	ListSecurityMetrics(*gofr.Context) (any, error)
+	ListMarketDays(*gofr.Context) (any, error)
+	ListMarketHolidays(*gofr.Context) (any, error)
+	StreamPrices(*gofr.Context, SecurityService_StreamPricesServer) error
 }
 
 // SecurityServiceServerWrapper wraps the server and handles request and response logic
@@ -61,6 +65,115 @@ func (h *SecurityServiceServerWrapper) Index(ctx context.Context, req *SecurityI
 	return resp, nil
 }
 
+// Unary method handler for Read
+func (h *SecurityServiceServerWrapper) Read(ctx context.Context, req *SecurityReadRequest) (*Security, error) {
+	gctx := h.getGofrContext(ctx, &SecurityReadRequestWrapper{ctx: ctx, SecurityReadRequest: req})
+	
+	res, err := h.server.Read(gctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, ok := res.(*Security)
+	if !ok {
+		return nil, status.Errorf(codes.Unknown, "unexpected response type %T", res)
+	}
+	
+	return resp, nil
+}
+
+// Unary method handler for ListMetrics
+func (h *SecurityServiceServerWrapper) ListMetrics(ctx context.Context, req *MetricListRequest) (*MetricListResponse, error) {
+	gctx := h.getGofrContext(ctx, &MetricListRequestWrapper{ctx: ctx, MetricListRequest: req})
+	
+	res, err := h.server.ListMetrics(gctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, ok := res.(*MetricListResponse)
+	if !ok {
+		return nil, status.Errorf(codes.Unknown, "unexpected response type %T", res)
+	}
+	
+	return resp, nil
+}
+
+// Unary method handler for ListSecurityStats
+func (h *SecurityServiceServerWrapper) ListSecurityStats(ctx context.Context, req *SecurityStatListRequest) (*SecurityStatListResponse, error) {
+	gctx := h.getGofrContext(ctx, &SecurityStatListRequestWrapper{ctx: ctx, SecurityStatListRequest: req})
+	
+	res, err := h.server.ListSecurityStats(gctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, ok := res.(*SecurityStatListResponse)
+	if !ok {
+		return nil, status.Errorf(codes.Unknown, "unexpected response type %T", res)
+	}
+	
+	return resp, nil
+}
+
+// Unary method handler for ListSecurityMetrics
+func (h *SecurityServiceServerWrapper) ListSecurityMetrics(ctx context.Context, req *SecurityMetricListRequest) (*SecurityMetricListResponse, error) {
+	gctx := h.getGofrContext(ctx, &SecurityMetricListRequestWrapper{ctx: ctx, SecurityMetricListRequest: req})
+	
+	res, err := h.server.ListSecurityMetrics(gctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, ok := res.(*SecurityMetricListResponse)
+	if !ok {
+		return nil, status.Errorf(codes.Unknown, "unexpected response type %T", res)
+	}
+	
+	return resp, nil
+}
+
+// Unary method handler for ListMarketDays
+func (h *SecurityServiceServerWrapper) ListMarketDays(ctx context.Context, req *MarketDayListRequest) (*MarketDayListResponse, error) {
+	gctx := h.getGofrContext(ctx, &MarketDayListRequestWrapper{ctx: ctx, MarketDayListRequest: req})
+	
+	res, err := h.server.ListMarketDays(gctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, ok := res.(*MarketDayListResponse)
+	if !ok {
+		return nil, status.Errorf(codes.Unknown, "unexpected response type %T", res)
+	}
+	
+	return resp, nil
+}
+
+// Unary method handler for ListMarketHolidays
+func (h *SecurityServiceServerWrapper) ListMarketHolidays(ctx context.Context, req *MarketHolidayListRequest) (*MarketHolidayListResponse, error) {
+	gctx := h.getGofrContext(ctx, &MarketHolidayListRequestWrapper{ctx: ctx, MarketHolidayListRequest: req})
+	
+	res, err := h.server.ListMarketHolidays(gctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, ok := res.(*MarketHolidayListResponse)
+	if !ok {
+		return nil, status.Errorf(codes.Unknown, "unexpected response type %T", res)
+	}
+	
+	return resp, nil
+}
+
+// Server streaming method handler for StreamPrices
+func (h *SecurityServiceServerWrapper) StreamPrices(req *StreamPricesRequest, stream SecurityService_StreamPricesServer) error {
+	gctx := h.getGofrContext(stream.Context(), &StreamPricesRequestWrapper{ctx: stream.Context(), StreamPricesRequest: req})
+
+	return h.server.StreamPrices(gctx, stream)
+}
+
 // mustEmbedUnimplementedSecurityServiceServer ensures implementation
 func (h *SecurityServiceServerWrapper) mustEmbedUnimplementedSecurityServiceServer() {}
 
@@ -1,27 +1,84 @@
 package grpc
 
 import (
+	"errors"
+	"net"
 	"time"
 
 	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
+	"github.com/stratifyr/security-service/internal/auth"
+	"github.com/stratifyr/security-service/internal/handlers"
 	"github.com/stratifyr/security-service/internal/services"
 )
 
+const (
+	defaultPerPage      = 20
+	maxPerPage          = 500
+	maxStreamSecurities = 500
+)
+
 type SecurityServiceGoFrServer struct {
-	svc    services.SecurityService
-	health *healthServer
+	svc                   services.SecurityService
+	metricService         services.MetricService
+	securityStatService   services.SecurityStatService
+	securityMetricService services.SecurityMetricService
+	marketDayService      services.MarketDayService
+	marketHolidayService  services.MarketHolidayService
+	entitlementService    services.EntitlementService
+	rateLimitService      services.RateLimitService
+	priceHub              services.PriceHub
+	authenticator         *auth.Authenticator
+	health                *healthServer
 
 	UnimplementedSecurityServiceServer
 }
 
+// NewSecurityServer wires the dependencies of the SecurityService into the server created by the generated
+// NewSecurityServiceGoFrServer, which only knows about the health server.
+func NewSecurityServer(svc services.SecurityService, metricService services.MetricService,
+	securityStatService services.SecurityStatService, securityMetricService services.SecurityMetricService,
+	marketDayService services.MarketDayService, marketHolidayService services.MarketHolidayService,
+	entitlementService services.EntitlementService, rateLimitService services.RateLimitService,
+	priceHub services.PriceHub, authenticator *auth.Authenticator) *SecurityServiceGoFrServer {
+	s := NewSecurityServiceGoFrServer()
+
+	s.svc = svc
+	s.metricService = metricService
+	s.securityStatService = securityStatService
+	s.securityMetricService = securityMetricService
+	s.marketDayService = marketDayService
+	s.marketHolidayService = marketHolidayService
+	s.entitlementService = entitlementService
+	s.rateLimitService = rateLimitService
+	s.priceHub = priceHub
+	s.authenticator = authenticator
+
+	return s
+}
+
 func (s *SecurityServiceGoFrServer) Index(ctx *gofr.Context) (any, error) {
+	if err := s.prepare(ctx, services.FeatureScreener); err != nil {
+		return nil, err
+	}
+
 	var payload SecurityIndexRequest
 
 	if err := ctx.Bind(&payload); err != nil {
 		return nil, err
 	}
 
+	page, perPage, err := pagination(payload.Page, payload.PerPage)
+	if err != nil {
+		return nil, err
+	}
+
 	var ids []int
 
 	if len(payload.Ids) > 0 {
@@ -31,68 +88,589 @@ func (s *SecurityServiceGoFrServer) Index(ctx *gofr.Context) (any, error) {
 	}
 
 	filter := &services.SecurityFilter{
-		UserID: int(payload.UserId),
-		IDs:    ids,
-		ISIN:   payload.Isin,
-		Symbol: payload.Symbol,
+		IDs:            ids,
+		ISIN:           payload.Isin,
+		Symbol:         payload.Symbol,
+		WatchlistID:    int(payload.WatchlistId),
+		IndexID:        int(payload.IndexId),
+		LotSize:        int(payload.LotSize),
+		Surveillance:   payload.UnderSurveillance,
+		MTFEligible:    payload.MtfEligible,
+		InstrumentType: payload.InstrumentType,
 	}
 
-	securities, count, err := s.svc.Index(ctx, filter, 0, 0)
+	securities, count, err := s.svc.Index(ctx, filter, page, perPage)
 	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &SecurityIndexResponse{
+		Securities: make([]*Security, len(securities)),
+		Total:      int32(count),
+		Page:       int32(page),
+		PerPage:    int32(perPage),
+	}
+
+	for i := range securities {
+		resp.Securities[i] = s.buildSecurity(securities[i])
+	}
+
+	return resp, nil
+}
+
+func (s *SecurityServiceGoFrServer) Read(ctx *gofr.Context) (any, error) {
+	if err := s.prepare(ctx); err != nil {
 		return nil, err
 	}
 
-	return s.buildResponse(securities, count)
+	var payload SecurityReadRequest
+
+	if err := ctx.Bind(&payload); err != nil {
+		return nil, err
+	}
+
+	if payload.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+
+	security, err := s.svc.Read(ctx, int(payload.Id))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return s.buildSecurity(security), nil
 }
 
-func (s *SecurityServiceGoFrServer) buildResponse(securities []*services.Security, count int) (*SecurityIndexResponse, error) {
-	resp := &SecurityIndexResponse{
-		Securities: make([]*Security, len(securities)),
+func (s *SecurityServiceGoFrServer) ListMetrics(ctx *gofr.Context) (any, error) {
+	if err := s.prepare(ctx); err != nil {
+		return nil, err
+	}
+
+	var payload MetricListRequest
+
+	if err := ctx.Bind(&payload); err != nil {
+		return nil, err
+	}
+
+	page, perPage, err := pagination(payload.Page, payload.PerPage)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &services.MetricFilter{
+		Type:   payload.Type,
+		Period: int(payload.Period),
+	}
+
+	metrics, count, err := s.metricService.Index(ctx, filter, page, perPage)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &MetricListResponse{
+		Metrics: make([]*MetricDefinition, len(metrics)),
+		Total:   int32(count),
+		Page:    int32(page),
+		PerPage: int32(perPage),
+	}
+
+	for i := range metrics {
+		resp.Metrics[i] = &MetricDefinition{
+			Id:        int32(metrics[i].ID),
+			Name:      metrics[i].Name,
+			Type:      metrics[i].Type,
+			Period:    int32(metrics[i].Period),
+			Indicator: metrics[i].Indicator,
+			Tier:      int32(metrics[i].Tier),
+			CreatedAt: metrics[i].CreatedAt.Format(time.RFC3339),
+			UpdatedAt: metrics[i].UpdatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *SecurityServiceGoFrServer) ListSecurityStats(ctx *gofr.Context) (any, error) {
+	if err := s.prepare(ctx, services.FeatureHistory); err != nil {
+		return nil, err
+	}
+
+	var payload SecurityStatListRequest
+
+	if err := ctx.Bind(&payload); err != nil {
+		return nil, err
+	}
+
+	page, perPage, err := pagination(payload.Page, payload.PerPage)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &services.SecurityStatFilter{SecurityID: int(payload.SecurityId)}
+
+	if filter.Date, err = parseDate("date", payload.Date); err != nil {
+		return nil, err
+	}
+
+	securityStats, count, err := s.securityStatService.Index(ctx, filter, page, perPage)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &SecurityStatListResponse{
+		SecurityStats: make([]*SecurityStat, len(securityStats)),
+		Total:         int32(count),
+		Page:          int32(page),
+		PerPage:       int32(perPage),
+	}
+
+	for i := range securityStats {
+		resp.SecurityStats[i] = &SecurityStat{
+			Id:         int32(securityStats[i].ID),
+			SecurityId: int32(securityStats[i].SecurityID),
+			Date:       securityStats[i].Date.Format(time.DateOnly),
+			Open:       securityStats[i].Open,
+			Close:      securityStats[i].Close,
+			High:       securityStats[i].High,
+			Low:        securityStats[i].Low,
+			Volume:     int32(securityStats[i].Volume),
+			CreatedAt:  securityStats[i].CreatedAt.Format(time.RFC3339),
+			UpdatedAt:  securityStats[i].UpdatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *SecurityServiceGoFrServer) ListSecurityMetrics(ctx *gofr.Context) (any, error) {
+	if err := s.prepare(ctx, services.FeatureHistory); err != nil {
+		return nil, err
+	}
+
+	var payload SecurityMetricListRequest
+
+	if err := ctx.Bind(&payload); err != nil {
+		return nil, err
+	}
+
+	page, perPage, err := pagination(payload.Page, payload.PerPage)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &services.SecurityMetricFilter{
+		SecurityID: int(payload.SecurityId),
+		MetricID:   int(payload.MetricId),
+	}
+
+	if filter.Date, err = parseDate("date", payload.Date); err != nil {
+		return nil, err
+	}
+
+	securityMetrics, count, err := s.securityMetricService.Index(ctx, filter, page, perPage)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &SecurityMetricListResponse{
+		SecurityMetrics: make([]*SecurityMetric, len(securityMetrics)),
+		Total:           int32(count),
+		Page:            int32(page),
+		PerPage:         int32(perPage),
+	}
+
+	for i := range securityMetrics {
+		resp.SecurityMetrics[i] = &SecurityMetric{
+			Id:         int32(securityMetrics[i].ID),
+			SecurityId: int32(securityMetrics[i].SecurityID),
+			MetricId:   int32(securityMetrics[i].MetricID),
+			Date:       securityMetrics[i].Date.Format(time.DateOnly),
+			Value:      securityMetrics[i].Value,
+			CreatedAt:  securityMetrics[i].CreatedAt.Format(time.RFC3339),
+			UpdatedAt:  securityMetrics[i].UpdatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *SecurityServiceGoFrServer) ListMarketDays(ctx *gofr.Context) (any, error) {
+	if err := s.prepare(ctx); err != nil {
+		return nil, err
+	}
+
+	var payload MarketDayListRequest
+
+	if err := ctx.Bind(&payload); err != nil {
+		return nil, err
+	}
+
+	page, perPage, err := pagination(payload.Page, payload.PerPage)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &services.MarketDayFilter{LastNDays: int(payload.LastNDays)}
+
+	if payload.StartDate != "" || payload.EndDate != "" {
+		filter.DateBetween = &struct {
+			StartDate time.Time
+			EndDate   time.Time
+		}{}
+
+		if filter.DateBetween.StartDate, err = parseDate("start_date", payload.StartDate); err != nil {
+			return nil, err
+		}
+
+		if filter.DateBetween.EndDate, err = parseDate("end_date", payload.EndDate); err != nil {
+			return nil, err
+		}
+	}
+
+	marketDays, count, err := s.marketDayService.Index(ctx, filter)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	start := min((page-1)*perPage, len(marketDays))
+	end := min(start+perPage, len(marketDays))
+
+	resp := &MarketDayListResponse{
+		MarketDays: make([]string, end-start),
 		Total:      int32(count),
+		Page:       int32(page),
+		PerPage:    int32(perPage),
+	}
+
+	for i := range resp.MarketDays {
+		resp.MarketDays[i] = marketDays[start+i].Format(time.DateOnly)
+	}
+
+	return resp, nil
+}
+
+func (s *SecurityServiceGoFrServer) ListMarketHolidays(ctx *gofr.Context) (any, error) {
+	if err := s.prepare(ctx); err != nil {
+		return nil, err
 	}
 
-	for i := range resp.Securities {
-		resp.Securities[i] = &Security{
-			Id:            int32(securities[i].ID),
-			Isin:          securities[i].ISIN,
+	var payload MarketHolidayListRequest
+
+	if err := ctx.Bind(&payload); err != nil {
+		return nil, err
+	}
+
+	page, perPage, err := pagination(payload.Page, payload.PerPage)
+	if err != nil {
+		return nil, err
+	}
+
+	var filter services.MarketHolidayFilter
+
+	if filter.Date, err = parseDate("date", payload.Date); err != nil {
+		return nil, err
+	}
+
+	if payload.StartDate != "" || payload.EndDate != "" {
+		filter.DateBetween = &struct {
+			StartDate time.Time
+			EndDate   time.Time
+		}{}
+
+		if filter.DateBetween.StartDate, err = parseDate("start_date", payload.StartDate); err != nil {
+			return nil, err
+		}
+
+		if filter.DateBetween.EndDate, err = parseDate("end_date", payload.EndDate); err != nil {
+			return nil, err
+		}
+	}
+
+	marketHolidays, count, err := s.marketHolidayService.Index(ctx, &filter, page, perPage)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &MarketHolidayListResponse{
+		MarketHolidays: make([]*MarketHoliday, len(marketHolidays)),
+		Total:          int32(count),
+		Page:           int32(page),
+		PerPage:        int32(perPage),
+	}
+
+	for i := range marketHolidays {
+		resp.MarketHolidays[i] = &MarketHoliday{
+			Id:          int32(marketHolidays[i].ID),
+			Date:        marketHolidays[i].Date.Format(time.DateOnly),
+			Description: marketHolidays[i].Description,
+			CreatedAt:   marketHolidays[i].CreatedAt.Format(time.RFC3339),
+			UpdatedAt:   marketHolidays[i].UpdatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *SecurityServiceGoFrServer) StreamPrices(ctx *gofr.Context, stream SecurityService_StreamPricesServer) error {
+	if err := s.prepare(ctx, services.FeatureScreener); err != nil {
+		return err
+	}
+
+	entitlement, err := s.entitlementService.Resolve(ctx)
+	if err != nil {
+		return toStatus(err)
+	}
+
+	if entitlement.LTPDelay > 0 {
+		return status.Error(codes.PermissionDenied, "your plan does not include live prices")
+	}
+
+	var payload StreamPricesRequest
+
+	if err = ctx.Bind(&payload); err != nil {
+		return err
+	}
+
+	if len(payload.SecurityIds) == 0 || len(payload.SecurityIds) > maxStreamSecurities {
+		return status.Errorf(codes.InvalidArgument, "security_ids must contain between 1 and %d ids", maxStreamSecurities)
+	}
+
+	ids := make([]int, len(payload.SecurityIds))
+
+	for i := range payload.SecurityIds {
+		ids[i] = int(payload.SecurityIds[i])
+	}
+
+	securities, _, err := s.svc.Index(ctx, &services.SecurityFilter{IDs: ids}, 1, len(ids))
+	if err != nil {
+		return toStatus(err)
+	}
+
+	if len(securities) == 0 {
+		return status.Error(codes.NotFound, "no securities found for the requested ids")
+	}
+
+	ids = ids[:0]
+
+	for i := range securities {
+		ids = append(ids, securities[i].ID)
+	}
+
+	subscription := s.priceHub.Subscribe(ids)
+	defer subscription.Close()
+
+	for i := range securities {
+		err = stream.Send(&PriceUpdate{
+			SecurityId:    int32(securities[i].ID),
 			Symbol:        securities[i].Symbol,
-			Industry:      securities[i].Industry,
-			Name:          securities[i].Name,
-			Image:         securities[i].Image,
 			Ltp:           securities[i].LTP,
 			PreviousClose: securities[i].PreviousClose,
-			Tier:          int32(securities[i].Tier),
-			CreatedAt:     securities[i].CreatedAt.Format(time.RFC3339),
 			UpdatedAt:     securities[i].UpdatedAt.Format(time.RFC3339),
+		})
+		if err != nil {
+			return err
 		}
+	}
 
-		if securities[i].SecurityStat == nil {
-			continue
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-subscription.Ready():
+			for _, update := range subscription.Drain() {
+				err = stream.Send(&PriceUpdate{
+					SecurityId:    int32(update.SecurityID),
+					Symbol:        update.Symbol,
+					Ltp:           update.LTP,
+					PreviousClose: update.PreviousClose,
+					UpdatedAt:     update.UpdatedAt.Format(time.RFC3339),
+				})
+				if err != nil {
+					return err
+				}
+			}
 		}
+	}
+}
 
-		resp.Securities[i].MarketData = &MarketData{
-			Date:    securities[i].SecurityStat.Date.Format(time.DateOnly),
-			Open:    securities[i].SecurityStat.Open,
-			Close:   securities[i].SecurityStat.Close,
-			High:    securities[i].SecurityStat.High,
-			Low:     securities[i].SecurityStat.Low,
-			Volume:  int32(securities[i].SecurityStat.Volume),
-			Metrics: make([]*Metric, len(securities[i].SecurityMetrics)),
+func (s *SecurityServiceGoFrServer) prepare(ctx *gofr.Context, features ...services.Feature) error {
+	if err := s.authenticate(ctx); err != nil {
+		return err
+	}
+
+	if err := s.rateLimit(ctx); err != nil {
+		return err
+	}
+
+	for _, feature := range features {
+		if _, err := s.entitlementService.Require(ctx, feature); err != nil {
+			return toStatus(err)
 		}
+	}
 
-		for j := range resp.Securities[i].MarketData.Metrics {
-			resp.Securities[i].MarketData.Metrics[j] = &Metric{
-				Id:              int32(securities[i].SecurityMetrics[j].Metric.ID),
-				Name:            securities[i].SecurityMetrics[j].Metric.Name,
-				Type:            securities[i].SecurityMetrics[j].Metric.Type,
-				Period:          int32(securities[i].SecurityMetrics[j].Metric.Period),
-				Indicator:       securities[i].SecurityMetrics[j].Metric.Indicator,
-				Tier:            int32(securities[i].SecurityMetrics[j].Metric.Tier),
-				Value:           securities[i].SecurityMetrics[j].Value,
-				NormalizedValue: securities[i].SecurityMetrics[j].NormalizedValue,
-			}
+	return nil
+}
+
+func (s *SecurityServiceGoFrServer) authenticate(ctx *gofr.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx.Context)
+
+	principal, err := s.authenticator.Authenticate(ctx, firstValue(md, "authorization"), firstValue(md, "x-api-key"))
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	ctx.Context = auth.WithTransport(ctx.Context, auth.SourceGRPC)
+
+	if principal != nil {
+		ctx.Context = auth.WithPrincipal(ctx.Context, principal)
+	}
+
+	return nil
+}
+
+func (s *SecurityServiceGoFrServer) rateLimit(ctx *gofr.Context) error {
+	var clientIP string
+
+	if p, ok := peer.FromContext(ctx.Context); ok {
+		clientIP, _, _ = net.SplitHostPort(p.Addr.String())
+	}
+
+	rateLimit, err := s.rateLimitService.Allow(ctx, clientIP)
+	if err != nil {
+		ctx.Logger.Errorf("failed to resolve rate limit, err: %v", err)
+
+		return status.Error(codes.Unavailable, "something went wrong!")
+	}
+
+	if headers := handlers.RateLimitHeaders(rateLimit); headers != nil {
+		_ = grpc.SetHeader(ctx.Context, metadata.New(headers))
+	}
+
+	if !rateLimit.Allowed {
+		return status.Error(codes.ResourceExhausted, "rate limit exceeded")
+	}
+
+	return nil
+}
+
+func (s *SecurityServiceGoFrServer) buildSecurity(security *services.Security) *Security {
+	resp := &Security{
+		Id:             int32(security.ID),
+		Isin:           security.ISIN,
+		Symbol:         security.Symbol,
+		InstrumentType: security.InstrumentType,
+		Industry:       security.Industry,
+		Name:           security.Name,
+		Image:          security.Image,
+		Ltp:            security.LTP,
+		PreviousClose:  security.PreviousClose,
+		Tier:           int32(security.Tier),
+		CreatedAt:      security.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      security.UpdatedAt.Format(time.RFC3339),
+	}
+
+	if security.Tradability != nil {
+		resp.Tradability = &Tradability{
+			LotSize:              int32(security.Tradability.LotSize),
+			TickSize:             security.Tradability.TickSize,
+			SurveillanceFlag:     security.Tradability.SurveillanceFlag,
+			SurveillanceCategory: security.Tradability.SurveillanceCategory,
+			UnderSurveillance:    security.Tradability.UnderSurveillance,
+			MtfLeverage:          security.Tradability.MTFLeverage,
 		}
 	}
 
-	return resp, nil
+	if security.SecurityStat == nil {
+		return resp
+	}
+
+	resp.MarketData = &MarketData{
+		Date:    security.SecurityStat.Date.Format(time.DateOnly),
+		Open:    security.SecurityStat.Open,
+		Close:   security.SecurityStat.Close,
+		High:    security.SecurityStat.High,
+		Low:     security.SecurityStat.Low,
+		Volume:  int32(security.SecurityStat.Volume),
+		Metrics: make([]*Metric, len(security.SecurityMetrics)),
+	}
+
+	for i := range resp.MarketData.Metrics {
+		resp.MarketData.Metrics[i] = &Metric{
+			Id:              int32(security.SecurityMetrics[i].Metric.ID),
+			Name:            security.SecurityMetrics[i].Metric.Name,
+			Type:            security.SecurityMetrics[i].Metric.Type,
+			Period:          int32(security.SecurityMetrics[i].Metric.Period),
+			Indicator:       security.SecurityMetrics[i].Metric.Indicator,
+			Tier:            int32(security.SecurityMetrics[i].Metric.Tier),
+			Value:           security.SecurityMetrics[i].Value,
+			NormalizedValue: security.SecurityMetrics[i].NormalizedValue,
+		}
+	}
+
+	return resp
+}
+
+func pagination(page, perPage int32) (int, int, error) {
+	if page < 0 {
+		return 0, 0, status.Error(codes.InvalidArgument, "invalid page")
+	}
+
+	if perPage < 0 || perPage > maxPerPage {
+		return 0, 0, status.Error(codes.InvalidArgument, "invalid per_page")
+	}
+
+	if page == 0 {
+		page = 1
+	}
+
+	if perPage == 0 {
+		perPage = defaultPerPage
+	}
+
+	return int(page), int(perPage), nil
+}
+
+func parseDate(field, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, status.Error(codes.InvalidArgument, "invalid "+field)
+	}
+
+	return date, nil
+}
+
+func firstValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+func toStatus(err error) error {
+	var errResp *services.ErrResp
+
+	if errors.As(err, &http.ErrorEntityNotFound{}) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	if !errors.As(err, &errResp) {
+		return err
+	}
+
+	switch errResp.Code {
+	case 400:
+		return status.Error(codes.InvalidArgument, errResp.Message)
+	case 401:
+		return status.Error(codes.Unauthenticated, errResp.Message)
+	case 403:
+		return status.Error(codes.PermissionDenied, errResp.Message)
+	default:
+		return err
+	}
 }
@@ -1,12 +1,69 @@
 package handlers
 
 import (
+	"strconv"
+	"time"
+
 	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
 	"gofr.dev/pkg/gofr/http/response"
 
 	"github.com/stratifyr/security-service/internal/services"
 )
 
+type Industry struct {
+	ID        int      `json:"id"`
+	Name      string   `json:"name"`
+	Level     string   `json:"level"`
+	ParentID  *int     `json:"parentId"`
+	Path      []string `json:"path"`
+	CreatedAt string   `json:"createdAt"`
+	UpdatedAt string   `json:"updatedAt"`
+}
+
+type IndustryCreate struct {
+	Name     string `json:"name"`
+	Level    string `json:"level"`
+	ParentID *int   `json:"parentId"`
+}
+
+type IndustryUpdate struct {
+	Name     string `json:"name"`
+	ParentID *int   `json:"parentId"`
+}
+
+type IndustryAssignment struct {
+	ID            int     `json:"id"`
+	SecurityID    int     `json:"securityId"`
+	IndustryID    int     `json:"industryId"`
+	Industry      string  `json:"industry"`
+	EffectiveFrom string  `json:"effectiveFrom"`
+	EffectiveTo   *string `json:"effectiveTo"`
+	CreatedAt     string  `json:"createdAt"`
+	UpdatedAt     string  `json:"updatedAt"`
+}
+
+type IndustryAssignmentCreate struct {
+	IndustryID    int    `json:"industryId"`
+	Industry      string `json:"industry"`
+	EffectiveFrom string `json:"effectiveFrom"`
+}
+
+type IndustrySummary struct {
+	Industry           string   `json:"industry"`
+	Date               string   `json:"date"`
+	Securities         int      `json:"securities"`
+	Advances           int      `json:"advances"`
+	Declines           int      `json:"declines"`
+	Unchanged          int      `json:"unchanged"`
+	MedianReturn       *float64 `json:"medianReturn"`
+	WeightedReturn     *float64 `json:"weightedReturn"`
+	WeightingBasis     string   `json:"weightingBasis,omitempty"`
+	WeightingCoverage  *float64 `json:"weightingCoverage"`
+	AverageRSI         *float64 `json:"averageRsi"`
+	PercentAboveSMA200 *float64 `json:"percentAboveSma200"`
+}
+
 type industryHandler struct {
 	svc services.IndustryService
 }
@@ -16,9 +73,296 @@ func NewIndustryHandler(svc services.IndustryService) *industryHandler {
 }
 
 func (h *industryHandler) Index(ctx *gofr.Context) (interface{}, error) {
-	industries := h.svc.Index(ctx)
+	var (
+		filter services.IndustryFilter
+		err    error
+	)
+
+	if ctx.Param("level") != "" {
+		filter.Level = ctx.Param("level")
+	}
+
+	if ctx.Param("parentId") != "" {
+		filter.ParentID, err = strconv.Atoi(ctx.Param("parentId"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"parentId"}}
+		}
+	}
+
+	industries, err := h.svc.Index(ctx, &filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp = make([]*Industry, len(industries))
+
+	for i := range industries {
+		resp[i] = h.buildResp(industries[i])
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": resp,
+	}}, nil
+}
+
+func (h *industryHandler) Read(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	industry, err := h.svc.Read(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(industry),
+	}}, nil
+}
+
+func (h *industryHandler) Create(ctx *gofr.Context) (interface{}, error) {
+	var payload IndustryCreate
+
+	if err := ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	model := &services.IndustryCreate{
+		Name:     payload.Name,
+		Level:    payload.Level,
+		ParentID: payload.ParentID,
+	}
+
+	industry, err := h.svc.Create(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(industry),
+	}}, nil
+}
+
+func (h *industryHandler) Patch(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var payload IndustryUpdate
+
+	if err := ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	model := &services.IndustryUpdate{
+		Name:     payload.Name,
+		ParentID: payload.ParentID,
+	}
+
+	industry, err := h.svc.Patch(ctx, id, model)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(industry),
+	}}, nil
+}
+
+func (h *industryHandler) Delete(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	if err = h.svc.Delete(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (h *industryHandler) Assignments(ctx *gofr.Context) (interface{}, error) {
+	securityID, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	assignments, err := h.svc.Assignments(ctx, securityID)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp = make([]*IndustryAssignment, len(assignments))
+
+	for i := range assignments {
+		resp[i] = h.buildAssignmentResp(assignments[i])
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": resp,
+	}}, nil
+}
+
+func (h *industryHandler) Assign(ctx *gofr.Context) (interface{}, error) {
+	securityID, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var payload IndustryAssignmentCreate
+
+	if err = ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	model := &services.IndustryAssignmentCreate{
+		IndustryID: payload.IndustryID,
+		Industry:   payload.Industry,
+	}
+
+	if payload.EffectiveFrom != "" {
+		model.EffectiveFrom, err = time.Parse(time.DateOnly, payload.EffectiveFrom)
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"effectiveFrom"}}
+		}
+	}
+
+	assignment, err := h.svc.Assign(ctx, securityID, model)
+	if err != nil {
+		return nil, err
+	}
 
 	return response.Raw{Data: map[string]any{
-		"data": industries,
+		"data": h.buildAssignmentResp(assignment),
 	}}, nil
 }
+
+func (h *industryHandler) Unassign(ctx *gofr.Context) (interface{}, error) {
+	securityID, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	id, err := strconv.Atoi(ctx.PathParam("assignmentId"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"assignmentId"}}
+	}
+
+	if err = h.svc.Unassign(ctx, securityID, id); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (h *industryHandler) Summary(ctx *gofr.Context) (interface{}, error) {
+	var (
+		date time.Time
+		err  error
+	)
+
+	if ctx.Param("date") != "" {
+		date, err = time.Parse(time.DateOnly, ctx.Param("date"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"date"}}
+		}
+	}
+
+	summary, err := h.svc.Summary(ctx, ctx.PathParam("name"), date)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildSummaryResp(summary),
+	}}, nil
+}
+
+func (h *industryHandler) Summaries(ctx *gofr.Context) (interface{}, error) {
+	var (
+		filter services.IndustrySummaryFilter
+		err    error
+	)
+
+	if ctx.Param("from") != "" {
+		filter.From, err = time.Parse(time.DateOnly, ctx.Param("from"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"from"}}
+		}
+	}
+
+	if ctx.Param("to") != "" {
+		filter.To, err = time.Parse(time.DateOnly, ctx.Param("to"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"to"}}
+		}
+	}
+
+	summaries, err := h.svc.Summaries(ctx, ctx.PathParam("name"), &filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp = make([]*IndustrySummary, len(summaries))
+
+	for i := range summaries {
+		resp[i] = h.buildSummaryResp(summaries[i])
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": resp,
+	}}, nil
+}
+
+func (h *industryHandler) buildResp(model *services.Industry) *Industry {
+	resp := &Industry{
+		ID:        model.ID,
+		Name:      model.Name,
+		Level:     model.Level,
+		ParentID:  model.ParentID,
+		Path:      model.Path,
+		CreatedAt: model.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: model.UpdatedAt.Format(time.RFC3339),
+	}
+
+	return resp
+}
+
+func (h *industryHandler) buildAssignmentResp(model *services.IndustryAssignment) *IndustryAssignment {
+	resp := &IndustryAssignment{
+		ID:            model.ID,
+		SecurityID:    model.SecurityID,
+		IndustryID:    model.IndustryID,
+		Industry:      model.Industry,
+		EffectiveFrom: model.EffectiveFrom.Format(time.DateOnly),
+		CreatedAt:     model.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     model.UpdatedAt.Format(time.RFC3339),
+	}
+
+	if model.EffectiveTo != nil {
+		effectiveTo := model.EffectiveTo.Format(time.DateOnly)
+		resp.EffectiveTo = &effectiveTo
+	}
+
+	return resp
+}
+
+func (h *industryHandler) buildSummaryResp(model *services.IndustrySummary) *IndustrySummary {
+	return &IndustrySummary{
+		Industry:           model.Industry,
+		Date:               model.Date.Format(time.DateOnly),
+		Securities:         model.Securities,
+		Advances:           model.Advances,
+		Declines:           model.Declines,
+		Unchanged:          model.Unchanged,
+		MedianReturn:       model.MedianReturn,
+		WeightedReturn:     model.WeightedReturn,
+		WeightingBasis:     model.WeightingBasis,
+		WeightingCoverage:  model.WeightingCoverage,
+		AverageRSI:         model.AverageRSI,
+		PercentAboveSMA200: model.PercentAboveSMA200,
+	}
+}
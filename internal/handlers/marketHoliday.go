@@ -21,13 +21,11 @@ type MarketHoliday struct {
 }
 
 type MarketHolidayCreate struct {
-	UserID      int    `json:"userId"`
 	Date        string `json:"date"`
 	Description string `json:"description"`
 }
 
 type MarketHolidayUpdate struct {
-	UserID      int    `json:"userId"`
 	Date        string `json:"date"`
 	Description string `json:"description"`
 }
@@ -141,7 +139,6 @@ func (h *marketHolidayHandler) Create(ctx *gofr.Context) (interface{}, error) {
 	}
 
 	model := &services.MarketHolidayCreate{
-		UserID:      payload.UserID,
 		Date:        date,
 		Description: payload.Description,
 	}
@@ -178,7 +175,6 @@ func (h *marketHolidayHandler) Patch(ctx *gofr.Context) (interface{}, error) {
 	}
 
 	model := &services.MarketHolidayUpdate{
-		UserID:      payload.UserID,
 		Date:        date,
 		Description: payload.Description,
 	}
@@ -199,12 +195,7 @@ func (h *marketHolidayHandler) Delete(ctx *gofr.Context) (interface{}, error) {
 		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
 	}
 
-	userID, err := strconv.Atoi(ctx.Param("userId"))
-	if err != nil {
-		return nil, http.ErrorInvalidParam{Params: []string{"userId"}}
-	}
-
-	err = h.svc.Delete(ctx, id, userID)
+	err = h.svc.Delete(ctx, id)
 	if err != nil {
 		return nil, err
 	}
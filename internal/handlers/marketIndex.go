@@ -0,0 +1,284 @@
+package handlers
+
+import (
+	"strconv"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
+
+	"github.com/stratifyr/security-service/internal/services"
+)
+
+type MarketIndex struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Symbol    string `json:"symbol"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type MarketIndexCreate struct {
+	Name   string `json:"name"`
+	Symbol string `json:"symbol"`
+}
+
+type MarketIndexUpdate struct {
+	Name   string `json:"name"`
+	Symbol string `json:"symbol"`
+}
+
+type IndexConstituent struct {
+	SecurityID    int      `json:"securityId"`
+	Symbol        string   `json:"symbol"`
+	Weight        *float64 `json:"weight"`
+	EffectiveFrom string   `json:"effectiveFrom"`
+	EffectiveTo   *string  `json:"effectiveTo"`
+}
+
+type IndexConstituentsReplace struct {
+	EffectiveFrom string                    `json:"effectiveFrom"`
+	Constituents  []*IndexConstituentCreate `json:"constituents"`
+}
+
+type IndexConstituentCreate struct {
+	SecurityID int      `json:"securityId"`
+	Weight     *float64 `json:"weight"`
+}
+
+type marketIndexHandler struct {
+	svc services.MarketIndexService
+}
+
+func NewMarketIndexHandler(svc services.MarketIndexService) *marketIndexHandler {
+	return &marketIndexHandler{svc: svc}
+}
+
+func (h *marketIndexHandler) Index(ctx *gofr.Context) (interface{}, error) {
+	var (
+		filter services.MarketIndexFilter
+		err    error
+	)
+
+	if ctx.Param("name") != "" {
+		filter.Name = ctx.Param("name")
+	}
+
+	if ctx.Param("symbol") != "" {
+		filter.Symbol = ctx.Param("symbol")
+	}
+
+	page := 1
+	if ctx.Param("page") != "" {
+		page, err = strconv.Atoi(ctx.Param("page"))
+		if err != nil || page < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"page"}}
+		}
+	}
+
+	perPage := 20
+	if ctx.Param("perPage") != "" {
+		perPage, err = strconv.Atoi(ctx.Param("perPage"))
+		if err != nil || perPage < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"perPage"}}
+		}
+	}
+
+	marketIndices, count, err := h.svc.Index(ctx, &filter, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp = make([]*MarketIndex, len(marketIndices))
+
+	for i := range marketIndices {
+		resp[i] = h.buildResp(marketIndices[i])
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": resp,
+		"meta": map[string]any{
+			"page":    page,
+			"perPage": perPage,
+			"total":   count,
+		},
+	}}, nil
+}
+
+func (h *marketIndexHandler) Read(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	marketIndex, err := h.svc.Read(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(marketIndex),
+	}}, nil
+}
+
+func (h *marketIndexHandler) Create(ctx *gofr.Context) (interface{}, error) {
+	var payload MarketIndexCreate
+
+	if err := ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	model := &services.MarketIndexCreate{
+		Name:   payload.Name,
+		Symbol: payload.Symbol,
+	}
+
+	marketIndex, err := h.svc.Create(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(marketIndex),
+	}}, nil
+}
+
+func (h *marketIndexHandler) Patch(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var payload MarketIndexUpdate
+
+	if err := ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	model := &services.MarketIndexUpdate{
+		Name:   payload.Name,
+		Symbol: payload.Symbol,
+	}
+
+	marketIndex, err := h.svc.Patch(ctx, id, model)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(marketIndex),
+	}}, nil
+}
+
+func (h *marketIndexHandler) Delete(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	if err = h.svc.Delete(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (h *marketIndexHandler) Constituents(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var date time.Time
+
+	if ctx.Param("date") != "" {
+		date, err = time.Parse(time.DateOnly, ctx.Param("date"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"date"}}
+		}
+	}
+
+	constituents, err := h.svc.Constituents(ctx, id, date)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildConstituentsResp(constituents),
+	}}, nil
+}
+
+func (h *marketIndexHandler) ReplaceConstituents(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var payload IndexConstituentsReplace
+
+	if err = ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	model := &services.IndexConstituentsReplace{
+		Constituents: make([]*services.IndexConstituentCreate, len(payload.Constituents)),
+	}
+
+	if payload.EffectiveFrom != "" {
+		model.EffectiveFrom, err = time.Parse(time.DateOnly, payload.EffectiveFrom)
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"effectiveFrom"}}
+		}
+	}
+
+	for i, c := range payload.Constituents {
+		if c == nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"constituents"}}
+		}
+
+		model.Constituents[i] = &services.IndexConstituentCreate{
+			SecurityID: c.SecurityID,
+			Weight:     c.Weight,
+		}
+	}
+
+	constituents, err := h.svc.ReplaceConstituents(ctx, id, model)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildConstituentsResp(constituents),
+	}}, nil
+}
+
+func (h *marketIndexHandler) buildConstituentsResp(constituents []*services.IndexConstituent) []*IndexConstituent {
+	var resp = make([]*IndexConstituent, len(constituents))
+
+	for i, c := range constituents {
+		resp[i] = &IndexConstituent{
+			SecurityID:    c.SecurityID,
+			Symbol:        c.Symbol,
+			Weight:        c.Weight,
+			EffectiveFrom: c.EffectiveFrom.Format(time.DateOnly),
+		}
+
+		if c.EffectiveTo != nil {
+			effectiveTo := c.EffectiveTo.Format(time.DateOnly)
+			resp[i].EffectiveTo = &effectiveTo
+		}
+	}
+
+	return resp
+}
+
+func (h *marketIndexHandler) buildResp(marketIndex *services.MarketIndex) *MarketIndex {
+	return &MarketIndex{
+		ID:        marketIndex.ID,
+		Name:      marketIndex.Name,
+		Symbol:    marketIndex.Symbol,
+		CreatedAt: marketIndex.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: marketIndex.UpdatedAt.Format(time.RFC3339),
+	}
+}
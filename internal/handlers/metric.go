@@ -23,7 +23,6 @@ type Metric struct {
 }
 
 type MetricCreate struct {
-	UserID int    `json:"userId"`
 	Name   string `json:"name"`
 	Type   string `json:"type"`
 	Period int    `json:"period"`
@@ -31,9 +30,8 @@ type MetricCreate struct {
 }
 
 type MetricUpdate struct {
-	UserID int    `json:"userId"`
-	Name   string `json:"name"`
-	Tier   *int   `json:"tier"`
+	Name string `json:"name"`
+	Tier *int   `json:"tier"`
 }
 
 type metricHandler struct {
@@ -50,13 +48,6 @@ func (h *metricHandler) Index(ctx *gofr.Context) (interface{}, error) {
 		err    error
 	)
 
-	if ctx.Param("userId") != "" {
-		filter.UserID, err = strconv.Atoi(ctx.Param("userId"))
-		if err != nil {
-			return nil, http.ErrorInvalidParam{Params: []string{"userId"}}
-		}
-	}
-
 	if ctx.Param("type") != "" {
 		filter.Type = ctx.Param("type")
 	}
@@ -68,6 +59,10 @@ func (h *metricHandler) Index(ctx *gofr.Context) (interface{}, error) {
 		}
 	}
 
+	if ctx.Param("instrumentType") != "" {
+		filter.InstrumentType = ctx.Param("instrumentType")
+	}
+
 	page := 1
 	if ctx.Param("page") != "" {
 		page, err = strconv.Atoi(ctx.Param("page"))
@@ -129,7 +124,6 @@ func (h *metricHandler) Create(ctx *gofr.Context) (interface{}, error) {
 	}
 
 	model := &services.MetricCreate{
-		UserID: payload.UserID,
 		Name:   payload.Name,
 		Type:   payload.Type,
 		Period: payload.Period,
@@ -159,9 +153,8 @@ func (h *metricHandler) Patch(ctx *gofr.Context) (interface{}, error) {
 	}
 
 	model := &services.MetricUpdate{
-		UserID: payload.UserID,
-		Name:   payload.Name,
-		Tier:   payload.Tier,
+		Name: payload.Name,
+		Tier: payload.Tier,
 	}
 
 	metric, err := h.svc.Patch(ctx, id, model)
@@ -0,0 +1,469 @@
+package handlers
+
+import (
+	"strconv"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
+
+	"github.com/stratifyr/security-service/internal/services"
+)
+
+type Portfolio struct {
+	ID        int    `json:"id"`
+	UserID    int    `json:"userId"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type PortfolioCreate struct {
+	Name string `json:"name"`
+}
+
+type PortfolioUpdate struct {
+	Name string `json:"name"`
+}
+
+type PortfolioTransaction struct {
+	ID          int     `json:"id"`
+	PortfolioID int     `json:"portfolioId"`
+	SecurityID  int     `json:"securityId"`
+	Type        string  `json:"type"`
+	Quantity    float64 `json:"quantity"`
+	Price       float64 `json:"price"`
+	Date        string  `json:"date"`
+	CreatedAt   string  `json:"createdAt"`
+}
+
+type PortfolioTransactionCreate struct {
+	SecurityID int     `json:"securityId"`
+	Type       string  `json:"type"`
+	Quantity   float64 `json:"quantity"`
+	Price      float64 `json:"price"`
+	Date       string  `json:"date"`
+}
+
+type PortfolioHolding struct {
+	SecurityID      int     `json:"securityId"`
+	Symbol          string  `json:"symbol"`
+	Name            string  `json:"name"`
+	Industry        string  `json:"industry"`
+	Quantity        float64 `json:"quantity"`
+	AverageCost     float64 `json:"averageCost"`
+	Invested        float64 `json:"invested"`
+	LTP             float64 `json:"ltp"`
+	LTPDelayMinutes int     `json:"ltpDelayMinutes"`
+	PreviousClose   float64 `json:"previousClose"`
+	Value           float64 `json:"value"`
+	DayChange       float64 `json:"dayChange"`
+	UnrealizedPnL   float64 `json:"unrealizedPnl"`
+	RealizedPnL     float64 `json:"realizedPnl"`
+	Priced          bool    `json:"priced"`
+}
+
+type PortfolioSummary struct {
+	Invested      float64                `json:"invested"`
+	Value         float64                `json:"value"`
+	DayChange     float64                `json:"dayChange"`
+	UnrealizedPnL float64                `json:"unrealizedPnl"`
+	RealizedPnL   float64                `json:"realizedPnl"`
+	XIRR          *float64               `json:"xirr"`
+	Allocation    []*PortfolioAllocation `json:"allocation"`
+}
+
+type PortfolioAllocation struct {
+	Industry string  `json:"industry"`
+	Value    float64 `json:"value"`
+	Weight   float64 `json:"weight"`
+}
+
+type PortfolioValuation struct {
+	Date          string  `json:"date"`
+	Invested      float64 `json:"invested"`
+	Value         float64 `json:"value"`
+	UnrealizedPnL float64 `json:"unrealizedPnl"`
+	RealizedPnL   float64 `json:"realizedPnl"`
+}
+
+type portfolioHandler struct {
+	svc services.PortfolioService
+}
+
+func NewPortfolioHandler(svc services.PortfolioService) *portfolioHandler {
+	return &portfolioHandler{svc: svc}
+}
+
+func (h *portfolioHandler) Index(ctx *gofr.Context) (interface{}, error) {
+	var err error
+
+	page := 1
+	if ctx.Param("page") != "" {
+		page, err = strconv.Atoi(ctx.Param("page"))
+		if err != nil || page < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"page"}}
+		}
+	}
+
+	perPage := 20
+	if ctx.Param("perPage") != "" {
+		perPage, err = strconv.Atoi(ctx.Param("perPage"))
+		if err != nil || perPage < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"perPage"}}
+		}
+	}
+
+	portfolios, count, err := h.svc.Index(ctx, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp = make([]*Portfolio, len(portfolios))
+
+	for i := range portfolios {
+		resp[i] = h.buildResp(portfolios[i])
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": resp,
+		"meta": map[string]any{
+			"page":    page,
+			"perPage": perPage,
+			"total":   count,
+		},
+	}}, nil
+}
+
+func (h *portfolioHandler) Read(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	portfolio, err := h.svc.Read(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(portfolio),
+	}}, nil
+}
+
+func (h *portfolioHandler) Create(ctx *gofr.Context) (interface{}, error) {
+	var payload PortfolioCreate
+
+	if err := ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	portfolio, err := h.svc.Create(ctx, &services.PortfolioCreate{Name: payload.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(portfolio),
+	}}, nil
+}
+
+func (h *portfolioHandler) Patch(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var payload PortfolioUpdate
+
+	if err = ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	portfolio, err := h.svc.Patch(ctx, id, &services.PortfolioUpdate{Name: payload.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(portfolio),
+	}}, nil
+}
+
+func (h *portfolioHandler) Delete(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	err = h.svc.Delete(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (h *portfolioHandler) Transactions(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	filter := services.PortfolioTransactionFilter{Type: ctx.Param("type")}
+
+	if ctx.Param("securityId") != "" {
+		filter.SecurityID, err = strconv.Atoi(ctx.Param("securityId"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"securityId"}}
+		}
+	}
+
+	if ctx.Param("from") != "" {
+		filter.From, err = time.Parse(time.DateOnly, ctx.Param("from"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"from"}}
+		}
+	}
+
+	if ctx.Param("to") != "" {
+		filter.To, err = time.Parse(time.DateOnly, ctx.Param("to"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"to"}}
+		}
+	}
+
+	page := 1
+	if ctx.Param("page") != "" {
+		page, err = strconv.Atoi(ctx.Param("page"))
+		if err != nil || page < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"page"}}
+		}
+	}
+
+	perPage := 20
+	if ctx.Param("perPage") != "" {
+		perPage, err = strconv.Atoi(ctx.Param("perPage"))
+		if err != nil || perPage < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"perPage"}}
+		}
+	}
+
+	portfolioTransactions, count, err := h.svc.IndexTransactions(ctx, id, &filter, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp = make([]*PortfolioTransaction, len(portfolioTransactions))
+
+	for i := range portfolioTransactions {
+		resp[i] = h.buildTransactionResp(portfolioTransactions[i])
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": resp,
+		"meta": map[string]any{
+			"page":    page,
+			"perPage": perPage,
+			"total":   count,
+		},
+	}}, nil
+}
+
+func (h *portfolioHandler) CreateTransaction(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var payload PortfolioTransactionCreate
+
+	if err = ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	date, err := time.Parse(time.DateOnly, payload.Date)
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"date"}}
+	}
+
+	model := &services.PortfolioTransactionCreate{
+		SecurityID: payload.SecurityID,
+		Type:       payload.Type,
+		Quantity:   payload.Quantity,
+		Price:      payload.Price,
+		Date:       date,
+	}
+
+	portfolioTransaction, err := h.svc.CreateTransaction(ctx, id, model)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildTransactionResp(portfolioTransaction),
+	}}, nil
+}
+
+func (h *portfolioHandler) DeleteTransaction(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	transactionID, err := strconv.Atoi(ctx.PathParam("transactionId"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"transactionId"}}
+	}
+
+	err = h.svc.DeleteTransaction(ctx, id, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (h *portfolioHandler) Holdings(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	holdings, err := h.svc.Holdings(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp = make([]*PortfolioHolding, len(holdings))
+
+	for i := range holdings {
+		resp[i] = &PortfolioHolding{
+			SecurityID:      holdings[i].SecurityID,
+			Symbol:          holdings[i].Symbol,
+			Name:            holdings[i].Name,
+			Industry:        holdings[i].Industry,
+			Quantity:        holdings[i].Quantity,
+			AverageCost:     holdings[i].AverageCost,
+			Invested:        holdings[i].Invested,
+			LTP:             holdings[i].LTP,
+			LTPDelayMinutes: int(holdings[i].LTPDelay.Minutes()),
+			PreviousClose:   holdings[i].PreviousClose,
+			Value:           holdings[i].Value,
+			DayChange:       holdings[i].DayChange,
+			UnrealizedPnL:   holdings[i].UnrealizedPnL,
+			RealizedPnL:     holdings[i].RealizedPnL,
+			Priced:          holdings[i].Priced,
+		}
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": resp,
+	}}, nil
+}
+
+func (h *portfolioHandler) Summary(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	summary, err := h.svc.Summary(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &PortfolioSummary{
+		Invested:      summary.Invested,
+		Value:         summary.Value,
+		DayChange:     summary.DayChange,
+		UnrealizedPnL: summary.UnrealizedPnL,
+		RealizedPnL:   summary.RealizedPnL,
+		XIRR:          summary.XIRR,
+		Allocation:    make([]*PortfolioAllocation, len(summary.Allocation)),
+	}
+
+	for i, allocation := range summary.Allocation {
+		resp.Allocation[i] = &PortfolioAllocation{
+			Industry: allocation.Industry,
+			Value:    allocation.Value,
+			Weight:   allocation.Weight,
+		}
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": resp,
+	}}, nil
+}
+
+func (h *portfolioHandler) Valuations(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var filter services.PortfolioValuationFilter
+
+	if ctx.Param("from") != "" {
+		filter.From, err = time.Parse(time.DateOnly, ctx.Param("from"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"from"}}
+		}
+	}
+
+	if ctx.Param("to") != "" {
+		filter.To, err = time.Parse(time.DateOnly, ctx.Param("to"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"to"}}
+		}
+	}
+
+	valuations, err := h.svc.Valuations(ctx, id, &filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp = make([]*PortfolioValuation, len(valuations))
+
+	for i := range valuations {
+		resp[i] = &PortfolioValuation{
+			Date:          valuations[i].Date.Format(time.DateOnly),
+			Invested:      valuations[i].Invested,
+			Value:         valuations[i].Value,
+			UnrealizedPnL: valuations[i].UnrealizedPnL,
+			RealizedPnL:   valuations[i].RealizedPnL,
+		}
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": resp,
+	}}, nil
+}
+
+func (h *portfolioHandler) buildResp(model *services.Portfolio) *Portfolio {
+	resp := &Portfolio{
+		ID:        model.ID,
+		UserID:    model.UserID,
+		Name:      model.Name,
+		CreatedAt: model.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: model.UpdatedAt.Format(time.RFC3339),
+	}
+
+	return resp
+}
+
+func (h *portfolioHandler) buildTransactionResp(model *services.PortfolioTransaction) *PortfolioTransaction {
+	resp := &PortfolioTransaction{
+		ID:          model.ID,
+		PortfolioID: model.PortfolioID,
+		SecurityID:  model.SecurityID,
+		Type:        model.Type,
+		Quantity:    model.Quantity,
+		Price:       model.Price,
+		Date:        model.Date.Format(time.DateOnly),
+		CreatedAt:   model.CreatedAt.Format(time.RFC3339),
+	}
+
+	return resp
+}
@@ -0,0 +1,335 @@
+package handlers
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/stratifyr/security-service/internal/services"
+)
+
+const (
+	maxPriceFeedSubscriptions = 50
+	delayedPriceFeedInterval  = time.Minute
+)
+
+type PriceFeedRequest struct {
+	Action      string   `json:"action"`
+	Symbols     []string `json:"symbols"`
+	WatchlistID int      `json:"watchlistId"`
+}
+
+type PriceFeedTick struct {
+	Type          string  `json:"type"`
+	SecurityID    int     `json:"securityId"`
+	Symbol        string  `json:"symbol"`
+	LTP           float64 `json:"ltp"`
+	PreviousClose float64 `json:"previousClose"`
+	Change        float64 `json:"change"`
+	ChangePercent float64 `json:"changePercent"`
+	DelayMinutes  int     `json:"delayMinutes"`
+	UpdatedAt     string  `json:"updatedAt"`
+}
+
+type PriceFeedSignal struct {
+	Type       string  `json:"type"`
+	SecurityID int     `json:"securityId"`
+	Symbol     string  `json:"symbol"`
+	MetricID   int     `json:"metricId"`
+	Metric     string  `json:"metric"`
+	Date       string  `json:"date"`
+	Value      float64 `json:"value"`
+}
+
+type PriceFeedStatus struct {
+	Type    string   `json:"type"`
+	Symbols []string `json:"symbols,omitempty"`
+	Message string   `json:"message,omitempty"`
+}
+
+type priceFeedHandler struct {
+	securityService    services.SecurityService
+	entitlementService services.EntitlementService
+	priceHub           services.PriceHub
+}
+
+func NewPriceFeedHandler(securityService services.SecurityService, entitlementService services.EntitlementService,
+	priceHub services.PriceHub) *priceFeedHandler {
+	return &priceFeedHandler{
+		securityService:    securityService,
+		entitlementService: entitlementService,
+		priceHub:           priceHub,
+	}
+}
+
+func (h *priceFeedHandler) Stream(ctx *gofr.Context) (interface{}, error) {
+	entitlement, err := h.entitlementService.Require(ctx, services.FeatureScreener)
+	if err != nil {
+		_ = ctx.WriteMessageToSocket(&PriceFeedStatus{Type: "error", Message: err.Error()})
+
+		return nil, err
+	}
+
+	requests := make(chan *PriceFeedRequest)
+	readErr := make(chan error, 1)
+
+	go func() {
+		for {
+			var request PriceFeedRequest
+
+			if err := ctx.Bind(&request); err != nil {
+				readErr <- err
+				return
+			}
+
+			select {
+			case requests <- &request:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var (
+		subscribed   = make(map[int]*services.Security)
+		subscription = h.priceHub.Subscribe(nil)
+		refresh      <-chan time.Time
+	)
+
+	defer func() { subscription.Close() }()
+
+	if entitlement.LTPDelay > 0 {
+		ticker := time.NewTicker(delayedPriceFeedInterval)
+		defer ticker.Stop()
+
+		refresh = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, nil
+		case err = <-readErr:
+			return nil, err
+		case request := <-requests:
+			changed, status := h.handleRequest(ctx, request, subscribed)
+
+			if changed {
+				subscription.Close()
+				subscription = h.priceHub.Subscribe(subscribedIDs(subscribed))
+			}
+
+			if err = ctx.WriteMessageToSocket(status); err != nil {
+				return nil, err
+			}
+
+			if request.Action != "subscribe" {
+				continue
+			}
+
+			for _, security := range subscribed {
+				if !slices.Contains(status.Symbols, security.Symbol) {
+					continue
+				}
+
+				if err = ctx.WriteMessageToSocket(buildTick(security, entitlement.LTPDelay)); err != nil {
+					return nil, err
+				}
+			}
+		case <-subscription.Ready():
+			if err = h.writeUpdates(ctx, subscription, subscribed, entitlement); err != nil {
+				return nil, err
+			}
+		case <-refresh:
+			if err = h.writeDelayedTicks(ctx, subscribed, entitlement.LTPDelay); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+func (h *priceFeedHandler) handleRequest(ctx *gofr.Context, request *PriceFeedRequest, subscribed map[int]*services.Security) (bool, *PriceFeedStatus) {
+	switch request.Action {
+	case "subscribe":
+		if request.WatchlistID != 0 {
+			securities, _, err := h.securityService.Index(ctx, &services.SecurityFilter{WatchlistID: request.WatchlistID}, 1,
+				maxPriceFeedSubscriptions)
+			if err != nil {
+				ctx.Logger.Errorf("failed to resolve price feed watchlist %d, err: %v", request.WatchlistID, err)
+
+				return false, &PriceFeedStatus{Type: "error", Message: fmt.Sprintf("failed to resolve watchlist %d", request.WatchlistID)}
+			}
+
+			for i := range securities {
+				request.Symbols = append(request.Symbols, securities[i].Symbol)
+			}
+		}
+
+		var (
+			symbols []string
+			seen    = make(map[string]bool, len(subscribed))
+		)
+
+		for _, security := range subscribed {
+			seen[security.Symbol] = true
+		}
+
+		for _, symbol := range request.Symbols {
+			if !seen[symbol] {
+				symbols = append(symbols, symbol)
+				seen[symbol] = true
+			}
+		}
+
+		if len(symbols) == 0 {
+			return false, &PriceFeedStatus{Type: "subscribed", Symbols: request.Symbols}
+		}
+
+		if len(subscribed)+len(symbols) > maxPriceFeedSubscriptions {
+			return false, &PriceFeedStatus{Type: "error",
+				Message: fmt.Sprintf("a connection can subscribe to at most %d symbols", maxPriceFeedSubscriptions)}
+		}
+
+		securities, _, err := h.securityService.Index(ctx, &services.SecurityFilter{Symbols: symbols}, 1, len(symbols))
+		if err != nil {
+			ctx.Logger.Errorf("failed to resolve price feed symbols, err: %v", err)
+
+			return false, &PriceFeedStatus{Type: "error", Message: "failed to subscribe, please retry"}
+		}
+
+		status := &PriceFeedStatus{Type: "subscribed"}
+
+		for i := range securities {
+			subscribed[securities[i].ID] = securities[i]
+			status.Symbols = append(status.Symbols, securities[i].Symbol)
+		}
+
+		if len(status.Symbols) < len(symbols) {
+			var missing []string
+
+			for _, symbol := range symbols {
+				if !slices.Contains(status.Symbols, symbol) {
+					missing = append(missing, symbol)
+				}
+			}
+
+			status.Message = "unknown or unavailable symbols - " + strings.Join(missing, ", ")
+		}
+
+		return len(securities) > 0, status
+	case "unsubscribe":
+		status := &PriceFeedStatus{Type: "unsubscribed"}
+
+		for id, security := range subscribed {
+			if slices.Contains(request.Symbols, security.Symbol) {
+				delete(subscribed, id)
+
+				status.Symbols = append(status.Symbols, security.Symbol)
+			}
+		}
+
+		return len(status.Symbols) > 0, status
+	default:
+		return false, &PriceFeedStatus{Type: "error", Message: "invalid action - " + request.Action}
+	}
+}
+
+func (h *priceFeedHandler) writeUpdates(ctx *gofr.Context, subscription *services.PriceSubscription,
+	subscribed map[int]*services.Security, entitlement *services.Entitlement) error {
+	for _, update := range subscription.Drain() {
+		security, ok := subscribed[update.SecurityID]
+		if !ok || entitlement.LTPDelay > 0 {
+			continue
+		}
+
+		security.LTP = update.LTP
+		security.PreviousClose = update.PreviousClose
+		security.UpdatedAt = update.UpdatedAt
+
+		if err := ctx.WriteMessageToSocket(buildTick(security, 0)); err != nil {
+			return err
+		}
+	}
+
+	for _, signal := range subscription.DrainSignals() {
+		security, ok := subscribed[signal.SecurityID]
+		if !ok || (entitlement.Tier != nil && signal.MetricTier > *entitlement.Tier) {
+			continue
+		}
+
+		err := ctx.WriteMessageToSocket(&PriceFeedSignal{
+			Type:       "signal",
+			SecurityID: signal.SecurityID,
+			Symbol:     security.Symbol,
+			MetricID:   signal.MetricID,
+			Metric:     signal.Metric,
+			Date:       signal.Date.Format(time.DateOnly),
+			Value:      signal.Value,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *priceFeedHandler) writeDelayedTicks(ctx *gofr.Context, subscribed map[int]*services.Security, delay time.Duration) error {
+	if len(subscribed) == 0 {
+		return nil
+	}
+
+	securities, _, err := h.securityService.Index(ctx, &services.SecurityFilter{IDs: subscribedIDs(subscribed)}, 1, len(subscribed))
+	if err != nil {
+		ctx.Logger.Warnf("failed to refresh delayed prices, err: %v", err)
+
+		return nil
+	}
+
+	for i := range securities {
+		previous, ok := subscribed[securities[i].ID]
+		if !ok || previous.LTP == securities[i].LTP {
+			continue
+		}
+
+		subscribed[securities[i].ID] = securities[i]
+
+		if err = ctx.WriteMessageToSocket(buildTick(securities[i], delay)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func buildTick(security *services.Security, delay time.Duration) *PriceFeedTick {
+	tick := &PriceFeedTick{
+		Type:          "tick",
+		SecurityID:    security.ID,
+		Symbol:        security.Symbol,
+		LTP:           security.LTP,
+		PreviousClose: security.PreviousClose,
+		DelayMinutes:  int(delay.Minutes()),
+		UpdatedAt:     security.UpdatedAt.Format(time.RFC3339),
+	}
+
+	if security.PreviousClose != 0 {
+		tick.Change = security.LTP - security.PreviousClose
+		tick.ChangePercent = tick.Change / security.PreviousClose * 100
+	}
+
+	return tick
+}
+
+func subscribedIDs(subscribed map[int]*services.Security) []int {
+	ids := make([]int, 0, len(subscribed))
+
+	for id := range subscribed {
+		ids = append(ids, id)
+	}
+
+	return ids
+}
@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+
+	"github.com/stratifyr/security-service/internal/services"
+)
+
+type rateLimitMiddleware struct {
+	svc            services.RateLimitService
+	trustedProxies []netip.Prefix
+}
+
+// NewRateLimitMiddleware limits anonymous requests by client address, X-Forwarded-For is only honoured on requests relayed by one
+// of trustedProxies.
+func NewRateLimitMiddleware(svc services.RateLimitService, trustedProxies []netip.Prefix) *rateLimitMiddleware {
+	return &rateLimitMiddleware{svc: svc, trustedProxies: trustedProxies}
+}
+
+// ParseTrustedProxies parses a comma separated list of proxy addresses and CIDR ranges.
+func ParseTrustedProxies(proxies string) ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+
+	for _, entry := range strings.Split(proxies, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+
+		if addr, err := netip.ParseAddr(entry); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %s", entry)
+		}
+
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes, nil
+}
+
+func (m *rateLimitMiddleware) Handle(c *container.Container, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/.well-known/") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := &gofr.Context{Context: r.Context(), Container: c}
+
+		rateLimit, err := m.svc.Allow(ctx, m.clientIP(r))
+		if err != nil {
+			c.Logger.Errorf("failed to resolve rate limit, err: %v", err)
+			writeRateLimitError(w, http.StatusServiceUnavailable, "something went wrong!")
+
+			return
+		}
+
+		for key, value := range RateLimitHeaders(rateLimit) {
+			w.Header().Set(key, value)
+		}
+
+		if !rateLimit.Allowed {
+			writeRateLimitError(w, http.StatusTooManyRequests, "rate limit exceeded")
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func writeRateLimitError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"error": map[string]any{
+			"message": message,
+		},
+	})
+}
+
+func RateLimitHeaders(rateLimit *services.RateLimit) map[string]string {
+	if rateLimit.Limit == 0 {
+		return nil
+	}
+
+	reset := strconv.Itoa(int(math.Ceil(rateLimit.Reset.Seconds())))
+
+	headers := map[string]string{
+		"X-RateLimit-Limit":     strconv.Itoa(rateLimit.Limit),
+		"X-RateLimit-Remaining": strconv.Itoa(rateLimit.Remaining),
+		"X-RateLimit-Reset":     reset,
+	}
+
+	if !rateLimit.Allowed {
+		headers["Retry-After"] = reset
+	}
+
+	return headers
+}
+
+// clientIP is the peer of r unless the peer is a trusted proxy. X-Forwarded-For is then read from the right, as every proxy appends
+// the address it received the request from, and the first hop that is not a trusted proxy is the client. Hops further left were
+// written by the client itself and are never used.
+func (m *rateLimitMiddleware) clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	if !m.trusted(host) {
+		return host
+	}
+
+	var hops []string
+
+	for _, forwarded := range r.Header.Values("X-Forwarded-For") {
+		for _, hop := range strings.Split(forwarded, ",") {
+			hops = append(hops, strings.TrimSpace(hop))
+		}
+	}
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(hops[i])
+		if err != nil {
+			return host
+		}
+
+		if !m.trusted(hops[i]) {
+			return addr.Unmap().String()
+		}
+
+		host = hops[i]
+	}
+
+	return host
+}
+
+func (m *rateLimitMiddleware) trusted(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+
+	for _, prefix := range m.trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestParseTrustedProxies(t *testing.T) {
+	tests := []struct {
+		proxies string
+		want    []string
+		wantErr bool
+	}{
+		{proxies: ""},
+		{proxies: "10.0.0.1", want: []string{"10.0.0.1/32"}},
+		{proxies: " 10.0.0.0/8 , 2001:db8::1 ", want: []string{"10.0.0.0/8", "2001:db8::1/128"}},
+		{proxies: "::ffff:10.0.0.1", want: []string{"10.0.0.1/32"}},
+		{proxies: "10.1.2.3/8", want: []string{"10.0.0.0/8"}},
+		{proxies: "10.0.0.1,proxy.internal", wantErr: true},
+		{proxies: "10.0.0.0/33", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		prefixes, err := ParseTrustedProxies(tc.proxies)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("ParseTrustedProxies(%q) err = %v, want error %v", tc.proxies, err, tc.wantErr)
+
+			continue
+		}
+
+		var got []string
+
+		for _, prefix := range prefixes {
+			got = append(got, prefix.String())
+		}
+
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("ParseTrustedProxies(%q) = %v, want %v", tc.proxies, got, tc.want)
+		}
+	}
+}
+
+func TestRateLimitMiddleware_ClientIP(t *testing.T) {
+	trustedProxies, err := ParseTrustedProxies("10.0.0.0/8,192.168.1.1")
+	if err != nil {
+		t.Fatalf("ParseTrustedProxies() err = %v", err)
+	}
+
+	m := NewRateLimitMiddleware(nil, trustedProxies)
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor []string
+		wantClientIP string
+	}{
+		{name: "direct client", remoteAddr: "203.0.113.7:5100", wantClientIP: "203.0.113.7"},
+		{name: "spoofed header from an untrusted peer", remoteAddr: "203.0.113.7:5100", forwardedFor: []string{"198.51.100.1"},
+			wantClientIP: "203.0.113.7"},
+		{name: "one trusted proxy", remoteAddr: "10.0.0.5:5100", forwardedFor: []string{"198.51.100.1"}, wantClientIP: "198.51.100.1"},
+		{name: "client written hops are ignored", remoteAddr: "10.0.0.5:5100", forwardedFor: []string{"1.1.1.1, 198.51.100.1"},
+			wantClientIP: "198.51.100.1"},
+		{name: "chain of trusted proxies", remoteAddr: "10.0.0.5:5100", forwardedFor: []string{"1.1.1.1, 198.51.100.1, 192.168.1.1", "10.0.0.9"},
+			wantClientIP: "198.51.100.1"},
+		{name: "mapped client address", remoteAddr: "10.0.0.5:5100", forwardedFor: []string{"::ffff:198.51.100.1"}, wantClientIP: "198.51.100.1"},
+		{name: "unparsable hop falls back to the last trusted proxy", remoteAddr: "10.0.0.5:5100",
+			forwardedFor: []string{"198.51.100.1, unknown, 10.0.0.9"}, wantClientIP: "10.0.0.9"},
+		{name: "only trusted hops", remoteAddr: "10.0.0.5:5100", forwardedFor: []string{"10.0.0.9"}, wantClientIP: "10.0.0.9"},
+		{name: "trusted peer without the header", remoteAddr: "10.0.0.5:5100", wantClientIP: "10.0.0.5"},
+		{name: "ipv6 peer", remoteAddr: "[2001:db8::7]:5100", wantClientIP: "2001:db8::7"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r, _ := http.NewRequest(http.MethodGet, "/securities", http.NoBody)
+			r.RemoteAddr = tc.remoteAddr
+
+			for _, forwarded := range tc.forwardedFor {
+				r.Header.Add("X-Forwarded-For", forwarded)
+			}
+
+			if got := m.clientIP(r); got != tc.wantClientIP {
+				t.Errorf("clientIP() = %s, want %s", got, tc.wantClientIP)
+			}
+		})
+	}
+}
@@ -12,18 +12,21 @@ import (
 )
 
 type Security struct {
-	ID            int     `json:"id"`
-	ISIN          string  `json:"isin"`
-	Symbol        string  `json:"symbol"`
-	Industry      string  `json:"industry"`
-	Name          string  `json:"name"`
-	Image         string  `json:"image"`
-	LTP           float64 `json:"ltp"`
-	PreviousClose float64 `json:"previousClose"`
-	Tier          int     `json:"tier"`
-	CreatedAt     string  `json:"createdAt"`
-	UpdatedAt     string  `json:"updatedAt"`
-	MarketData    *struct {
+	ID              int          `json:"id"`
+	ISIN            string       `json:"isin"`
+	Symbol          string       `json:"symbol"`
+	InstrumentType  string       `json:"instrumentType"`
+	Industry        string       `json:"industry"`
+	Name            string       `json:"name"`
+	Image           string       `json:"image"`
+	LTP             float64      `json:"ltp"`
+	LTPDelayMinutes int          `json:"ltpDelayMinutes"`
+	PreviousClose   float64      `json:"previousClose"`
+	Tier            int          `json:"tier"`
+	CreatedAt       string       `json:"createdAt"`
+	UpdatedAt       string       `json:"updatedAt"`
+	Tradability     *Tradability `json:"tradability"`
+	MarketData      *struct {
 		Date    string  `json:"date"`
 		Open    float64 `json:"open"`
 		Close   float64 `json:"close"`
@@ -44,24 +47,24 @@ type Security struct {
 }
 
 type SecurityCreate struct {
-	UserID   int     `json:"userId"`
-	ISIN     string  `json:"isin"`
-	Symbol   string  `json:"symbol"`
-	Industry string  `json:"industry"`
-	Name     string  `json:"name"`
-	Image    string  `json:"image"`
-	LTP      float64 `json:"ltp"`
-	Tier     int     `json:"tier"`
+	ISIN           string  `json:"isin"`
+	Symbol         string  `json:"symbol"`
+	InstrumentType string  `json:"instrumentType"`
+	Industry       string  `json:"industry"`
+	Name           string  `json:"name"`
+	Image          string  `json:"image"`
+	LTP            float64 `json:"ltp"`
+	Tier           int     `json:"tier"`
 }
 
 type SecurityUpdate struct {
-	UserID   int     `json:"userId"`
-	Symbol   string  `json:"symbol"`
-	Industry string  `json:"industry"`
-	Name     string  `json:"name"`
-	Image    string  `json:"image"`
-	LTP      float64 `json:"ltp"`
-	Tier     *int    `json:"tier"`
+	Symbol         string  `json:"symbol"`
+	InstrumentType string  `json:"instrumentType"`
+	Industry       string  `json:"industry"`
+	Name           string  `json:"name"`
+	Image          string  `json:"image"`
+	LTP            float64 `json:"ltp"`
+	Tier           *int    `json:"tier"`
 }
 
 type securityHandler struct {
@@ -78,13 +81,6 @@ func (h *securityHandler) Index(ctx *gofr.Context) (interface{}, error) {
 		err    error
 	)
 
-	if ctx.Param("userId") != "" {
-		filter.UserID, err = strconv.Atoi(ctx.Param("userId"))
-		if err != nil {
-			return nil, http.ErrorInvalidParam{Params: []string{"userId"}}
-		}
-	}
-
 	if ctx.Param("symbol") != "" {
 		filter.Symbol = ctx.Param("symbol")
 	}
@@ -93,6 +89,42 @@ func (h *securityHandler) Index(ctx *gofr.Context) (interface{}, error) {
 		filter.ISIN = ctx.Param("isin")
 	}
 
+	if ctx.Param("instrumentType") != "" {
+		filter.InstrumentType = ctx.Param("instrumentType")
+	}
+
+	if ctx.Param("indexId") != "" {
+		filter.IndexID, err = strconv.Atoi(ctx.Param("indexId"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"indexId"}}
+		}
+	}
+
+	if ctx.Param("lotSize") != "" {
+		filter.LotSize, err = strconv.Atoi(ctx.Param("lotSize"))
+		if err != nil || filter.LotSize < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"lotSize"}}
+		}
+	}
+
+	if ctx.Param("underSurveillance") != "" {
+		underSurveillance, err := strconv.ParseBool(ctx.Param("underSurveillance"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"underSurveillance"}}
+		}
+
+		filter.Surveillance = &underSurveillance
+	}
+
+	if ctx.Param("mtfEligible") != "" {
+		mtfEligible, err := strconv.ParseBool(ctx.Param("mtfEligible"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"mtfEligible"}}
+		}
+
+		filter.MTFEligible = &mtfEligible
+	}
+
 	page := 1
 	if ctx.Param("page") != "" {
 		page, err = strconv.Atoi(ctx.Param("page"))
@@ -136,16 +168,7 @@ func (h *securityHandler) Read(ctx *gofr.Context) (interface{}, error) {
 		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
 	}
 
-	var userID int
-
-	if ctx.Param("userId") != "" {
-		userID, err = strconv.Atoi(ctx.Param("userId"))
-		if err != nil {
-			return nil, http.ErrorInvalidParam{Params: []string{"userId"}}
-		}
-	}
-
-	security, err := h.svc.Read(ctx, id, userID)
+	security, err := h.svc.Read(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -163,14 +186,14 @@ func (h *securityHandler) Create(ctx *gofr.Context) (interface{}, error) {
 	}
 
 	model := &services.SecurityCreate{
-		UserID:   payload.UserID,
-		ISIN:     payload.ISIN,
-		Symbol:   payload.Symbol,
-		Industry: payload.Industry,
-		Name:     payload.Name,
-		Image:    payload.Image,
-		LTP:      payload.LTP,
-		Tier:     payload.Tier,
+		ISIN:           payload.ISIN,
+		Symbol:         payload.Symbol,
+		InstrumentType: payload.InstrumentType,
+		Industry:       payload.Industry,
+		Name:           payload.Name,
+		Image:          payload.Image,
+		LTP:            payload.LTP,
+		Tier:           payload.Tier,
 	}
 
 	security, err := h.svc.Create(ctx, model)
@@ -196,13 +219,13 @@ func (h *securityHandler) Patch(ctx *gofr.Context) (interface{}, error) {
 	}
 
 	model := &services.SecurityUpdate{
-		UserID:   payload.UserID,
-		Symbol:   payload.Symbol,
-		Industry: payload.Industry,
-		Name:     payload.Name,
-		Image:    payload.Image,
-		LTP:      payload.LTP,
-		Tier:     payload.Tier,
+		Symbol:         payload.Symbol,
+		InstrumentType: payload.InstrumentType,
+		Industry:       payload.Industry,
+		Name:           payload.Name,
+		Image:          payload.Image,
+		LTP:            payload.LTP,
+		Tier:           payload.Tier,
 	}
 
 	security, err := h.svc.Patch(ctx, id, model)
@@ -217,18 +240,31 @@ func (h *securityHandler) Patch(ctx *gofr.Context) (interface{}, error) {
 
 func (h *securityHandler) buildResp(model *services.Security) *Security {
 	resp := &Security{
-		ID:            model.ID,
-		ISIN:          model.ISIN,
-		Symbol:        model.Symbol,
-		Industry:      model.Industry,
-		Name:          model.Name,
-		Image:         model.Image,
-		LTP:           model.LTP,
-		Tier:          model.Tier,
-		PreviousClose: model.PreviousClose,
-		CreatedAt:     model.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:     model.UpdatedAt.Format(time.RFC3339),
-		MarketData:    nil,
+		ID:              model.ID,
+		ISIN:            model.ISIN,
+		Symbol:          model.Symbol,
+		InstrumentType:  model.InstrumentType,
+		Industry:        model.Industry,
+		Name:            model.Name,
+		Image:           model.Image,
+		LTP:             model.LTP,
+		LTPDelayMinutes: int(model.LTPDelay.Minutes()),
+		Tier:            model.Tier,
+		PreviousClose:   model.PreviousClose,
+		CreatedAt:       model.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       model.UpdatedAt.Format(time.RFC3339),
+		MarketData:      nil,
+	}
+
+	if model.Tradability != nil {
+		resp.Tradability = &Tradability{
+			LotSize:              model.Tradability.LotSize,
+			TickSize:             model.Tradability.TickSize,
+			SurveillanceFlag:     model.Tradability.SurveillanceFlag,
+			SurveillanceCategory: model.Tradability.SurveillanceCategory,
+			UnderSurveillance:    model.Tradability.UnderSurveillance,
+			MTFLeverage:          model.Tradability.MTFLeverage,
+		}
 	}
 
 	if model.SecurityStat == nil {
@@ -0,0 +1,300 @@
+package handlers
+
+import (
+	"strconv"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
+
+	"github.com/stratifyr/security-service/internal/services"
+)
+
+type SecurityFundamental struct {
+	ID                int      `json:"id"`
+	SecurityID        int      `json:"securityId"`
+	PeriodEnd         string   `json:"periodEnd"`
+	ReportedAt        *string  `json:"reportedAt"`
+	SharesOutstanding int64    `json:"sharesOutstanding"`
+	Revenue           *float64 `json:"revenue"`
+	NetIncome         *float64 `json:"netIncome"`
+	EPS               *float64 `json:"eps"`
+	BookValue         *float64 `json:"bookValue"`
+	DividendPerShare  *float64 `json:"dividendPerShare"`
+	CreatedAt         string   `json:"createdAt"`
+	UpdatedAt         string   `json:"updatedAt"`
+}
+
+type SecurityFundamentalCreate struct {
+	SecurityID        int      `json:"securityId"`
+	PeriodEnd         string   `json:"periodEnd"`
+	ReportedAt        string   `json:"reportedAt"`
+	SharesOutstanding int64    `json:"sharesOutstanding"`
+	Revenue           *float64 `json:"revenue"`
+	NetIncome         *float64 `json:"netIncome"`
+	EPS               *float64 `json:"eps"`
+	BookValue         *float64 `json:"bookValue"`
+	DividendPerShare  *float64 `json:"dividendPerShare"`
+}
+
+type SecurityFundamentalUpdate struct {
+	ReportedAt        string   `json:"reportedAt"`
+	SharesOutstanding int64    `json:"sharesOutstanding"`
+	Revenue           *float64 `json:"revenue"`
+	NetIncome         *float64 `json:"netIncome"`
+	EPS               *float64 `json:"eps"`
+	BookValue         *float64 `json:"bookValue"`
+	DividendPerShare  *float64 `json:"dividendPerShare"`
+}
+
+type Valuation struct {
+	SecurityID        int      `json:"securityId"`
+	Date              string   `json:"date"`
+	Price             float64  `json:"price"`
+	PeriodEnd         string   `json:"periodEnd"`
+	SharesOutstanding int64    `json:"sharesOutstanding"`
+	MarketCap         float64  `json:"marketCap"`
+	EPSTTM            *float64 `json:"epsTtm"`
+	BookValuePerShare *float64 `json:"bookValuePerShare"`
+	PE                *float64 `json:"pe"`
+	PB                *float64 `json:"pb"`
+	DividendYield     *float64 `json:"dividendYield"`
+}
+
+type securityFundamentalHandler struct {
+	svc services.SecurityFundamentalService
+}
+
+func NewSecurityFundamentalHandler(svc services.SecurityFundamentalService) *securityFundamentalHandler {
+	return &securityFundamentalHandler{svc: svc}
+}
+
+func (h *securityFundamentalHandler) Index(ctx *gofr.Context) (interface{}, error) {
+	var (
+		filter services.SecurityFundamentalFilter
+		err    error
+	)
+
+	if ctx.Param("securityId") != "" {
+		filter.SecurityID, err = strconv.Atoi(ctx.Param("securityId"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"securityId"}}
+		}
+	}
+
+	if ctx.Param("periodEnd") != "" {
+		filter.PeriodEnd, err = time.Parse(time.DateOnly, ctx.Param("periodEnd"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"periodEnd"}}
+		}
+	}
+
+	if ctx.Param("from") != "" {
+		filter.From, err = time.Parse(time.DateOnly, ctx.Param("from"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"from"}}
+		}
+	}
+
+	if ctx.Param("to") != "" {
+		filter.To, err = time.Parse(time.DateOnly, ctx.Param("to"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"to"}}
+		}
+	}
+
+	page := 1
+	if ctx.Param("page") != "" {
+		page, err = strconv.Atoi(ctx.Param("page"))
+		if err != nil || page < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"page"}}
+		}
+	}
+
+	perPage := 20
+	if ctx.Param("perPage") != "" {
+		perPage, err = strconv.Atoi(ctx.Param("perPage"))
+		if err != nil || perPage < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"perPage"}}
+		}
+	}
+
+	securityFundamentals, count, err := h.svc.Index(ctx, &filter, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp = make([]*SecurityFundamental, len(securityFundamentals))
+
+	for i := range securityFundamentals {
+		resp[i] = h.buildResp(securityFundamentals[i])
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": resp,
+		"meta": map[string]any{
+			"page":    page,
+			"perPage": perPage,
+			"total":   count,
+		},
+	}}, nil
+}
+
+func (h *securityFundamentalHandler) Read(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	securityFundamental, err := h.svc.Read(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(securityFundamental),
+	}}, nil
+}
+
+func (h *securityFundamentalHandler) Create(ctx *gofr.Context) (interface{}, error) {
+	var payload SecurityFundamentalCreate
+
+	if err := ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	periodEnd, err := time.Parse(time.DateOnly, payload.PeriodEnd)
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"periodEnd"}}
+	}
+
+	model := &services.SecurityFundamentalCreate{
+		SecurityID:        payload.SecurityID,
+		PeriodEnd:         periodEnd,
+		SharesOutstanding: payload.SharesOutstanding,
+		Revenue:           payload.Revenue,
+		NetIncome:         payload.NetIncome,
+		EPS:               payload.EPS,
+		BookValue:         payload.BookValue,
+		DividendPerShare:  payload.DividendPerShare,
+	}
+
+	if payload.ReportedAt != "" {
+		reportedAt, err := time.Parse(time.DateOnly, payload.ReportedAt)
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"reportedAt"}}
+		}
+
+		model.ReportedAt = &reportedAt
+	}
+
+	securityFundamental, err := h.svc.Create(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(securityFundamental),
+	}}, nil
+}
+
+func (h *securityFundamentalHandler) Patch(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var payload SecurityFundamentalUpdate
+
+	if err = ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	model := &services.SecurityFundamentalUpdate{
+		SharesOutstanding: payload.SharesOutstanding,
+		Revenue:           payload.Revenue,
+		NetIncome:         payload.NetIncome,
+		EPS:               payload.EPS,
+		BookValue:         payload.BookValue,
+		DividendPerShare:  payload.DividendPerShare,
+	}
+
+	if payload.ReportedAt != "" {
+		reportedAt, err := time.Parse(time.DateOnly, payload.ReportedAt)
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"reportedAt"}}
+		}
+
+		model.ReportedAt = &reportedAt
+	}
+
+	securityFundamental, err := h.svc.Patch(ctx, id, model)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(securityFundamental),
+	}}, nil
+}
+
+func (h *securityFundamentalHandler) Valuation(ctx *gofr.Context) (interface{}, error) {
+	securityID, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var date time.Time
+
+	if ctx.Param("date") != "" {
+		date, err = time.Parse(time.DateOnly, ctx.Param("date"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"date"}}
+		}
+	}
+
+	valuation, err := h.svc.Valuation(ctx, securityID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": &Valuation{
+			SecurityID:        valuation.SecurityID,
+			Date:              valuation.Date.Format(time.DateOnly),
+			Price:             valuation.Price,
+			PeriodEnd:         valuation.PeriodEnd.Format(time.DateOnly),
+			SharesOutstanding: valuation.SharesOutstanding,
+			MarketCap:         valuation.MarketCap,
+			EPSTTM:            valuation.EPSTTM,
+			BookValuePerShare: valuation.BookValuePerShare,
+			PE:                valuation.PE,
+			PB:                valuation.PB,
+			DividendYield:     valuation.DividendYield,
+		},
+	}}, nil
+}
+
+func (h *securityFundamentalHandler) buildResp(model *services.SecurityFundamental) *SecurityFundamental {
+	resp := &SecurityFundamental{
+		ID:                model.ID,
+		SecurityID:        model.SecurityID,
+		PeriodEnd:         model.PeriodEnd.Format(time.DateOnly),
+		SharesOutstanding: model.SharesOutstanding,
+		Revenue:           model.Revenue,
+		NetIncome:         model.NetIncome,
+		EPS:               model.EPS,
+		BookValue:         model.BookValue,
+		DividendPerShare:  model.DividendPerShare,
+		CreatedAt:         model.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         model.UpdatedAt.Format(time.RFC3339),
+	}
+
+	if model.ReportedAt != nil {
+		reportedAt := model.ReportedAt.Format(time.DateOnly)
+		resp.ReportedAt = &reportedAt
+	}
+
+	return resp
+}
@@ -23,7 +23,6 @@ type SecurityMetric struct {
 }
 
 type SecurityMetricCreate struct {
-	UserID     int     `json:"userId"`
 	SecurityID int     `json:"securityId"`
 	MetricID   int     `json:"metricId"`
 	Date       string  `json:"date"`
@@ -31,7 +30,6 @@ type SecurityMetricCreate struct {
 }
 
 type SecurityMetricUpdate struct {
-	UserID         int     `json:"userId"`
 	Value          float64 `json:"value"`
 	RecomputeValue bool    `json:"recomputeValue"`
 }
@@ -137,7 +135,6 @@ func (h *securityMetricHandler) Create(ctx *gofr.Context) (interface{}, error) {
 	}
 
 	model := &services.SecurityMetricCreate{
-		UserID:     payload.UserID,
 		SecurityID: payload.SecurityID,
 		MetricID:   payload.MetricID,
 		Date:       date,
@@ -154,6 +151,52 @@ func (h *securityMetricHandler) Create(ctx *gofr.Context) (interface{}, error) {
 	}}, nil
 }
 
+func (h *securityMetricHandler) BulkUpsert(ctx *gofr.Context) (interface{}, error) {
+	var payload []*SecurityMetricCreate
+
+	if err := ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	if len(payload) == 0 {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	var (
+		models  []*services.SecurityMetricCreate
+		indices []int
+		failed  []*services.BulkUpsertResult
+	)
+
+	for i := range payload {
+		if payload[i] == nil {
+			failed = append(failed, &services.BulkUpsertResult{Index: i, Status: services.BulkUpsertFailed, Error: "row is null"})
+			continue
+		}
+
+		date, err := time.Parse(time.DateOnly, payload[i].Date)
+		if err != nil {
+			failed = append(failed, &services.BulkUpsertResult{Index: i, Status: services.BulkUpsertFailed, Error: "invalid date - " + payload[i].Date})
+			continue
+		}
+
+		models = append(models, &services.SecurityMetricCreate{
+			SecurityID: payload[i].SecurityID,
+			MetricID:   payload[i].MetricID,
+			Date:       date,
+			Value:      payload[i].Value,
+		})
+		indices = append(indices, i)
+	}
+
+	results, err := h.svc.BulkUpsert(ctx, models)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildBulkUpsertResp(mergeBulkUpsertResults(results, indices, failed)), nil
+}
+
 func (h *securityMetricHandler) Patch(ctx *gofr.Context) (interface{}, error) {
 	id, err := strconv.Atoi(ctx.PathParam("id"))
 	if err != nil {
@@ -167,7 +210,6 @@ func (h *securityMetricHandler) Patch(ctx *gofr.Context) (interface{}, error) {
 	}
 
 	model := &services.SecurityMetricUpdate{
-		UserID:         payload.UserID,
 		Value:          payload.Value,
 		RecomputeValue: payload.RecomputeValue,
 	}
@@ -1,8 +1,14 @@
 package handlers
 
 import (
+	"encoding/csv"
+	"errors"
 	"fmt"
+	"io"
+	"mime/multipart"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"gofr.dev/pkg/gofr"
@@ -26,7 +32,6 @@ type SecurityStat struct {
 }
 
 type SecurityStatCreate struct {
-	UserID     int     `json:"userId"`
 	SecurityID int     `json:"securityId"`
 	Date       string  `json:"date"`
 	Open       float64 `json:"open"`
@@ -37,7 +42,6 @@ type SecurityStatCreate struct {
 }
 
 type SecurityStatUpdate struct {
-	UserID int     `json:"userId"`
 	Open   float64 `json:"open"`
 	Close  float64 `json:"close"`
 	High   float64 `json:"high"`
@@ -45,6 +49,52 @@ type SecurityStatUpdate struct {
 	Volume int     `json:"volume"`
 }
 
+type BulkUpsertResult struct {
+	Index  int    `json:"index"`
+	ID     int    `json:"id,omitempty"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type SecurityStatImportResult struct {
+	Line   int    `json:"line"`
+	ID     int    `json:"id,omitempty"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// securityStatImportColumns maps lower cased CSV headers onto import fields, the NSE bhavcopy headers are accepted as they are.
+var securityStatImportColumns = map[string]string{
+	"isin":        "isin",
+	"symbol":      "symbol",
+	"tckrsymb":    "symbol",
+	"date":        "date",
+	"timestamp":   "date",
+	"traddt":      "date",
+	"open":        "open",
+	"opnpric":     "open",
+	"high":        "high",
+	"hghpric":     "high",
+	"low":         "low",
+	"lwpric":      "low",
+	"close":       "close",
+	"clspric":     "close",
+	"volume":      "volume",
+	"tottrdqty":   "volume",
+	"ttltradgvol": "volume",
+	"series":      "series",
+	"sctysrs":     "series",
+}
+
+var securityStatImportDateLayouts = []string{time.DateOnly, "02-Jan-2006"}
+
+// securityStatImportSeries is the only series imported, a bhavcopy also lists securities under BE, BZ, BL and other series whose
+// prices are not the regular market's. Rows of other series are reported as skipped.
+const (
+	securityStatImportSeries  = "EQ"
+	securityStatImportSkipped = "skipped"
+)
+
 type securityStatHandler struct {
 	svc services.SecurityStatService
 }
@@ -139,7 +189,6 @@ func (h *securityStatHandler) Create(ctx *gofr.Context) (interface{}, error) {
 	}
 
 	model := &services.SecurityStatCreate{
-		UserID:     payload.UserID,
 		SecurityID: payload.SecurityID,
 		Date:       date,
 		Open:       payload.Open,
@@ -159,6 +208,198 @@ func (h *securityStatHandler) Create(ctx *gofr.Context) (interface{}, error) {
 	}}, nil
 }
 
+func (h *securityStatHandler) BulkUpsert(ctx *gofr.Context) (interface{}, error) {
+	var payload []*SecurityStatCreate
+
+	if err := ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	if len(payload) == 0 {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	var (
+		models  []*services.SecurityStatCreate
+		indices []int
+		failed  []*services.BulkUpsertResult
+	)
+
+	for i := range payload {
+		if payload[i] == nil {
+			failed = append(failed, &services.BulkUpsertResult{Index: i, Status: services.BulkUpsertFailed, Error: "row is null"})
+			continue
+		}
+
+		date, err := time.Parse(time.DateOnly, payload[i].Date)
+		if err != nil {
+			failed = append(failed, &services.BulkUpsertResult{Index: i, Status: services.BulkUpsertFailed, Error: "invalid date - " + payload[i].Date})
+			continue
+		}
+
+		models = append(models, &services.SecurityStatCreate{
+			SecurityID: payload[i].SecurityID,
+			Date:       date,
+			Open:       payload[i].Open,
+			Close:      payload[i].Close,
+			High:       payload[i].High,
+			Low:        payload[i].Low,
+			Volume:     payload[i].Volume,
+		})
+		indices = append(indices, i)
+	}
+
+	results, err := h.svc.BulkUpsert(ctx, models)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildBulkUpsertResp(mergeBulkUpsertResults(results, indices, failed)), nil
+}
+
+func (h *securityStatHandler) Import(ctx *gofr.Context) (interface{}, error) {
+	var payload struct {
+		File *multipart.FileHeader `file:"file"`
+	}
+
+	if err := ctx.Bind(&payload); err != nil || payload.File == nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"file"}}
+	}
+
+	file, err := payload.File.Open()
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"file"}}
+	}
+
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"file"}}
+	}
+
+	columns := make(map[string]int)
+
+	for i := range header {
+		field, ok := securityStatImportColumns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(header[i], "\ufeff")))]
+		if !ok {
+			continue
+		}
+
+		if _, exists := columns[field]; !exists {
+			columns[field] = i
+		}
+	}
+
+	var missing []string
+
+	for _, field := range []string{"date", "open", "high", "low", "close", "volume"} {
+		if _, ok := columns[field]; !ok {
+			missing = append(missing, field)
+		}
+	}
+
+	_, hasISIN := columns["isin"]
+	_, hasSymbol := columns["symbol"]
+
+	if !hasISIN && !hasSymbol {
+		missing = append(missing, "isin or symbol")
+	}
+
+	if len(missing) > 0 {
+		return nil, http.ErrorMissingParam{Params: missing}
+	}
+
+	var (
+		rows   []*services.SecurityStatImport
+		lines  []int
+		report []*SecurityStatImportResult
+	)
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var parseErr *csv.ParseError
+
+		if errors.As(err, &parseErr) {
+			report = append(report, &SecurityStatImportResult{Line: parseErr.Line, Status: services.BulkUpsertFailed, Error: parseErr.Err.Error()})
+			continue
+		}
+
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"file"}}
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		if i, ok := columns["series"]; ok && i < len(record) {
+			if series := strings.ToUpper(strings.TrimSpace(record[i])); series != "" && series != securityStatImportSeries {
+				report = append(report, &SecurityStatImportResult{Line: line, Status: securityStatImportSkipped,
+					Error: fmt.Sprintf("series %s is not imported, only %s is", series, securityStatImportSeries)})
+				continue
+			}
+		}
+
+		row, err := parseSecurityStatImportRow(record, columns)
+		if err != nil {
+			report = append(report, &SecurityStatImportResult{Line: line, Status: services.BulkUpsertFailed, Error: err.Error()})
+			continue
+		}
+
+		rows = append(rows, row)
+		lines = append(lines, line)
+	}
+
+	if len(rows) == 0 && len(report) == 0 {
+		return nil, http.ErrorInvalidParam{Params: []string{"file"}}
+	}
+
+	results, err := h.svc.Import(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range results {
+		report = append(report, &SecurityStatImportResult{
+			Line:   lines[results[i].Index],
+			ID:     results[i].ID,
+			Status: results[i].Status,
+			Error:  results[i].Error,
+		})
+	}
+
+	slices.SortFunc(report, func(a, b *SecurityStatImportResult) int { return a.Line - b.Line })
+
+	counts := map[string]int{
+		services.BulkUpsertCreated: 0,
+		services.BulkUpsertUpdated: 0,
+		services.BulkUpsertFailed:  0,
+		securityStatImportSkipped:  0,
+	}
+
+	for i := range report {
+		counts[report[i].Status]++
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": report,
+		"meta": map[string]any{
+			"total":   len(report),
+			"created": counts[services.BulkUpsertCreated],
+			"updated": counts[services.BulkUpsertUpdated],
+			"failed":  counts[services.BulkUpsertFailed],
+			"skipped": counts[securityStatImportSkipped],
+		},
+	}}, nil
+}
+
 func (h *securityStatHandler) Patch(ctx *gofr.Context) (interface{}, error) {
 	id, err := strconv.Atoi(ctx.PathParam("id"))
 	if err != nil {
@@ -172,7 +413,6 @@ func (h *securityStatHandler) Patch(ctx *gofr.Context) (interface{}, error) {
 	}
 
 	model := &services.SecurityStatUpdate{
-		UserID: payload.UserID,
 		Open:   payload.Open,
 		Close:  payload.Close,
 		High:   payload.High,
@@ -206,3 +446,85 @@ func (h *securityStatHandler) buildResp(model *services.SecurityStat) *SecurityS
 
 	return resp
 }
+
+// mergeBulkUpsertResults points the results of the rows sent to the service back at their index in the request and adds the rows
+// that failed before reaching it.
+func mergeBulkUpsertResults(results []*services.BulkUpsertResult, indices []int, failed []*services.BulkUpsertResult) []*services.BulkUpsertResult {
+	for i := range results {
+		results[i].Index = indices[results[i].Index]
+	}
+
+	results = append(results, failed...)
+
+	slices.SortFunc(results, func(a, b *services.BulkUpsertResult) int { return a.Index - b.Index })
+
+	return results
+}
+
+func buildBulkUpsertResp(results []*services.BulkUpsertResult) response.Raw {
+	var (
+		resp   = make([]*BulkUpsertResult, len(results))
+		counts = map[string]int{services.BulkUpsertCreated: 0, services.BulkUpsertUpdated: 0, services.BulkUpsertFailed: 0}
+	)
+
+	for i := range results {
+		resp[i] = &BulkUpsertResult{
+			Index:  results[i].Index,
+			ID:     results[i].ID,
+			Status: results[i].Status,
+			Error:  results[i].Error,
+		}
+
+		counts[results[i].Status]++
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": resp,
+		"meta": map[string]any{
+			"total":   len(results),
+			"created": counts[services.BulkUpsertCreated],
+			"updated": counts[services.BulkUpsertUpdated],
+			"failed":  counts[services.BulkUpsertFailed],
+		},
+	}}
+}
+
+func parseSecurityStatImportRow(record []string, columns map[string]int) (*services.SecurityStatImport, error) {
+	value := func(field string) string {
+		if i, ok := columns[field]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+
+		return ""
+	}
+
+	row := &services.SecurityStatImport{ISIN: value("isin"), Symbol: value("symbol")}
+
+	var err error
+
+	for _, layout := range securityStatImportDateLayouts {
+		if row.Date, err = time.Parse(layout, value("date")); err == nil {
+			break
+		}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid date - %s", value("date"))
+	}
+
+	prices := map[string]*float64{"open": &row.Open, "high": &row.High, "low": &row.Low, "close": &row.Close}
+
+	for _, field := range []string{"open", "high", "low", "close"} {
+		price := prices[field]
+
+		if *price, err = strconv.ParseFloat(value(field), 64); err != nil {
+			return nil, fmt.Errorf("invalid %s - %s", field, value(field))
+		}
+	}
+
+	if row.Volume, err = strconv.Atoi(value("volume")); err != nil {
+		return nil, fmt.Errorf("invalid volume - %s", value("volume"))
+	}
+
+	return row, nil
+}
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"strconv"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
+
+	"github.com/stratifyr/security-service/internal/services"
+)
+
+type Tradability struct {
+	LotSize              int     `json:"lotSize"`
+	TickSize             float64 `json:"tickSize"`
+	SurveillanceFlag     string  `json:"surveillanceFlag"`
+	SurveillanceCategory string  `json:"surveillanceCategory"`
+	UnderSurveillance    bool    `json:"underSurveillance"`
+	MTFLeverage          float64 `json:"mtfLeverage"`
+}
+
+type SecurityTradability struct {
+	ID            int     `json:"id"`
+	SecurityID    int     `json:"securityId"`
+	EffectiveFrom string  `json:"effectiveFrom"`
+	EffectiveTo   *string `json:"effectiveTo"`
+	Tradability
+}
+
+type TradabilityUpdate struct {
+	LotSize              int     `json:"lotSize"`
+	TickSize             float64 `json:"tickSize"`
+	SurveillanceFlag     string  `json:"surveillanceFlag"`
+	SurveillanceCategory string  `json:"surveillanceCategory"`
+	MTFLeverage          float64 `json:"mtfLeverage"`
+	EffectiveFrom        string  `json:"effectiveFrom"`
+}
+
+type securityTradabilityHandler struct {
+	svc services.SecurityTradabilityService
+}
+
+func NewSecurityTradabilityHandler(svc services.SecurityTradabilityService) *securityTradabilityHandler {
+	return &securityTradabilityHandler{svc: svc}
+}
+
+func (h *securityTradabilityHandler) History(ctx *gofr.Context) (interface{}, error) {
+	securityID, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	tradabilities, err := h.svc.History(ctx, securityID)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp = make([]*SecurityTradability, len(tradabilities))
+
+	for i := range tradabilities {
+		resp[i] = h.buildResp(tradabilities[i])
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": resp,
+	}}, nil
+}
+
+func (h *securityTradabilityHandler) Update(ctx *gofr.Context) (interface{}, error) {
+	securityID, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var payload TradabilityUpdate
+
+	if err = ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	model := &services.TradabilityUpdate{
+		LotSize:              payload.LotSize,
+		TickSize:             payload.TickSize,
+		SurveillanceFlag:     payload.SurveillanceFlag,
+		SurveillanceCategory: payload.SurveillanceCategory,
+		MTFLeverage:          payload.MTFLeverage,
+	}
+
+	if payload.EffectiveFrom != "" {
+		model.EffectiveFrom, err = time.Parse(time.DateOnly, payload.EffectiveFrom)
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"effectiveFrom"}}
+		}
+	}
+
+	tradability, err := h.svc.Update(ctx, securityID, model)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(tradability),
+	}}, nil
+}
+
+func (h *securityTradabilityHandler) buildResp(model *services.Tradability) *SecurityTradability {
+	resp := &SecurityTradability{
+		ID:            model.ID,
+		SecurityID:    model.SecurityID,
+		EffectiveFrom: model.EffectiveFrom.Format(time.DateOnly),
+		Tradability: Tradability{
+			LotSize:              model.LotSize,
+			TickSize:             model.TickSize,
+			SurveillanceFlag:     model.SurveillanceFlag,
+			SurveillanceCategory: model.SurveillanceCategory,
+			UnderSurveillance:    model.UnderSurveillance,
+			MTFLeverage:          model.MTFLeverage,
+		},
+	}
+
+	if model.EffectiveTo != nil {
+		effectiveTo := model.EffectiveTo.Format(time.DateOnly)
+		resp.EffectiveTo = &effectiveTo
+	}
+
+	return resp
+}
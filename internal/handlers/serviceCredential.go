@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"strconv"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
+
+	"github.com/stratifyr/security-service/internal/services"
+)
+
+type ServiceCredential struct {
+	ID                   int      `json:"id"`
+	Name                 string   `json:"name"`
+	Key                  string   `json:"key,omitempty"`
+	KeyPrefix            string   `json:"keyPrefix"`
+	Scopes               []string `json:"scopes"`
+	PreviousKeyExpiresAt *string  `json:"previousKeyExpiresAt"`
+	LastUsedAt           *string  `json:"lastUsedAt"`
+	RevokedAt            *string  `json:"revokedAt"`
+	CreatedAt            string   `json:"createdAt"`
+	UpdatedAt            string   `json:"updatedAt"`
+}
+
+type ServiceCredentialCreate struct {
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+}
+
+type ServiceCredentialUpdate struct {
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+}
+
+type serviceCredentialHandler struct {
+	svc services.ServiceCredentialService
+}
+
+func NewServiceCredentialHandler(svc services.ServiceCredentialService) *serviceCredentialHandler {
+	return &serviceCredentialHandler{svc: svc}
+}
+
+func (h *serviceCredentialHandler) Index(ctx *gofr.Context) (interface{}, error) {
+	var (
+		filter services.ServiceCredentialFilter
+		err    error
+	)
+
+	if ctx.Param("revoked") != "" {
+		revoked, err := strconv.ParseBool(ctx.Param("revoked"))
+		if err != nil {
+			return nil, http.ErrorInvalidParam{Params: []string{"revoked"}}
+		}
+
+		filter.Revoked = &revoked
+	}
+
+	page := 1
+	if ctx.Param("page") != "" {
+		page, err = strconv.Atoi(ctx.Param("page"))
+		if err != nil || page < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"page"}}
+		}
+	}
+
+	perPage := 20
+	if ctx.Param("perPage") != "" {
+		perPage, err = strconv.Atoi(ctx.Param("perPage"))
+		if err != nil || perPage < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"perPage"}}
+		}
+	}
+
+	serviceCredentials, count, err := h.svc.Index(ctx, &filter, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp = make([]*ServiceCredential, len(serviceCredentials))
+
+	for i := range serviceCredentials {
+		resp[i] = h.buildResp(serviceCredentials[i])
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": resp,
+		"meta": map[string]any{
+			"page":    page,
+			"perPage": perPage,
+			"total":   count,
+		},
+	}}, nil
+}
+
+func (h *serviceCredentialHandler) Read(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	serviceCredential, err := h.svc.Read(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(serviceCredential),
+	}}, nil
+}
+
+func (h *serviceCredentialHandler) Create(ctx *gofr.Context) (interface{}, error) {
+	var payload ServiceCredentialCreate
+
+	if err := ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	model := &services.ServiceCredentialCreate{
+		Name:   payload.Name,
+		Scopes: payload.Scopes,
+	}
+
+	serviceCredential, err := h.svc.Create(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(serviceCredential),
+	}}, nil
+}
+
+func (h *serviceCredentialHandler) Patch(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var payload ServiceCredentialUpdate
+
+	if err = ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	model := &services.ServiceCredentialUpdate{
+		Name:   payload.Name,
+		Scopes: payload.Scopes,
+	}
+
+	serviceCredential, err := h.svc.Patch(ctx, id, model)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(serviceCredential),
+	}}, nil
+}
+
+func (h *serviceCredentialHandler) Rotate(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	serviceCredential, err := h.svc.Rotate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(serviceCredential),
+	}}, nil
+}
+
+func (h *serviceCredentialHandler) Revoke(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	err = h.svc.Revoke(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (h *serviceCredentialHandler) buildResp(model *services.ServiceCredential) *ServiceCredential {
+	resp := &ServiceCredential{
+		ID:                   model.ID,
+		Name:                 model.Name,
+		Key:                  model.Key,
+		KeyPrefix:            model.KeyPrefix,
+		Scopes:               model.Scopes,
+		PreviousKeyExpiresAt: formatOptionalTime(model.PreviousKeyExpiresAt),
+		LastUsedAt:           formatOptionalTime(model.LastUsedAt),
+		RevokedAt:            formatOptionalTime(model.RevokedAt),
+		CreatedAt:            model.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:            model.UpdatedAt.Format(time.RFC3339),
+	}
+
+	return resp
+}
+
+func formatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+
+	formatted := t.Format(time.RFC3339)
+
+	return &formatted
+}
@@ -0,0 +1,282 @@
+package handlers
+
+import (
+	"strconv"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
+
+	"github.com/stratifyr/security-service/internal/services"
+)
+
+type Watchlist struct {
+	ID          int    `json:"id"`
+	UserID      int    `json:"userId"`
+	Name        string `json:"name"`
+	SecurityIDs []int  `json:"securityIds"`
+	CreatedAt   string `json:"createdAt"`
+	UpdatedAt   string `json:"updatedAt"`
+}
+
+type WatchlistCreate struct {
+	Name        string `json:"name"`
+	SecurityIDs []int  `json:"securityIds"`
+}
+
+type WatchlistUpdate struct {
+	Name string `json:"name"`
+}
+
+type WatchlistSecurityAdd struct {
+	SecurityID int `json:"securityId"`
+}
+
+type WatchlistReorder struct {
+	SecurityIDs []int `json:"securityIds"`
+}
+
+type watchlistHandler struct {
+	svc        services.WatchlistService
+	securities *securityHandler
+}
+
+func NewWatchlistHandler(svc services.WatchlistService, securityService services.SecurityService) *watchlistHandler {
+	return &watchlistHandler{svc: svc, securities: NewSecurityHandler(securityService)}
+}
+
+func (h *watchlistHandler) Index(ctx *gofr.Context) (interface{}, error) {
+	var err error
+
+	page := 1
+	if ctx.Param("page") != "" {
+		page, err = strconv.Atoi(ctx.Param("page"))
+		if err != nil || page < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"page"}}
+		}
+	}
+
+	perPage := 20
+	if ctx.Param("perPage") != "" {
+		perPage, err = strconv.Atoi(ctx.Param("perPage"))
+		if err != nil || perPage < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"perPage"}}
+		}
+	}
+
+	watchlists, count, err := h.svc.Index(ctx, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp = make([]*Watchlist, len(watchlists))
+
+	for i := range watchlists {
+		resp[i] = h.buildResp(watchlists[i])
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": resp,
+		"meta": map[string]any{
+			"page":    page,
+			"perPage": perPage,
+			"total":   count,
+		},
+	}}, nil
+}
+
+func (h *watchlistHandler) Read(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	watchlist, err := h.svc.Read(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(watchlist),
+	}}, nil
+}
+
+func (h *watchlistHandler) Create(ctx *gofr.Context) (interface{}, error) {
+	var payload WatchlistCreate
+
+	if err := ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	model := &services.WatchlistCreate{
+		Name:        payload.Name,
+		SecurityIDs: payload.SecurityIDs,
+	}
+
+	watchlist, err := h.svc.Create(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(watchlist),
+	}}, nil
+}
+
+func (h *watchlistHandler) Patch(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var payload WatchlistUpdate
+
+	if err = ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	watchlist, err := h.svc.Patch(ctx, id, &services.WatchlistUpdate{Name: payload.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(watchlist),
+	}}, nil
+}
+
+func (h *watchlistHandler) Delete(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	err = h.svc.Delete(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (h *watchlistHandler) Securities(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	page := 1
+	if ctx.Param("page") != "" {
+		page, err = strconv.Atoi(ctx.Param("page"))
+		if err != nil || page < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"page"}}
+		}
+	}
+
+	perPage := 20
+	if ctx.Param("perPage") != "" {
+		perPage, err = strconv.Atoi(ctx.Param("perPage"))
+		if err != nil || perPage < 1 {
+			return nil, http.ErrorInvalidParam{Params: []string{"perPage"}}
+		}
+	}
+
+	securities, count, err := h.securities.svc.Index(ctx, &services.SecurityFilter{WatchlistID: id}, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp = make([]*Security, len(securities))
+
+	for i := range securities {
+		resp[i] = h.securities.buildResp(securities[i])
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": resp,
+		"meta": map[string]any{
+			"page":    page,
+			"perPage": perPage,
+			"total":   count,
+		},
+	}}, nil
+}
+
+func (h *watchlistHandler) AddSecurity(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var payload WatchlistSecurityAdd
+
+	if err = ctx.Bind(&payload); err != nil || payload.SecurityID <= 0 {
+		return nil, http.ErrorInvalidParam{Params: []string{"securityId"}}
+	}
+
+	watchlist, err := h.svc.AddSecurity(ctx, id, payload.SecurityID)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(watchlist),
+	}}, nil
+}
+
+func (h *watchlistHandler) RemoveSecurity(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	securityID, err := strconv.Atoi(ctx.PathParam("securityId"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"securityId"}}
+	}
+
+	watchlist, err := h.svc.RemoveSecurity(ctx, id, securityID)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(watchlist),
+	}}, nil
+}
+
+func (h *watchlistHandler) Reorder(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var payload WatchlistReorder
+
+	if err = ctx.Bind(&payload); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"request-body"}}
+	}
+
+	watchlist, err := h.svc.Reorder(ctx, id, payload.SecurityIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Raw{Data: map[string]any{
+		"data": h.buildResp(watchlist),
+	}}, nil
+}
+
+func (h *watchlistHandler) buildResp(model *services.Watchlist) *Watchlist {
+	resp := &Watchlist{
+		ID:          model.ID,
+		UserID:      model.UserID,
+		Name:        model.Name,
+		SecurityIDs: model.SecurityIDs,
+		CreatedAt:   model.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   model.UpdatedAt.Format(time.RFC3339),
+	}
+
+	return resp
+}
@@ -0,0 +1,106 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+const (
+	compactI32    = 5
+	compactI64    = 6
+	compactBinary = 8
+	compactList   = 9
+	compactStruct = 12
+)
+
+// compactWriter encodes the thrift compact protocol, which is all the parquet footer and page headers need.
+type compactWriter struct {
+	buf     bytes.Buffer
+	lastIDs []int16
+	scratch [binary.MaxVarintLen64]byte
+}
+
+func newCompactWriter() *compactWriter {
+	return &compactWriter{lastIDs: []int16{0}}
+}
+
+func (w *compactWriter) Bytes() []byte {
+	return w.buf.Bytes()
+}
+
+func (w *compactWriter) i32(id int16, v int32) {
+	w.fieldHeader(id, compactI32)
+	w.varInt(int64(v))
+}
+
+func (w *compactWriter) i64(id int16, v int64) {
+	w.fieldHeader(id, compactI64)
+	w.varInt(v)
+}
+
+func (w *compactWriter) binary(id int16, v string) {
+	w.fieldHeader(id, compactBinary)
+	w.rawBinary(v)
+}
+
+func (w *compactWriter) beginStruct(id int16) {
+	w.fieldHeader(id, compactStruct)
+	w.lastIDs = append(w.lastIDs, 0)
+}
+
+// beginListStruct starts a struct that is an element of a list, elements carry no field header.
+func (w *compactWriter) beginListStruct() {
+	w.lastIDs = append(w.lastIDs, 0)
+}
+
+func (w *compactWriter) endStruct() {
+	w.buf.WriteByte(0)
+	w.lastIDs = w.lastIDs[:len(w.lastIDs)-1]
+}
+
+func (w *compactWriter) beginList(id int16, elemType byte, size int) {
+	w.fieldHeader(id, compactList)
+
+	if size < 15 {
+		w.buf.WriteByte(byte(size<<4) | elemType)
+		return
+	}
+
+	w.buf.WriteByte(0xf0 | elemType)
+	w.uVarInt(uint64(size))
+}
+
+func (w *compactWriter) listI32(v int32) {
+	w.varInt(int64(v))
+}
+
+func (w *compactWriter) listBinary(v string) {
+	w.rawBinary(v)
+}
+
+func (w *compactWriter) fieldHeader(id int16, typ byte) {
+	last := &w.lastIDs[len(w.lastIDs)-1]
+
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		w.buf.WriteByte(byte(delta<<4) | typ)
+	} else {
+		w.buf.WriteByte(typ)
+		w.varInt(int64(id))
+	}
+
+	*last = id
+}
+
+func (w *compactWriter) rawBinary(v string) {
+	w.uVarInt(uint64(len(v)))
+	w.buf.WriteString(v)
+}
+
+func (w *compactWriter) varInt(v int64) {
+	w.uVarInt(uint64((v << 1) ^ (v >> 63)))
+}
+
+func (w *compactWriter) uVarInt(v uint64) {
+	n := binary.PutUvarint(w.scratch[:], v)
+	w.buf.Write(w.scratch[:n])
+}
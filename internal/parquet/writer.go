@@ -0,0 +1,339 @@
+// Package parquet writes flat parquet files of required columns. Values are PLAIN encoded into one gzip compressed data page
+// per column chunk, and rows are flushed as row groups while writing, so a file can be streamed without holding it in memory.
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+const (
+	String Type = iota
+	Int64
+	Double
+	Date
+)
+
+const (
+	physicalInt32     = 1
+	physicalInt64     = 2
+	physicalDouble    = 5
+	physicalByteArray = 6
+
+	convertedUTF8 = 0
+	convertedDate = 6
+
+	encodingPlain = 0
+	encodingRLE   = 3
+
+	repetitionRequired = 0
+	pageTypeDataPage   = 0
+	codecGzip          = 2
+)
+
+const (
+	magic        = "PAR1"
+	createdBy    = "security-service"
+	rowGroupSize = 50000
+	secondsInDay = 24 * 60 * 60
+)
+
+var errClosed = errors.New("parquet: write on closed writer")
+
+type Type int
+
+type Column struct {
+	Name string
+	Type Type
+}
+
+type Writer struct {
+	w         *countingWriter
+	columns   []*Column
+	pages     []*bytes.Buffer
+	rows      int
+	numRows   int64
+	rowGroups []*rowGroup
+	gzip      *gzip.Writer
+	scratch   [8]byte
+	closed    bool
+}
+
+type rowGroup struct {
+	numRows       int64
+	totalByteSize int64
+	chunks        []*columnChunk
+}
+
+type columnChunk struct {
+	offset           int64
+	uncompressedSize int64
+	compressedSize   int64
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+
+	return n, err
+}
+
+func NewWriter(w io.Writer, columns []*Column) (*Writer, error) {
+	pw := &Writer{
+		w:       &countingWriter{w: w},
+		columns: columns,
+		pages:   make([]*bytes.Buffer, len(columns)),
+		gzip:    gzip.NewWriter(io.Discard),
+	}
+
+	for i := range pw.pages {
+		pw.pages[i] = &bytes.Buffer{}
+	}
+
+	if _, err := pw.w.Write([]byte(magic)); err != nil {
+		return nil, err
+	}
+
+	return pw, nil
+}
+
+// Write appends a row, values must be in column order and of the Go type matching each column: string, int, float64 or time.Time.
+func (w *Writer) Write(values []any) error {
+	if w.closed {
+		return errClosed
+	}
+
+	if len(values) != len(w.columns) {
+		return fmt.Errorf("parquet: expected %d values, got %d", len(w.columns), len(values))
+	}
+
+	for i, column := range w.columns {
+		if err := w.encode(w.pages[i], column, values[i]); err != nil {
+			return err
+		}
+	}
+
+	w.rows++
+
+	if w.rows >= rowGroupSize {
+		return w.flush()
+	}
+
+	return nil
+}
+
+// Close flushes the buffered rows and writes the footer, it does not close the underlying writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return errClosed
+	}
+
+	w.closed = true
+
+	if w.rows > 0 {
+		if err := w.flush(); err != nil {
+			return err
+		}
+	}
+
+	footer := w.footer()
+
+	if _, err := w.w.Write(footer); err != nil {
+		return err
+	}
+
+	if _, err := w.w.Write(binary.LittleEndian.AppendUint32(w.scratch[:0], uint32(len(footer)))); err != nil {
+		return err
+	}
+
+	_, err := w.w.Write([]byte(magic))
+
+	return err
+}
+
+func (w *Writer) encode(page *bytes.Buffer, column *Column, value any) error {
+	var ok bool
+
+	switch column.Type {
+	case String:
+		var v string
+
+		if v, ok = value.(string); ok {
+			page.Write(binary.LittleEndian.AppendUint32(w.scratch[:0], uint32(len(v))))
+			page.WriteString(v)
+		}
+	case Int64:
+		var v int
+
+		if v, ok = value.(int); ok {
+			page.Write(binary.LittleEndian.AppendUint64(w.scratch[:0], uint64(v)))
+		}
+	case Double:
+		var v float64
+
+		if v, ok = value.(float64); ok {
+			page.Write(binary.LittleEndian.AppendUint64(w.scratch[:0], math.Float64bits(v)))
+		}
+	case Date:
+		var v time.Time
+
+		if v, ok = value.(time.Time); ok {
+			year, month, day := v.Date()
+			days := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / secondsInDay
+
+			page.Write(binary.LittleEndian.AppendUint32(w.scratch[:0], uint32(int32(days))))
+		}
+	}
+
+	if !ok {
+		return fmt.Errorf("parquet: invalid value %v for column %s", value, column.Name)
+	}
+
+	return nil
+}
+
+func (w *Writer) flush() error {
+	group := &rowGroup{numRows: int64(w.rows)}
+
+	var compressed bytes.Buffer
+
+	for i := range w.columns {
+		raw := w.pages[i].Bytes()
+
+		compressed.Reset()
+		w.gzip.Reset(&compressed)
+
+		if _, err := w.gzip.Write(raw); err != nil {
+			return err
+		}
+
+		if err := w.gzip.Close(); err != nil {
+			return err
+		}
+
+		header := newCompactWriter()
+		header.i32(1, pageTypeDataPage)
+		header.i32(2, int32(len(raw)))
+		header.i32(3, int32(compressed.Len()))
+		header.beginStruct(5)
+		header.i32(1, int32(w.rows))
+		header.i32(2, encodingPlain)
+		header.i32(3, encodingRLE)
+		header.i32(4, encodingRLE)
+		header.endStruct()
+		header.endStruct()
+
+		chunk := &columnChunk{
+			offset:           w.w.n,
+			uncompressedSize: int64(len(header.Bytes()) + len(raw)),
+			compressedSize:   int64(len(header.Bytes()) + compressed.Len()),
+		}
+
+		if _, err := w.w.Write(header.Bytes()); err != nil {
+			return err
+		}
+
+		if _, err := w.w.Write(compressed.Bytes()); err != nil {
+			return err
+		}
+
+		group.chunks = append(group.chunks, chunk)
+		group.totalByteSize += chunk.uncompressedSize
+
+		w.pages[i].Reset()
+	}
+
+	w.rowGroups = append(w.rowGroups, group)
+	w.numRows += group.numRows
+	w.rows = 0
+
+	return nil
+}
+
+func (w *Writer) footer() []byte {
+	meta := newCompactWriter()
+	meta.i32(1, 1)
+
+	meta.beginList(2, compactStruct, len(w.columns)+1)
+	meta.beginListStruct()
+	meta.binary(4, "schema")
+	meta.i32(5, int32(len(w.columns)))
+	meta.endStruct()
+
+	for _, column := range w.columns {
+		meta.beginListStruct()
+		meta.i32(1, physicalType(column.Type))
+		meta.i32(3, repetitionRequired)
+		meta.binary(4, column.Name)
+
+		switch column.Type {
+		case String:
+			meta.i32(6, convertedUTF8)
+		case Date:
+			meta.i32(6, convertedDate)
+		}
+
+		meta.endStruct()
+	}
+
+	meta.i64(3, w.numRows)
+
+	meta.beginList(4, compactStruct, len(w.rowGroups))
+
+	for _, group := range w.rowGroups {
+		meta.beginListStruct()
+		meta.beginList(1, compactStruct, len(group.chunks))
+
+		for i, chunk := range group.chunks {
+			meta.beginListStruct()
+			meta.i64(2, chunk.offset)
+			meta.beginStruct(3)
+			meta.i32(1, physicalType(w.columns[i].Type))
+			meta.beginList(2, compactI32, 2)
+			meta.listI32(encodingPlain)
+			meta.listI32(encodingRLE)
+			meta.beginList(3, compactBinary, 1)
+			meta.listBinary(w.columns[i].Name)
+			meta.i32(4, codecGzip)
+			meta.i64(5, group.numRows)
+			meta.i64(6, chunk.uncompressedSize)
+			meta.i64(7, chunk.compressedSize)
+			meta.i64(9, chunk.offset)
+			meta.endStruct()
+			meta.endStruct()
+		}
+
+		meta.i64(2, group.totalByteSize)
+		meta.i64(3, group.numRows)
+		meta.endStruct()
+	}
+
+	meta.binary(6, createdBy)
+	meta.endStruct()
+
+	return meta.Bytes()
+}
+
+func physicalType(typ Type) int32 {
+	switch typ {
+	case Int64:
+		return physicalInt64
+	case Double:
+		return physicalDouble
+	case Date:
+		return physicalInt32
+	default:
+		return physicalByteArray
+	}
+}
@@ -0,0 +1,328 @@
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"testing"
+	"time"
+)
+
+// compactReader decodes the thrift compact protocol independently of compactWriter. Structs are read into maps of field id to
+// value, lists into slices, integers into int64 and binaries into strings.
+type compactReader struct {
+	r *bytes.Reader
+}
+
+func (r *compactReader) readStruct() (map[int16]any, error) {
+	fields := make(map[int16]any)
+
+	var lastID int16
+
+	for {
+		header, err := r.r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+
+		if header == 0 {
+			return fields, nil
+		}
+
+		id := lastID + int16(header>>4)
+
+		if header>>4 == 0 {
+			v, err := binary.ReadVarint(r.r)
+			if err != nil {
+				return nil, err
+			}
+
+			id = int16(v)
+		}
+
+		if fields[id], err = r.readValue(header & 0x0f); err != nil {
+			return nil, fmt.Errorf("field %d: %w", id, err)
+		}
+
+		lastID = id
+	}
+}
+
+func (r *compactReader) readValue(typ byte) (any, error) {
+	switch typ {
+	case compactI32, compactI64:
+		return binary.ReadVarint(r.r)
+	case compactBinary:
+		size, err := binary.ReadUvarint(r.r)
+		if err != nil {
+			return nil, err
+		}
+
+		b := make([]byte, size)
+
+		if _, err = io.ReadFull(r.r, b); err != nil {
+			return nil, err
+		}
+
+		return string(b), nil
+	case compactList:
+		header, err := r.r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+
+		size := uint64(header >> 4)
+
+		if size == 15 {
+			if size, err = binary.ReadUvarint(r.r); err != nil {
+				return nil, err
+			}
+		}
+
+		list := make([]any, size)
+
+		for i := range list {
+			if list[i], err = r.readValue(header & 0x0f); err != nil {
+				return nil, err
+			}
+		}
+
+		return list, nil
+	case compactStruct:
+		return r.readStruct()
+	default:
+		return nil, fmt.Errorf("unexpected compact type %d", typ)
+	}
+}
+
+// readFile reads back a file written by Writer, checking the footer against the pages it points at.
+func readFile(t *testing.T, file []byte) (columns []*Column, rowGroupRows []int64, rows [][]any) {
+	t.Helper()
+
+	if string(file[:4]) != magic || string(file[len(file)-4:]) != magic {
+		t.Fatalf("file is not framed by %s", magic)
+	}
+
+	footerLength := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+	footerStart := len(file) - 8 - footerLength
+
+	meta, err := (&compactReader{r: bytes.NewReader(file[footerStart : len(file)-8])}).readStruct()
+	if err != nil {
+		t.Fatalf("failed to read footer: %v", err)
+	}
+
+	schema := meta[2].([]any)
+
+	if got := schema[0].(map[int16]any)[5].(int64); got != int64(len(schema)-1) {
+		t.Fatalf("schema root has %d children, want %d", got, len(schema)-1)
+	}
+
+	for _, element := range schema[1:] {
+		element := element.(map[int16]any)
+
+		column := &Column{Name: element[4].(string)}
+
+		switch element[1].(int64) {
+		case physicalByteArray:
+			column.Type = String
+		case physicalInt64:
+			column.Type = Int64
+		case physicalDouble:
+			column.Type = Double
+		case physicalInt32:
+			column.Type = Date
+
+			if element[6].(int64) != convertedDate {
+				t.Fatalf("int32 column %s is not a date", column.Name)
+			}
+		}
+
+		columns = append(columns, column)
+	}
+
+	for _, group := range meta[4].([]any) {
+		group := group.(map[int16]any)
+		numRows := group[3].(int64)
+		groupRows := make([][]any, numRows)
+
+		for i, chunk := range group[1].([]any) {
+			chunkMeta := chunk.(map[int16]any)[3].(map[int16]any)
+
+			if chunkMeta[4].(int64) != codecGzip {
+				t.Fatalf("column %s is not gzip compressed", columns[i].Name)
+			}
+
+			if chunkMeta[5].(int64) != numRows {
+				t.Fatalf("column %s has %d values in a row group of %d rows", columns[i].Name, chunkMeta[5].(int64), numRows)
+			}
+
+			offset := chunkMeta[9].(int64)
+			pageReader := bytes.NewReader(file[offset:footerStart])
+
+			header, err := (&compactReader{r: pageReader}).readStruct()
+			if err != nil {
+				t.Fatalf("failed to read page header of column %s: %v", columns[i].Name, err)
+			}
+
+			headerSize := int64(len(file[offset:footerStart]) - pageReader.Len())
+			compressedSize := header[3].(int64)
+
+			if got := headerSize + compressedSize; got != chunkMeta[7].(int64) {
+				t.Fatalf("column %s chunk is %d bytes, footer says %d", columns[i].Name, got, chunkMeta[7].(int64))
+			}
+
+			gz, err := gzip.NewReader(io.LimitReader(pageReader, compressedSize))
+			if err != nil {
+				t.Fatalf("page of column %s is not gzip: %v", columns[i].Name, err)
+			}
+
+			page, err := io.ReadAll(gz)
+			if err != nil {
+				t.Fatalf("failed to decompress page of column %s: %v", columns[i].Name, err)
+			}
+
+			if int64(len(page)) != header[2].(int64) {
+				t.Fatalf("column %s page is %d bytes, header says %d", columns[i].Name, len(page), header[2].(int64))
+			}
+
+			if got := header[5].(map[int16]any)[1].(int64); got != numRows {
+				t.Fatalf("column %s page has %d values, want %d", columns[i].Name, got, numRows)
+			}
+
+			for r := range groupRows {
+				var v any
+
+				switch columns[i].Type {
+				case String:
+					size := binary.LittleEndian.Uint32(page)
+					v, page = string(page[4:4+size]), page[4+size:]
+				case Int64:
+					v, page = int(binary.LittleEndian.Uint64(page)), page[8:]
+				case Double:
+					v, page = math.Float64frombits(binary.LittleEndian.Uint64(page)), page[8:]
+				case Date:
+					v, page = time.Unix(int64(int32(binary.LittleEndian.Uint32(page)))*secondsInDay, 0).UTC(), page[4:]
+				}
+
+				groupRows[r] = append(groupRows[r], v)
+			}
+
+			if len(page) != 0 {
+				t.Fatalf("column %s page has %d trailing bytes", columns[i].Name, len(page))
+			}
+		}
+
+		rowGroupRows = append(rowGroupRows, numRows)
+		rows = append(rows, groupRows...)
+	}
+
+	if int64(len(rows)) != meta[3].(int64) {
+		t.Fatalf("row groups hold %d rows, footer says %d", len(rows), meta[3].(int64))
+	}
+
+	return columns, rowGroupRows, rows
+}
+
+func TestWriterRoundTrip(t *testing.T) {
+	columns := []*Column{
+		{Name: "symbol", Type: String},
+		{Name: "volume", Type: Int64},
+		{Name: "close", Type: Double},
+		{Name: "date", Type: Date},
+	}
+
+	start := time.Date(1965, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	row := func(i int) []any {
+		return []any{
+			fmt.Sprintf("SYM%d", i%997) + string(make([]byte, i%3)),
+			i*1_000_003 - 50_000_000_000,
+			float64(i)/7 - 1000,
+			start.AddDate(0, 0, i),
+		}
+	}
+
+	tests := []struct {
+		name         string
+		rows         int
+		wantRowGroup []int64
+	}{
+		{name: "no rows", rows: 0},
+		{name: "single row", rows: 1, wantRowGroup: []int64{1}},
+		{name: "exactly one row group", rows: rowGroupSize, wantRowGroup: []int64{rowGroupSize}},
+		{name: "several row groups", rows: 2*rowGroupSize + 1234, wantRowGroup: []int64{rowGroupSize, rowGroupSize, 1234}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			w, err := NewWriter(&buf, columns)
+			if err != nil {
+				t.Fatalf("NewWriter() err = %v", err)
+			}
+
+			for i := range tc.rows {
+				if err = w.Write(row(i)); err != nil {
+					t.Fatalf("Write() err = %v", err)
+				}
+			}
+
+			if err = w.Close(); err != nil {
+				t.Fatalf("Close() err = %v", err)
+			}
+
+			gotColumns, gotRowGroups, gotRows := readFile(t, buf.Bytes())
+
+			for i := range columns {
+				if *gotColumns[i] != *columns[i] {
+					t.Errorf("column %d = %+v, want %+v", i, *gotColumns[i], *columns[i])
+				}
+			}
+
+			if fmt.Sprint(gotRowGroups) != fmt.Sprint(tc.wantRowGroup) {
+				t.Errorf("row groups = %v, want %v", gotRowGroups, tc.wantRowGroup)
+			}
+
+			if len(gotRows) != tc.rows {
+				t.Fatalf("got %d rows, want %d", len(gotRows), tc.rows)
+			}
+
+			for i := range gotRows {
+				want := row(i)
+
+				for c := range want {
+					if gotRows[i][c] != want[c] {
+						t.Fatalf("row %d column %s = %v, want %v", i, columns[c].Name, gotRows[i][c], want[c])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestWriterErrors(t *testing.T) {
+	w, err := NewWriter(io.Discard, []*Column{{Name: "close", Type: Double}})
+	if err != nil {
+		t.Fatalf("NewWriter() err = %v", err)
+	}
+
+	if err = w.Write([]any{1.5, 2.5}); err == nil {
+		t.Error("Write() with too many values did not fail")
+	}
+
+	if err = w.Write([]any{"1.5"}); err == nil {
+		t.Error("Write() with a string for a double column did not fail")
+	}
+
+	if err = w.Close(); err != nil {
+		t.Fatalf("Close() err = %v", err)
+	}
+
+	if err = w.Write([]any{1.5}); err != errClosed {
+		t.Errorf("Write() after Close() err = %v, want %v", err, errClosed)
+	}
+}
@@ -0,0 +1,978 @@
+package services
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+
+	"github.com/stratifyr/security-service/internal/auth"
+	"github.com/stratifyr/security-service/internal/stores"
+)
+
+const (
+	AlertFieldOpen   = "open"
+	AlertFieldClose  = "close"
+	AlertFieldHigh   = "high"
+	AlertFieldLow    = "low"
+	AlertFieldVolume = "volume"
+	AlertFieldMetric = "metric"
+)
+
+const (
+	AlertOperatorAbove        = "above"
+	AlertOperatorBelow        = "below"
+	AlertOperatorCrossesAbove = "crosses_above"
+	AlertOperatorCrossesBelow = "crosses_below"
+)
+
+const (
+	AlertDeliveryPending   = "pending"
+	AlertDeliveryDelivered = "delivered"
+	AlertDeliveryDead      = "dead"
+)
+
+const (
+	alertWebhookSecretPrefix = "whsec_"
+	alertDeliveryBatchSize   = 50
+	alertDeliveryMaxAttempts = 6
+	alertDeliveryBaseBackoff = 30 * time.Second
+
+	// alertDeliveryLease holds a claimed delivery back from other runs and replicas while it is sent, it outlasts a webhook
+	// timeout so a delivery is only picked up again when the run sending it died.
+	alertDeliveryLease = 6 * alertWebhookTimeout
+)
+
+var (
+	statFields     = []string{AlertFieldOpen, AlertFieldClose, AlertFieldHigh, AlertFieldLow, AlertFieldVolume}
+	alertOperators = []string{AlertOperatorAbove, AlertOperatorBelow, AlertOperatorCrossesAbove, AlertOperatorCrossesBelow}
+)
+
+type AlertService interface {
+	Index(ctx *gofr.Context, f *AlertRuleFilter, page, perPage int) ([]*AlertRule, int, error)
+	Read(ctx *gofr.Context, id int) (*AlertRule, error)
+	Create(ctx *gofr.Context, payload *AlertRuleCreate) (*AlertRule, error)
+	Patch(ctx *gofr.Context, id int, payload *AlertRuleUpdate) (*AlertRule, error)
+	Delete(ctx *gofr.Context, id int) error
+	IndexDeliveries(ctx *gofr.Context, id int, f *AlertDeliveryFilter, page, perPage int) ([]*AlertDelivery, int, error)
+	RetryDelivery(ctx *gofr.Context, id, deliveryID int) (*AlertDelivery, error)
+	EvaluateStats(ctx *gofr.Context, securityStats []*stores.SecurityStat)
+	EvaluateMetrics(ctx *gofr.Context, securityMetrics []*stores.SecurityMetric)
+	Deliver(ctx *gofr.Context)
+}
+
+type AlertRuleFilter struct {
+	UserID int
+	Active *bool
+}
+
+type AlertRule struct {
+	ID              int
+	UserID          int
+	Name            string
+	SecurityID      *int
+	IndexID         *int
+	Field           string
+	MetricID        *int
+	Operator        string
+	Threshold       float64
+	WebhookURL      string
+	WebhookSecret   string
+	Active          bool
+	LastTriggeredAt *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// AlertRuleCreate targets SecurityID, the constituents of IndexID as of each evaluated date, or every security when both are nil.
+type AlertRuleCreate struct {
+	Name       string
+	SecurityID *int
+	IndexID    *int
+	Field      string
+	MetricID   *int
+	Operator   string
+	Threshold  float64
+	WebhookURL string
+}
+
+type AlertRuleUpdate struct {
+	Name       string
+	Operator   string
+	Threshold  *float64
+	WebhookURL string
+	Active     *bool
+}
+
+type AlertDeliveryFilter struct {
+	Status string
+}
+
+type AlertDelivery struct {
+	ID            int
+	AlertRuleID   int
+	SecurityID    int
+	Date          time.Time
+	Value         float64
+	Status        string
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+	DeliveredAt   *time.Time
+	CreatedAt     time.Time
+}
+
+type AlertPayload struct {
+	AlertID       int      `json:"alertId"`
+	AlertName     string   `json:"alertName"`
+	SecurityID    int      `json:"securityId"`
+	Symbol        string   `json:"symbol"`
+	IndexID       *int     `json:"indexId,omitempty"`
+	Field         string   `json:"field"`
+	MetricID      *int     `json:"metricId,omitempty"`
+	Operator      string   `json:"operator"`
+	Threshold     float64  `json:"threshold"`
+	Value         float64  `json:"value"`
+	PreviousValue *float64 `json:"previousValue,omitempty"`
+	Date          string   `json:"date"`
+	TriggeredAt   string   `json:"triggeredAt"`
+}
+
+// alertSubject is an upserted stat or metric value that alert rules are evaluated against, metricID is 0 for stats.
+type alertSubject struct {
+	securityID int
+	metricID   int
+	date       time.Time
+	values     map[string]float64
+}
+
+type alertService struct {
+	entitlementService    EntitlementService
+	userTierService       UserTierService
+	marketDayService      MarketDayService
+	auditEventService     AuditEventService
+	webhookSender         AlertWebhookSender
+	metricStore           stores.MetricStore
+	securityStore         stores.SecurityStore
+	securityStatStore     stores.SecurityStatStore
+	securityMetricStore   stores.SecurityMetricStore
+	marketIndexStore      stores.MarketIndexStore
+	indexConstituentStore stores.IndexConstituentStore
+	alertDeliveryStore    stores.AlertDeliveryStore
+	store                 stores.AlertRuleStore
+}
+
+func NewAlertService(entitlementService EntitlementService, userTierService UserTierService, marketDayService MarketDayService,
+	auditEventService AuditEventService, webhookSender AlertWebhookSender, metricStore stores.MetricStore, securityStore stores.SecurityStore,
+	securityStatStore stores.SecurityStatStore, securityMetricStore stores.SecurityMetricStore, marketIndexStore stores.MarketIndexStore,
+	indexConstituentStore stores.IndexConstituentStore, alertDeliveryStore stores.AlertDeliveryStore, store stores.AlertRuleStore) *alertService {
+	return &alertService{
+		entitlementService:    entitlementService,
+		userTierService:       userTierService,
+		marketDayService:      marketDayService,
+		auditEventService:     auditEventService,
+		webhookSender:         webhookSender,
+		metricStore:           metricStore,
+		securityStore:         securityStore,
+		securityStatStore:     securityStatStore,
+		securityMetricStore:   securityMetricStore,
+		marketIndexStore:      marketIndexStore,
+		indexConstituentStore: indexConstituentStore,
+		alertDeliveryStore:    alertDeliveryStore,
+		store:                 store,
+	}
+}
+
+func (s *alertService) Index(ctx *gofr.Context, f *AlertRuleFilter, page, perPage int) ([]*AlertRule, int, error) {
+	if err := authorize(ctx, auth.WriteAlerts); err != nil {
+		return nil, 0, err
+	}
+
+	limit := perPage
+	offset := limit * (page - 1)
+
+	filter := &stores.AlertRuleFilter{UserID: f.UserID, Active: f.Active}
+
+	if principal := auth.PrincipalFromContext(ctx); !principal.Can(auth.ManageAllAlerts) {
+		// a zero UserID does not filter at all, and service credentials own no alerts
+		if principal.UserID == 0 {
+			return nil, 0, nil
+		}
+
+		filter.UserID = principal.UserID
+	}
+
+	alertRules, err := s.store.Index(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.store.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if count == 0 {
+		return nil, 0, nil
+	}
+
+	var resp = make([]*AlertRule, len(alertRules))
+
+	for i := range alertRules {
+		resp[i] = s.buildResp(alertRules[i], false)
+	}
+
+	return resp, count, nil
+}
+
+func (s *alertService) Read(ctx *gofr.Context, id int) (*AlertRule, error) {
+	alertRule, err := s.retrieveOwned(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(alertRule, false), nil
+}
+
+func (s *alertService) Create(ctx *gofr.Context, payload *AlertRuleCreate) (*AlertRule, error) {
+	if err := authorize(ctx, auth.WriteAlerts); err != nil {
+		return nil, err
+	}
+
+	principal := auth.PrincipalFromContext(ctx)
+	if principal.UserID == 0 {
+		return nil, &ErrResp{Code: 403, Message: "alerts can only be created by user accounts"}
+	}
+
+	if payload.Name == "" {
+		return nil, &ErrResp{Code: 400, Message: "name is required"}
+	}
+
+	if err := validateAlertCondition(payload.Operator, payload.WebhookURL); err != nil {
+		return nil, err
+	}
+
+	entitlement, err := s.entitlementService.Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.validateTarget(ctx, entitlement, payload); err != nil {
+		return nil, err
+	}
+
+	if entitlement.MaxAlertRules > 0 {
+		count, err := s.store.Count(ctx, &stores.AlertRuleFilter{UserID: principal.UserID})
+		if err != nil {
+			return nil, err
+		}
+
+		if count >= entitlement.MaxAlertRules {
+			return nil, &ErrResp{Code: 403, Message: fmt.Sprintf("your plan allows at most %d alert rules", entitlement.MaxAlertRules)}
+		}
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	model := &stores.AlertRule{
+		UserID:        principal.UserID,
+		Name:          payload.Name,
+		SecurityID:    payload.SecurityID,
+		IndexID:       payload.IndexID,
+		Field:         payload.Field,
+		MetricID:      payload.MetricID,
+		Operator:      payload.Operator,
+		Threshold:     payload.Threshold,
+		WebhookURL:    payload.WebhookURL,
+		WebhookSecret: secret,
+		Active:        true,
+		CreatedAt:     time.Now().UTC(),
+		UpdatedAt:     time.Now().UTC(),
+	}
+
+	var alertRule *stores.AlertRule
+
+	err = stores.InTx(ctx, func() error {
+		if alertRule, err = s.store.Create(ctx, model); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "alerts", alertRule.ID, AuditActionCreate, nil, s.buildResp(alertRule, false))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(alertRule, true), nil
+}
+
+func (s *alertService) Patch(ctx *gofr.Context, id int, payload *AlertRuleUpdate) (*AlertRule, error) {
+	alertRule, err := s.retrieveOwned(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := s.buildResp(alertRule, false)
+
+	if payload.Name != "" {
+		alertRule.Name = payload.Name
+	}
+
+	if payload.Operator != "" {
+		alertRule.Operator = payload.Operator
+	}
+
+	if payload.Threshold != nil {
+		alertRule.Threshold = *payload.Threshold
+	}
+
+	if payload.WebhookURL != "" {
+		alertRule.WebhookURL = payload.WebhookURL
+	}
+
+	if payload.Active != nil {
+		alertRule.Active = *payload.Active
+	}
+
+	if err = validateAlertCondition(alertRule.Operator, alertRule.WebhookURL); err != nil {
+		return nil, err
+	}
+
+	alertRule.UpdatedAt = time.Now().UTC()
+
+	err = stores.InTx(ctx, func() error {
+		if alertRule, err = s.store.Update(ctx, id, alertRule); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "alerts", alertRule.ID, AuditActionUpdate, before, s.buildResp(alertRule, false))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(alertRule, false), nil
+}
+
+func (s *alertService) Delete(ctx *gofr.Context, id int) error {
+	alertRule, err := s.retrieveOwned(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return stores.InTx(ctx, func() error {
+		if err := s.store.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "alerts", id, AuditActionDelete, s.buildResp(alertRule, false), nil)
+	})
+}
+
+func (s *alertService) IndexDeliveries(ctx *gofr.Context, id int, f *AlertDeliveryFilter, page, perPage int) ([]*AlertDelivery, int, error) {
+	if _, err := s.retrieveOwned(ctx, id); err != nil {
+		return nil, 0, err
+	}
+
+	limit := perPage
+	offset := limit * (page - 1)
+
+	filter := &stores.AlertDeliveryFilter{AlertRuleID: id, Status: f.Status}
+
+	alertDeliveries, err := s.alertDeliveryStore.Index(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.alertDeliveryStore.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if count == 0 {
+		return nil, 0, nil
+	}
+
+	var resp = make([]*AlertDelivery, len(alertDeliveries))
+
+	for i := range alertDeliveries {
+		resp[i] = s.buildDeliveryResp(alertDeliveries[i])
+	}
+
+	return resp, count, nil
+}
+
+func (s *alertService) RetryDelivery(ctx *gofr.Context, id, deliveryID int) (*AlertDelivery, error) {
+	if _, err := s.retrieveOwned(ctx, id); err != nil {
+		return nil, err
+	}
+
+	alertDelivery, err := s.alertDeliveryStore.Retrieve(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if alertDelivery.AlertRuleID != id {
+		return nil, http.ErrorEntityNotFound{Name: "alert-deliveries", Value: strconv.Itoa(deliveryID)}
+	}
+
+	if alertDelivery.Status != AlertDeliveryDead {
+		return nil, &ErrResp{Code: 400, Message: "only dead deliveries can be retried"}
+	}
+
+	alertDelivery.Status = AlertDeliveryPending
+	alertDelivery.Attempts = 0
+	alertDelivery.LastError = ""
+	alertDelivery.NextAttemptAt = time.Now().UTC()
+
+	if err = s.alertDeliveryStore.Update(ctx, alertDelivery.ID, alertDelivery); err != nil {
+		return nil, err
+	}
+
+	return s.buildDeliveryResp(alertDelivery), nil
+}
+
+func (s *alertService) EvaluateStats(ctx *gofr.Context, securityStats []*stores.SecurityStat) {
+	subjects := make([]*alertSubject, len(securityStats))
+
+	for i, ss := range securityStats {
+		subjects[i] = &alertSubject{securityID: ss.SecurityID, date: ss.Date, values: statAlertValues(ss)}
+	}
+
+	s.evaluate(ctx, &stores.AlertRuleFilter{Fields: statFields}, subjects,
+		func(securityIDs []int, minDate, maxDate time.Time) (map[string]map[string]float64, error) {
+			filter := &stores.SecurityStatFilter{SecurityIDs: securityIDs, MinDate: minDate, MaxDate: maxDate}
+
+			securityStats, err := s.securityStatStore.Index(ctx, filter, 0, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			values := make(map[string]map[string]float64, len(securityStats))
+
+			for _, ss := range securityStats {
+				values[securityMetricKey(ss.SecurityID, 0, ss.Date)] = statAlertValues(ss)
+			}
+
+			return values, nil
+		})
+}
+
+func (s *alertService) EvaluateMetrics(ctx *gofr.Context, securityMetrics []*stores.SecurityMetric) {
+	var (
+		subjects  = make([]*alertSubject, len(securityMetrics))
+		metricIDs []int
+	)
+
+	for i, sm := range securityMetrics {
+		subjects[i] = &alertSubject{
+			securityID: sm.SecurityID,
+			metricID:   sm.MetricID,
+			date:       sm.Date,
+			values:     map[string]float64{AlertFieldMetric: sm.Value},
+		}
+
+		if !slices.Contains(metricIDs, sm.MetricID) {
+			metricIDs = append(metricIDs, sm.MetricID)
+		}
+	}
+
+	filter := &stores.AlertRuleFilter{Fields: []string{AlertFieldMetric}, MetricIDs: metricIDs}
+
+	s.evaluate(ctx, filter, subjects,
+		func(securityIDs []int, minDate, maxDate time.Time) (map[string]map[string]float64, error) {
+			securityMetrics, err := s.securityMetricStore.Index(ctx, &stores.SecurityMetricFilter{
+				SecurityIDs: securityIDs,
+				MetricIDs:   metricIDs,
+				MinDate:     minDate,
+				MaxDate:     maxDate,
+			}, 0, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			values := make(map[string]map[string]float64, len(securityMetrics))
+
+			for _, sm := range securityMetrics {
+				values[securityMetricKey(sm.SecurityID, sm.MetricID, sm.Date)] = map[string]float64{AlertFieldMetric: sm.Value}
+			}
+
+			return values, nil
+		})
+}
+
+// Deliver posts due webhook deliveries, failed deliveries are retried with exponential backoff and marked dead
+// once alertDeliveryMaxAttempts is reached. Each delivery is claimed before it is sent, so overlapping runs and replicas
+// never send the same one twice.
+func (s *alertService) Deliver(ctx *gofr.Context) {
+	alertDeliveries, err := s.alertDeliveryStore.Index(ctx,
+		&stores.AlertDeliveryFilter{Status: AlertDeliveryPending, DueBefore: time.Now().UTC()}, alertDeliveryBatchSize, 0)
+	if err != nil {
+		ctx.Logger.Errorf("failed to read pending alert deliveries, err: %v", err)
+
+		return
+	}
+
+	alertRules := make(map[int]*stores.AlertRule)
+
+	for _, alertDelivery := range alertDeliveries {
+		alertRule, ok := alertRules[alertDelivery.AlertRuleID]
+		if !ok {
+			alertRule, err = s.store.Retrieve(ctx, alertDelivery.AlertRuleID)
+			if err != nil {
+				ctx.Logger.Errorf("failed to read alert rule %d, err: %v", alertDelivery.AlertRuleID, err)
+
+				continue
+			}
+
+			alertRules[alertRule.ID] = alertRule
+		}
+
+		now := time.Now().UTC()
+
+		claimed, err := s.alertDeliveryStore.Claim(ctx, alertDelivery.ID, now, now.Add(alertDeliveryLease))
+		if err != nil {
+			ctx.Logger.Errorf("failed to claim alert delivery %d, err: %v", alertDelivery.ID, err)
+
+			continue
+		}
+
+		if !claimed {
+			continue
+		}
+
+		alertDelivery.Attempts++
+
+		err = s.webhookSender.Send(ctx, alertRule.WebhookURL, alertRule.WebhookSecret, alertDelivery.ID, []byte(alertDelivery.Payload))
+
+		switch {
+		case err == nil:
+			deliveredAt := time.Now().UTC()
+
+			alertDelivery.Status = AlertDeliveryDelivered
+			alertDelivery.LastError = ""
+			alertDelivery.DeliveredAt = &deliveredAt
+		case alertDelivery.Attempts >= alertDeliveryMaxAttempts:
+			ctx.Logger.Warnf("alert delivery %d moved to dead letters after %d attempts, err: %v", alertDelivery.ID, alertDelivery.Attempts, err)
+
+			alertDelivery.Status = AlertDeliveryDead
+			alertDelivery.LastError = err.Error()
+		default:
+			alertDelivery.LastError = err.Error()
+			alertDelivery.NextAttemptAt = now.Add(alertDeliveryBaseBackoff << (alertDelivery.Attempts - 1))
+		}
+
+		if err = s.alertDeliveryStore.Update(ctx, alertDelivery.ID, alertDelivery); err != nil {
+			ctx.Logger.Errorf("failed to update alert delivery %d, err: %v", alertDelivery.ID, err)
+		}
+	}
+}
+
+// evaluate triggers the active rules matching each of subjects. The rules, the indices and tiers of the securities and the previous
+// values crossing rules compare against are read once for all subjects, previousValues reads the values of securityIDs between two
+// dates keyed by securityMetricKey.
+func (s *alertService) evaluate(ctx *gofr.Context, filter *stores.AlertRuleFilter, subjects []*alertSubject,
+	previousValues func(securityIDs []int, minDate, maxDate time.Time) (map[string]map[string]float64, error)) {
+	if len(subjects) == 0 {
+		return
+	}
+
+	var securityIDs []int
+
+	minDate, maxDate := subjects[0].date, subjects[0].date
+
+	for _, subject := range subjects {
+		if !slices.Contains(securityIDs, subject.securityID) {
+			securityIDs = append(securityIDs, subject.securityID)
+		}
+
+		if subject.date.Before(minDate) {
+			minDate = subject.date
+		}
+
+		if subject.date.After(maxDate) {
+			maxDate = subject.date
+		}
+	}
+
+	constituents, err := s.indexConstituentStore.Index(ctx,
+		&stores.IndexConstituentFilter{SecurityIDs: securityIDs, MinDate: minDate, MaxDate: maxDate}, 0, 0)
+	if err != nil {
+		ctx.Logger.Errorf("failed to read indices of securities %v for alert evaluation, err: %v", securityIDs, err)
+
+		return
+	}
+
+	active := true
+
+	filter.MatchSecurityIDs = securityIDs
+	filter.Active = &active
+
+	for i := range constituents {
+		if !slices.Contains(filter.MatchIndexIDs, constituents[i].IndexID) {
+			filter.MatchIndexIDs = append(filter.MatchIndexIDs, constituents[i].IndexID)
+		}
+	}
+
+	alertRules, err := s.store.Index(ctx, filter, 0, 0)
+	if err != nil {
+		ctx.Logger.Errorf("failed to read alert rules for securities %v, err: %v", securityIDs, err)
+
+		return
+	}
+
+	if len(alertRules) == 0 {
+		return
+	}
+
+	securities, err := s.securityStore.Index(ctx, &stores.SecurityFilter{IDs: securityIDs}, 0, 0)
+	if err != nil {
+		ctx.Logger.Errorf("failed to read securities %v for alert evaluation, err: %v", securityIDs, err)
+
+		return
+	}
+
+	securitiesMap := make(map[int]*stores.Security, len(securities))
+
+	for i := range securities {
+		securitiesMap[securities[i].ID] = securities[i]
+	}
+
+	var (
+		previousDays map[string]time.Time
+		previous     map[string]map[string]float64
+	)
+
+	if slices.ContainsFunc(alertRules, func(alertRule *stores.AlertRule) bool { return alertRuleCrosses(alertRule.Operator) }) {
+		previousDays, previous, err = s.previousValues(ctx, securityIDs, minDate, maxDate, previousValues)
+		if err != nil {
+			ctx.Logger.Errorf("failed to read previous values for securities %v, err: %v", securityIDs, err)
+
+			return
+		}
+	}
+
+	visible := make(map[[2]int]bool)
+
+	for _, subject := range subjects {
+		security := securitiesMap[subject.securityID]
+		if security == nil {
+			continue
+		}
+
+		for _, alertRule := range alertRules {
+			value, ok := subject.values[alertRule.Field]
+			if !ok || (alertRule.MetricID != nil && *alertRule.MetricID != subject.metricID) || !alertRuleTargets(alertRule, subject, constituents) {
+				continue
+			}
+
+			if alertRule.SecurityID == nil {
+				key := [2]int{alertRule.UserID, security.Tier}
+
+				if _, checked := visible[key]; !checked {
+					visible[key] = s.ownerCanSee(ctx, alertRule.UserID, security.Tier)
+				}
+
+				if !visible[key] {
+					continue
+				}
+			}
+
+			var previousValue *float64
+
+			if alertRuleCrosses(alertRule.Operator) {
+				previousDay, ok := previousDays[subject.date.Format(time.DateOnly)]
+				if !ok {
+					continue
+				}
+
+				prev, ok := previous[securityMetricKey(subject.securityID, subject.metricID, previousDay)][alertRule.Field]
+				if !ok {
+					continue
+				}
+
+				previousValue = &prev
+			}
+
+			if !alertTriggered(alertRule.Operator, alertRule.Threshold, value, previousValue) {
+				continue
+			}
+
+			s.trigger(ctx, alertRule, security, subject.date, value, previousValue)
+		}
+	}
+}
+
+// previousValues returns the market day before each day between minDate and maxDate keyed by date, and the values of securityIDs
+// on those days.
+func (s *alertService) previousValues(ctx *gofr.Context, securityIDs []int, minDate, maxDate time.Time,
+	previousValues func(securityIDs []int, minDate, maxDate time.Time) (map[string]map[string]float64, error)) (map[string]time.Time,
+	map[string]map[string]float64, error) {
+	marketDays, err := marketDaysWithLookback(ctx, s.marketDayService, 2, minDate, maxDate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(marketDays) < 2 {
+		return nil, nil, nil
+	}
+
+	previousDays := make(map[string]time.Time, len(marketDays)-1)
+
+	for i := 1; i < len(marketDays); i++ {
+		previousDays[marketDays[i].Format(time.DateOnly)] = marketDays[i-1]
+	}
+
+	values, err := previousValues(securityIDs, marketDays[0], marketDays[len(marketDays)-2])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return previousDays, values, nil
+}
+
+func (s *alertService) trigger(ctx *gofr.Context, alertRule *stores.AlertRule, security *stores.Security, date time.Time, value float64,
+	previousValue *float64) {
+	now := time.Now().UTC()
+
+	serialized, _ := json.Marshal(&AlertPayload{
+		AlertID:       alertRule.ID,
+		AlertName:     alertRule.Name,
+		SecurityID:    security.ID,
+		Symbol:        security.Symbol,
+		IndexID:       alertRule.IndexID,
+		Field:         alertRule.Field,
+		MetricID:      alertRule.MetricID,
+		Operator:      alertRule.Operator,
+		Threshold:     alertRule.Threshold,
+		Value:         value,
+		PreviousValue: previousValue,
+		Date:          date.Format(time.DateOnly),
+		TriggeredAt:   now.Format(time.RFC3339),
+	})
+
+	created, err := s.alertDeliveryStore.Create(ctx, &stores.AlertDelivery{
+		AlertRuleID:   alertRule.ID,
+		SecurityID:    security.ID,
+		Date:          date,
+		Value:         value,
+		Payload:       string(serialized),
+		Status:        AlertDeliveryPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	})
+	if err != nil {
+		ctx.Logger.Errorf("failed to queue alert delivery for rule %d, err: %v", alertRule.ID, err)
+
+		return
+	}
+
+	if !created {
+		return
+	}
+
+	if err = s.store.UpdateLastTriggeredAt(ctx, alertRule.ID, now); err != nil {
+		ctx.Logger.Warnf("failed to update last triggered at for alert rule %d, err: %v", alertRule.ID, err)
+	}
+}
+
+func (s *alertService) ownerCanSee(ctx *gofr.Context, userID, tier int) bool {
+	userTier, err := s.userTierService.Read(ctx, userID)
+	if err != nil {
+		ctx.Logger.Warnf("skipping alert evaluation for userId %d, err: %v", userID, err)
+
+		return false
+	}
+
+	return tier <= userTier
+}
+
+func (s *alertService) validateTarget(ctx *gofr.Context, entitlement *Entitlement, payload *AlertRuleCreate) error {
+	switch {
+	case payload.Field == AlertFieldMetric:
+		if payload.MetricID == nil {
+			return &ErrResp{Code: 400, Message: "metricId is required for metric alerts"}
+		}
+
+		metric, err := s.metricStore.Retrieve(ctx, *payload.MetricID)
+		if err != nil {
+			return err
+		}
+
+		if entitlement.Tier != nil && metric.Tier > *entitlement.Tier {
+			return &ErrResp{Code: 403, Message: "metric is not available on your plan"}
+		}
+	case slices.Contains(statFields, payload.Field):
+		if payload.MetricID != nil {
+			return &ErrResp{Code: 400, Message: "metricId is only allowed for metric alerts"}
+		}
+	default:
+		return &ErrResp{Code: 400, Message: "invalid field - " + payload.Field}
+	}
+
+	if payload.SecurityID != nil && payload.IndexID != nil {
+		return &ErrResp{Code: 400, Message: "an alert targets either a securityId or an indexId"}
+	}
+
+	if payload.IndexID != nil {
+		_, err := s.marketIndexStore.Retrieve(ctx, *payload.IndexID)
+
+		return err
+	}
+
+	if payload.SecurityID == nil {
+		return nil
+	}
+
+	security, err := s.securityStore.Retrieve(ctx, *payload.SecurityID)
+	if err != nil {
+		return err
+	}
+
+	if entitlement.Tier != nil && security.Tier > *entitlement.Tier {
+		return &ErrResp{Code: 403, Message: "security is not available on your plan"}
+	}
+
+	return nil
+}
+
+func (s *alertService) retrieveOwned(ctx *gofr.Context, id int) (*stores.AlertRule, error) {
+	if err := authorize(ctx, auth.WriteAlerts); err != nil {
+		return nil, err
+	}
+
+	alertRule, err := s.store.Retrieve(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	principal := auth.PrincipalFromContext(ctx)
+	if !principal.Can(auth.ManageAllAlerts) && alertRule.UserID != principal.UserID {
+		return nil, http.ErrorEntityNotFound{Name: "alerts", Value: strconv.Itoa(id)}
+	}
+
+	return alertRule, nil
+}
+
+func (s *alertService) buildResp(model *stores.AlertRule, withSecret bool) *AlertRule {
+	resp := &AlertRule{
+		ID:              model.ID,
+		UserID:          model.UserID,
+		Name:            model.Name,
+		SecurityID:      model.SecurityID,
+		IndexID:         model.IndexID,
+		Field:           model.Field,
+		MetricID:        model.MetricID,
+		Operator:        model.Operator,
+		Threshold:       model.Threshold,
+		WebhookURL:      model.WebhookURL,
+		Active:          model.Active,
+		LastTriggeredAt: model.LastTriggeredAt,
+		CreatedAt:       model.CreatedAt,
+		UpdatedAt:       model.UpdatedAt,
+	}
+
+	if withSecret {
+		resp.WebhookSecret = model.WebhookSecret
+	}
+
+	return resp
+}
+
+func (s *alertService) buildDeliveryResp(model *stores.AlertDelivery) *AlertDelivery {
+	resp := &AlertDelivery{
+		ID:            model.ID,
+		AlertRuleID:   model.AlertRuleID,
+		SecurityID:    model.SecurityID,
+		Date:          model.Date,
+		Value:         model.Value,
+		Status:        model.Status,
+		Attempts:      model.Attempts,
+		LastError:     model.LastError,
+		NextAttemptAt: model.NextAttemptAt,
+		DeliveredAt:   model.DeliveredAt,
+		CreatedAt:     model.CreatedAt,
+	}
+
+	return resp
+}
+
+// alertRuleTargets reports whether alertRule watches the security of subject on its date, constituents are the index memberships of
+// the evaluated securities.
+func alertRuleTargets(alertRule *stores.AlertRule, subject *alertSubject, constituents []*stores.IndexConstituent) bool {
+	switch {
+	case alertRule.SecurityID != nil:
+		return *alertRule.SecurityID == subject.securityID
+	case alertRule.IndexID != nil:
+		date := subject.date.Format(time.DateOnly)
+
+		return slices.ContainsFunc(constituents, func(ic *stores.IndexConstituent) bool {
+			return ic.IndexID == *alertRule.IndexID && ic.SecurityID == subject.securityID && ic.EffectiveFrom.Format(time.DateOnly) <= date &&
+				(ic.EffectiveTo == nil || ic.EffectiveTo.Format(time.DateOnly) > date)
+		})
+	default:
+		return true
+	}
+}
+
+func alertRuleCrosses(operator string) bool {
+	return operator == AlertOperatorCrossesAbove || operator == AlertOperatorCrossesBelow
+}
+
+func statAlertValues(securityStat *stores.SecurityStat) map[string]float64 {
+	return map[string]float64{
+		AlertFieldOpen:   securityStat.Open,
+		AlertFieldClose:  securityStat.Close,
+		AlertFieldHigh:   securityStat.High,
+		AlertFieldLow:    securityStat.Low,
+		AlertFieldVolume: float64(securityStat.Volume),
+	}
+}
+
+func alertTriggered(operator string, threshold, value float64, previousValue *float64) bool {
+	switch operator {
+	case AlertOperatorAbove:
+		return value > threshold
+	case AlertOperatorBelow:
+		return value < threshold
+	case AlertOperatorCrossesAbove:
+		return previousValue != nil && *previousValue <= threshold && value > threshold
+	case AlertOperatorCrossesBelow:
+		return previousValue != nil && *previousValue >= threshold && value < threshold
+	default:
+		return false
+	}
+}
+
+func validateAlertCondition(operator, webhookURL string) error {
+	if !slices.Contains(alertOperators, operator) {
+		return &ErrResp{Code: 400, Message: "invalid operator - " + operator}
+	}
+
+	u, err := url.Parse(webhookURL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return &ErrResp{Code: 400, Message: "webhookUrl must be an absolute https url"}
+	}
+
+	return nil
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return alertWebhookSecretPrefix + hex.EncodeToString(secret), nil
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+)
+
+const alertWebhookTimeout = 10 * time.Second
+
+type AlertWebhookSender interface {
+	Send(ctx *gofr.Context, webhookURL, secret string, deliveryID int, payload []byte) error
+}
+
+type alertWebhookSender struct {
+	client *http.Client
+}
+
+var errWebhookNotHTTPS = errors.New("webhook url must be https")
+
+// webhookDeniedPrefixes are the special purpose ranges a webhook may not connect to, every address outside them is public.
+var webhookDeniedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("10.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("127.0.0.0/8"),
+	netip.MustParsePrefix("169.254.0.0/16"),
+	netip.MustParsePrefix("172.16.0.0/12"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("192.88.99.0/24"),
+	netip.MustParsePrefix("192.168.0.0/16"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("224.0.0.0/4"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("::/127"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+	netip.MustParsePrefix("100::/64"),
+	netip.MustParsePrefix("2001::/23"),
+	netip.MustParsePrefix("2001:db8::/32"),
+	netip.MustParsePrefix("fc00::/7"),
+	netip.MustParsePrefix("fe80::/10"),
+	netip.MustParsePrefix("fec0::/10"),
+	netip.MustParsePrefix("ff00::/8"),
+}
+
+var (
+	// webhookNAT64Prefix and webhook6to4Prefix embed an ipv4 address, which is checked in their place.
+	webhookNAT64Prefix = netip.MustParsePrefix("64:ff9b::/96")
+	webhook6to4Prefix  = netip.MustParsePrefix("2002::/16")
+)
+
+func NewAlertWebhookSender() *alertWebhookSender {
+	dialer := &net.Dialer{Timeout: alertWebhookTimeout, Control: dialPublicOnly}
+
+	return &alertWebhookSender{client: &http.Client{
+		Timeout:   alertWebhookTimeout,
+		Transport: &http.Transport{DialContext: dialer.DialContext, TLSHandshakeTimeout: alertWebhookTimeout},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if req.URL.Scheme != "https" {
+				return errWebhookNotHTTPS
+			}
+
+			if len(via) >= 5 {
+				return errors.New("stopped after 5 redirects")
+			}
+
+			return nil
+		},
+	}}
+}
+
+// Send signs timestamp.payload with HMAC-SHA256 of the rule's secret, receivers should verify the signature and
+// reject stale timestamps.
+func (s *alertWebhookSender) Send(ctx *gofr.Context, webhookURL, secret string, deliveryID int, payload []byte) error {
+	if u, err := url.Parse(webhookURL); err != nil || u.Scheme != "https" {
+		return errWebhookNotHTTPS
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Alert-Delivery-Id", strconv.Itoa(deliveryID))
+	req.Header.Set("X-Alert-Timestamp", timestamp)
+	req.Header.Set("X-Alert-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// dialPublicOnly runs on the resolved address of every connection, redirects included, so a webhook cannot reach the services next to
+// this one whether its url names them directly or through a host that resolves to them.
+func dialPublicOnly(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+
+	if !publiclyRoutable(ip) {
+		return fmt.Errorf("webhook address %s is not publicly routable", ip)
+	}
+
+	return nil
+}
+
+func publiclyRoutable(ip netip.Addr) bool {
+	ip = ip.WithZone("").Unmap()
+
+	switch b := ip.As16(); {
+	case webhookNAT64Prefix.Contains(ip):
+		ip = netip.AddrFrom4([4]byte(b[12:16]))
+	case webhook6to4Prefix.Contains(ip):
+		ip = netip.AddrFrom4([4]byte(b[2:6]))
+	}
+
+	for _, prefix := range webhookDeniedPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
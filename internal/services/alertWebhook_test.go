@@ -0,0 +1,360 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/logging"
+
+	"github.com/stratifyr/security-service/internal/stores"
+)
+
+func newTestContext() *gofr.Context {
+	return &gofr.Context{Context: context.Background(), Container: &container.Container{Logger: logging.NewMockLogger(logging.ERROR)}}
+}
+
+func TestAlertWebhookSender_Send_Signs(t *testing.T) {
+	var (
+		header http.Header
+		body   []byte
+	)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	sender := &alertWebhookSender{client: server.Client()}
+	payload := []byte(`{"alertId":1,"value":71.5}`)
+
+	if err := sender.Send(newTestContext(), server.URL+"/hook", "whsec_test", 42, payload); err != nil {
+		t.Fatalf("Send() err = %v", err)
+	}
+
+	if string(body) != string(payload) {
+		t.Errorf("body = %s, want %s", body, payload)
+	}
+
+	if got := header.Get("X-Alert-Delivery-Id"); got != "42" {
+		t.Errorf("X-Alert-Delivery-Id = %q, want 42", got)
+	}
+
+	timestamp := header.Get("X-Alert-Timestamp")
+
+	sentAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || time.Since(time.Unix(sentAt, 0)) > time.Minute {
+		t.Errorf("X-Alert-Timestamp = %q, want the current unix time", timestamp)
+	}
+
+	mac := hmac.New(sha256.New, []byte("whsec_test"))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+
+	if got, want := header.Get("X-Alert-Signature"), "sha256="+hex.EncodeToString(mac.Sum(nil)); got != want {
+		t.Errorf("X-Alert-Signature = %q, want %q", got, want)
+	}
+}
+
+func TestAlertWebhookSender_Send_Errors(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		sender  *alertWebhookSender
+		url     string
+		wantErr string
+	}{
+		{name: "plain http", sender: NewAlertWebhookSender(), url: "http://example.com/hook", wantErr: errWebhookNotHTTPS.Error()},
+		{name: "loopback address", sender: NewAlertWebhookSender(), url: server.URL, wantErr: "not publicly routable"},
+		{name: "non 2xx response", sender: &alertWebhookSender{client: server.Client()}, url: server.URL, wantErr: "status 503"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.sender.Send(newTestContext(), tc.url, "whsec_test", 1, []byte(`{}`))
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("Send() err = %v, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestDialPublicOnly(t *testing.T) {
+	tests := []struct {
+		address string
+		allowed bool
+	}{
+		{address: "93.184.216.34:443", allowed: true},
+		{address: "[2606:2800:220:1::]:443", allowed: true},
+		{address: "127.0.0.1:443"},
+		{address: "10.1.2.3:443"},
+		{address: "172.16.0.1:443"},
+		{address: "192.168.1.1:443"},
+		{address: "169.254.169.254:80"},
+		{address: "0.0.0.0:443"},
+		{address: "[::1]:443"},
+		{address: "[fd00::1]:443"},
+		{address: "[fe80::1]:443"},
+		{address: "[::ffff:127.0.0.1]:443"},
+		{address: "100.64.0.1:443"},
+		{address: "100.127.255.254:443"},
+		{address: "192.0.0.8:443"},
+		{address: "198.18.0.1:443"},
+		{address: "198.19.255.255:443"},
+		{address: "240.0.0.1:443"},
+		{address: "255.255.255.255:443"},
+		{address: "[::ffff:10.0.0.1]:443"},
+		{address: "[::ffff:100.64.0.1]:443"},
+		{address: "[64:ff9b::a00:1]:443"},
+		{address: "[64:ff9b::7f00:1]:443"},
+		{address: "[64:ff9b::5db8:d822]:443", allowed: true},
+		{address: "[2002:c0a8:101::1]:443"},
+		{address: "[2002:5db8:d822::1]:443", allowed: true},
+		{address: "[fe80::1%eth0]:443"},
+		{address: "[2001:db8::1]:443"},
+		{address: "100.128.0.1:443", allowed: true},
+	}
+
+	for _, tc := range tests {
+		if err := dialPublicOnly("tcp", tc.address, nil); (err == nil) != tc.allowed {
+			t.Errorf("dialPublicOnly(%s) err = %v, want allowed %v", tc.address, err, tc.allowed)
+		}
+	}
+}
+
+func TestAlertService_Deliver(t *testing.T) {
+	const failure, success = http.StatusInternalServerError, http.StatusNoContent
+
+	tests := []struct {
+		name         string
+		responses    []int
+		wantStatus   string
+		wantAttempts int
+	}{
+		{name: "delivered on the first attempt", responses: []int{success}, wantStatus: AlertDeliveryDelivered, wantAttempts: 1},
+		{name: "delivered after retries", responses: []int{failure, failure, success}, wantStatus: AlertDeliveryDelivered, wantAttempts: 3},
+		{
+			name:         "dead lettered after the last attempt",
+			responses:    []int{failure, failure, failure, failure, failure, failure},
+			wantStatus:   AlertDeliveryDead,
+			wantAttempts: alertDeliveryMaxAttempts,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var requests int
+
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.responses[min(requests, len(tc.responses)-1)])
+				requests++
+			}))
+			defer server.Close()
+
+			deliveries := &fakeAlertDeliveryStore{deliveries: map[int]*stores.AlertDelivery{
+				7: {ID: 7, AlertRuleID: 1, Payload: `{}`, Status: AlertDeliveryPending, NextAttemptAt: time.Now().Add(-time.Minute)},
+			}}
+
+			svc := &alertService{
+				webhookSender:      &alertWebhookSender{client: server.Client()},
+				alertDeliveryStore: deliveries,
+				store: &fakeAlertRuleStore{alertRules: map[int]*stores.AlertRule{
+					1: {ID: 1, WebhookURL: server.URL, WebhookSecret: "whsec_test"},
+				}},
+			}
+
+			for attempt := 1; attempt <= len(tc.responses); attempt++ {
+				startedAt := time.Now()
+
+				svc.Deliver(newTestContext())
+
+				delivery := deliveries.deliveries[7]
+
+				if delivery.Attempts != attempt {
+					t.Fatalf("attempt %d: attempts = %d", attempt, delivery.Attempts)
+				}
+
+				if delivery.Status != AlertDeliveryPending {
+					break
+				}
+
+				backoff := alertDeliveryBaseBackoff << (attempt - 1)
+
+				if wait := delivery.NextAttemptAt.Sub(startedAt); wait < backoff || wait > backoff+time.Second {
+					t.Errorf("attempt %d: next attempt in %v, want %v", attempt, wait, backoff)
+				}
+
+				if !strings.Contains(delivery.LastError, "status 500") {
+					t.Errorf("attempt %d: last error = %q", attempt, delivery.LastError)
+				}
+
+				delivery.NextAttemptAt = time.Now().Add(-time.Second)
+			}
+
+			// a dead or delivered delivery is not picked up again
+			svc.Deliver(newTestContext())
+
+			delivery := deliveries.deliveries[7]
+
+			if delivery.Status != tc.wantStatus || delivery.Attempts != tc.wantAttempts || requests != tc.wantAttempts {
+				t.Errorf("status = %s after %d attempts and %d requests, want %s after %d", delivery.Status, delivery.Attempts, requests,
+					tc.wantStatus, tc.wantAttempts)
+			}
+
+			if (delivery.DeliveredAt != nil) != (tc.wantStatus == AlertDeliveryDelivered) {
+				t.Errorf("deliveredAt = %v for status %s", delivery.DeliveredAt, delivery.Status)
+			}
+		})
+	}
+}
+
+func TestAlertService_Deliver_Overlapping(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	deliveries := &fakeAlertDeliveryStore{deliveries: map[int]*stores.AlertDelivery{
+		7: {ID: 7, AlertRuleID: 1, Payload: `{}`, Status: AlertDeliveryPending, NextAttemptAt: time.Now().Add(-time.Minute)},
+	}}
+
+	svc := &alertService{
+		webhookSender:      &alertWebhookSender{client: server.Client()},
+		alertDeliveryStore: deliveries,
+		store: &fakeAlertRuleStore{alertRules: map[int]*stores.AlertRule{
+			1: {ID: 1, WebhookURL: server.URL, WebhookSecret: "whsec_test"},
+		}},
+	}
+
+	var wg sync.WaitGroup
+
+	// runs overlapping as they do across cron ticks and replicas all read the delivery as pending
+	for range 4 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			svc.Deliver(newTestContext())
+		}()
+	}
+
+	wg.Wait()
+
+	if delivery := deliveries.deliveries[7]; requests.Load() != 1 || delivery.Attempts != 1 || delivery.Status != AlertDeliveryDelivered {
+		t.Errorf("status = %s after %d attempts and %d requests, want %s after 1", delivery.Status, delivery.Attempts, requests.Load(),
+			AlertDeliveryDelivered)
+	}
+}
+
+type fakeAlertDeliveryStore struct {
+	mu         sync.Mutex
+	deliveries map[int]*stores.AlertDelivery
+}
+
+func (s *fakeAlertDeliveryStore) Index(_ *gofr.Context, filter *stores.AlertDeliveryFilter, _, _ int) ([]*stores.AlertDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var alertDeliveries []*stores.AlertDelivery
+
+	for _, ad := range s.deliveries {
+		if ad.Status == filter.Status && !ad.NextAttemptAt.After(filter.DueBefore) {
+			clone := *ad
+			alertDeliveries = append(alertDeliveries, &clone)
+		}
+	}
+
+	return alertDeliveries, nil
+}
+
+func (s *fakeAlertDeliveryStore) Claim(_ *gofr.Context, id int, now, leaseUntil time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ad := s.deliveries[id]
+	if ad == nil || ad.Status != AlertDeliveryPending || ad.NextAttemptAt.After(now) {
+		return false, nil
+	}
+
+	ad.Attempts++
+	ad.NextAttemptAt = leaseUntil
+
+	return true, nil
+}
+
+func (s *fakeAlertDeliveryStore) Count(*gofr.Context, *stores.AlertDeliveryFilter) (int, error) {
+	return len(s.deliveries), nil
+}
+
+func (s *fakeAlertDeliveryStore) Retrieve(_ *gofr.Context, id int) (*stores.AlertDelivery, error) {
+	return s.deliveries[id], nil
+}
+
+func (s *fakeAlertDeliveryStore) Create(*gofr.Context, *stores.AlertDelivery) (bool, error) {
+	return false, errors.New("not implemented")
+}
+
+func (s *fakeAlertDeliveryStore) Update(_ *gofr.Context, id int, alertDelivery *stores.AlertDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clone := *alertDelivery
+	s.deliveries[id] = &clone
+
+	return nil
+}
+
+type fakeAlertRuleStore struct {
+	alertRules map[int]*stores.AlertRule
+}
+
+func (s *fakeAlertRuleStore) Index(*gofr.Context, *stores.AlertRuleFilter, int, int) ([]*stores.AlertRule, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (s *fakeAlertRuleStore) Count(*gofr.Context, *stores.AlertRuleFilter) (int, error) {
+	return len(s.alertRules), nil
+}
+
+func (s *fakeAlertRuleStore) Retrieve(_ *gofr.Context, id int) (*stores.AlertRule, error) {
+	return s.alertRules[id], nil
+}
+
+func (s *fakeAlertRuleStore) Create(*gofr.Context, *stores.AlertRule) (*stores.AlertRule, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (s *fakeAlertRuleStore) Update(*gofr.Context, int, *stores.AlertRule) (*stores.AlertRule, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (s *fakeAlertRuleStore) UpdateLastTriggeredAt(*gofr.Context, int, time.Time) error {
+	return nil
+}
+
+func (s *fakeAlertRuleStore) Delete(*gofr.Context, int) error {
+	return nil
+}
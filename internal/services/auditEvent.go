@@ -0,0 +1,234 @@
+package services
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/stratifyr/security-service/internal/auth"
+	"github.com/stratifyr/security-service/internal/stores"
+)
+
+const (
+	AuditActionCreate = "create"
+	AuditActionUpdate = "update"
+	AuditActionDelete = "delete"
+)
+
+var auditIgnoredFields = map[string]bool{"CreatedAt": true, "UpdatedAt": true}
+
+type AuditEventService interface {
+	Index(ctx *gofr.Context, f *AuditEventFilter, page, perPage int) ([]*AuditEvent, int, error)
+	Record(ctx *gofr.Context, entity string, entityID int, action string, before, after any) error
+	RecordBatch(ctx *gofr.Context, entity string, records []*AuditRecord) error
+}
+
+// AuditRecord is one mutation of a RecordBatch.
+type AuditRecord struct {
+	EntityID int
+	Action   string
+	Before   any
+	After    any
+}
+
+type AuditEventFilter struct {
+	Actor         string
+	Entity        string
+	EntityID      int
+	Action        string
+	Source        string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+}
+
+type AuditEvent struct {
+	ID        int
+	Actor     string
+	ActorType string
+	Entity    string
+	EntityID  int
+	Action    string
+	Source    string
+	Changes   map[string]*AuditChange
+	CreatedAt time.Time
+}
+
+type AuditChange struct {
+	From any `json:"from"`
+	To   any `json:"to"`
+}
+
+type auditEventService struct {
+	store stores.AuditEventStore
+}
+
+func NewAuditEventService(store stores.AuditEventStore) *auditEventService {
+	return &auditEventService{store: store}
+}
+
+func (s *auditEventService) Index(ctx *gofr.Context, f *AuditEventFilter, page, perPage int) ([]*AuditEvent, int, error) {
+	if err := authorize(ctx, auth.ReadAuditEvents); err != nil {
+		return nil, 0, err
+	}
+
+	limit := perPage
+	offset := limit * (page - 1)
+
+	filter := &stores.AuditEventFilter{
+		Actor:         f.Actor,
+		Entity:        f.Entity,
+		EntityID:      f.EntityID,
+		Action:        f.Action,
+		Source:        f.Source,
+		CreatedAfter:  f.CreatedAfter,
+		CreatedBefore: f.CreatedBefore,
+	}
+
+	auditEvents, err := s.store.Index(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.store.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if count == 0 {
+		return nil, 0, nil
+	}
+
+	var resp = make([]*AuditEvent, len(auditEvents))
+
+	for i := range auditEvents {
+		resp[i] = s.buildResp(auditEvents[i])
+	}
+
+	return resp, count, nil
+}
+
+// Record writes the audit event of a mutation, callers record inside the stores.InTx of the mutation so that it is rolled back
+// when the event cannot be written.
+func (s *auditEventService) Record(ctx *gofr.Context, entity string, entityID int, action string, before, after any) error {
+	model, err := newAuditEvent(ctx, entity, &AuditRecord{EntityID: entityID, Action: action, Before: before, After: after})
+	if err != nil || model == nil {
+		return err
+	}
+
+	return s.store.Create(ctx, model)
+}
+
+// RecordBatch writes the audit events of the mutations of a bulk write with a single insert, inside its stores.InTx like Record.
+func (s *auditEventService) RecordBatch(ctx *gofr.Context, entity string, records []*AuditRecord) error {
+	var models []*stores.AuditEvent
+
+	for _, record := range records {
+		model, err := newAuditEvent(ctx, entity, record)
+		if err != nil {
+			return err
+		}
+
+		if model != nil {
+			models = append(models, model)
+		}
+	}
+
+	if len(models) == 0 {
+		return nil
+	}
+
+	return s.store.CreateBatch(ctx, models)
+}
+
+func (s *auditEventService) buildResp(model *stores.AuditEvent) *AuditEvent {
+	resp := &AuditEvent{
+		ID:        model.ID,
+		Actor:     model.Actor,
+		ActorType: model.ActorType,
+		Entity:    model.Entity,
+		EntityID:  model.EntityID,
+		Action:    model.Action,
+		Source:    model.Source,
+		Changes:   make(map[string]*AuditChange),
+		CreatedAt: model.CreatedAt,
+	}
+
+	_ = json.Unmarshal([]byte(model.Changes), &resp.Changes)
+
+	return resp
+}
+
+// newAuditEvent returns the audit event of record, or nil for an update that changed nothing.
+func newAuditEvent(ctx *gofr.Context, entity string, record *AuditRecord) (*stores.AuditEvent, error) {
+	changes := diffFields(toFields(record.Before), toFields(record.After))
+	if record.Action == AuditActionUpdate && len(changes) == 0 {
+		return nil, nil
+	}
+
+	serialized, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, actorType := "anonymous", "anonymous"
+
+	if principal := auth.PrincipalFromContext(ctx); principal != nil {
+		actor, actorType = principal.Name, "service"
+
+		if principal.UserID != 0 {
+			actorType = "user"
+		}
+	}
+
+	return &stores.AuditEvent{
+		Actor:     actor,
+		ActorType: actorType,
+		Entity:    entity,
+		EntityID:  record.EntityID,
+		Action:    record.Action,
+		Source:    string(auth.SourceFromContext(ctx)),
+		Changes:   string(serialized),
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+func toFields(v any) map[string]any {
+	fields := make(map[string]any)
+
+	if v == nil {
+		return fields
+	}
+
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return fields
+	}
+
+	serialized, err := json.Marshal(v)
+	if err != nil {
+		return fields
+	}
+
+	_ = json.Unmarshal(serialized, &fields)
+
+	return fields
+}
+
+func diffFields(before, after map[string]any) map[string]*AuditChange {
+	changes := make(map[string]*AuditChange)
+
+	for field, value := range after {
+		if !auditIgnoredFields[field] && !reflect.DeepEqual(before[field], value) {
+			changes[field] = &AuditChange{From: before[field], To: value}
+		}
+	}
+
+	for field, value := range before {
+		if _, ok := after[field]; !ok && !auditIgnoredFields[field] {
+			changes[field] = &AuditChange{From: value, To: nil}
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,20 @@
+package services
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"github.com/stratifyr/security-service/internal/auth"
+)
+
+func authorize(ctx *gofr.Context, permission auth.Permission) error {
+	principal := auth.PrincipalFromContext(ctx)
+	if principal == nil {
+		return &ErrResp{Code: 401, Message: "Unauthorized"}
+	}
+
+	if !principal.Can(permission) {
+		return &ErrResp{Code: 403, Message: "Forbidden"}
+	}
+
+	return nil
+}
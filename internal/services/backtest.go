@@ -0,0 +1,538 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+
+	"github.com/stratifyr/security-service/internal/auth"
+	"github.com/stratifyr/security-service/internal/stores"
+)
+
+const (
+	BacktestQueued    = "queued"
+	BacktestRunning   = "running"
+	BacktestCompleted = "completed"
+	BacktestFailed    = "failed"
+)
+
+const (
+	BacktestSizingEqualWeight   = "equal_weight"
+	BacktestSizingFixedAmount   = "fixed_amount"
+	BacktestSizingPercentEquity = "percent_equity"
+)
+
+const (
+	backtestMaxInProgress = 2
+	backtestMaxSpan       = 5 * 366 * 24 * time.Hour
+	backtestMaxUniverse   = 500
+	backtestMaxPositions  = 50
+	backtestMaxConditions = 10
+	backtestTimeout       = 30 * time.Minute
+	backtestNameMaxLength = 100
+)
+
+type BacktestService interface {
+	Index(ctx *gofr.Context, page, perPage int) ([]*Backtest, int, error)
+	Read(ctx *gofr.Context, id int) (*Backtest, error)
+	Create(ctx *gofr.Context, payload *BacktestCreate) (*Backtest, error)
+	Delete(ctx *gofr.Context, id int) error
+	Run(ctx *gofr.Context)
+}
+
+type Backtest struct {
+	ID          int
+	UserID      int
+	Name        string
+	Strategy    *BacktestStrategy
+	Status      string
+	Result      *BacktestResult
+	Error       string
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	CreatedAt   time.Time
+}
+
+type BacktestCreate struct {
+	Name     string
+	Strategy *BacktestStrategy
+}
+
+type BacktestStrategy struct {
+	StartDate         time.Time            `json:"startDate"`
+	EndDate           time.Time            `json:"endDate"`
+	InitialCapital    float64              `json:"initialCapital"`
+	Universe          BacktestUniverse     `json:"universe"`
+	Entry             []*BacktestCondition `json:"entry"`
+	Exit              []*BacktestCondition `json:"exit"`
+	StopLossPercent   float64              `json:"stopLossPercent"`
+	TakeProfitPercent float64              `json:"takeProfitPercent"`
+	Sizing            BacktestSizing       `json:"sizing"`
+}
+
+// BacktestUniverse filters are combined, a security has to match every filter that is set.
+type BacktestUniverse struct {
+	SecurityIDs []int    `json:"securityIds"`
+	Symbols     []string `json:"symbols"`
+	Industries  []string `json:"industries"`
+}
+
+type BacktestCondition struct {
+	Left     BacktestOperand `json:"left"`
+	Operator string          `json:"operator"`
+	Right    BacktestOperand `json:"right"`
+}
+
+type BacktestOperand struct {
+	Field    string   `json:"field,omitempty"`
+	MetricID int      `json:"metricId,omitempty"`
+	Value    *float64 `json:"value,omitempty"`
+}
+
+type BacktestSizing struct {
+	Type         string  `json:"type"`
+	Value        float64 `json:"value"`
+	MaxPositions int     `json:"maxPositions"`
+}
+
+type backtestService struct {
+	entitlementService  EntitlementService
+	marketDayService    MarketDayService
+	industryService     IndustryService
+	metricStore         stores.MetricStore
+	securityStore       stores.SecurityStore
+	securityStatStore   stores.SecurityStatStore
+	securityMetricStore stores.SecurityMetricStore
+	store               stores.BacktestStore
+}
+
+func NewBacktestService(entitlementService EntitlementService, marketDayService MarketDayService, industryService IndustryService,
+	metricStore stores.MetricStore, securityStore stores.SecurityStore, securityStatStore stores.SecurityStatStore,
+	securityMetricStore stores.SecurityMetricStore, store stores.BacktestStore) *backtestService {
+	return &backtestService{
+		entitlementService:  entitlementService,
+		marketDayService:    marketDayService,
+		industryService:     industryService,
+		metricStore:         metricStore,
+		securityStore:       securityStore,
+		securityStatStore:   securityStatStore,
+		securityMetricStore: securityMetricStore,
+		store:               store,
+	}
+}
+
+func (s *backtestService) Index(ctx *gofr.Context, page, perPage int) ([]*Backtest, int, error) {
+	if err := authorize(ctx, auth.RunBacktests); err != nil {
+		return nil, 0, err
+	}
+
+	limit := perPage
+	offset := limit * (page - 1)
+
+	filter := &stores.BacktestFilter{UserID: auth.PrincipalFromContext(ctx).UserID}
+
+	if filter.UserID == 0 {
+		return nil, 0, nil
+	}
+
+	backtests, err := s.store.Index(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.store.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if count == 0 {
+		return nil, 0, nil
+	}
+
+	var resp = make([]*Backtest, len(backtests))
+
+	for i := range backtests {
+		resp[i] = s.buildResp(backtests[i])
+	}
+
+	return resp, count, nil
+}
+
+func (s *backtestService) Read(ctx *gofr.Context, id int) (*Backtest, error) {
+	backtest, err := s.retrieveOwned(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(backtest), nil
+}
+
+func (s *backtestService) Create(ctx *gofr.Context, payload *BacktestCreate) (*Backtest, error) {
+	if err := authorize(ctx, auth.RunBacktests); err != nil {
+		return nil, err
+	}
+
+	principal := auth.PrincipalFromContext(ctx)
+	if principal.UserID == 0 {
+		return nil, &ErrResp{Code: 403, Message: "backtests can only be run by user accounts"}
+	}
+
+	entitlement, err := s.entitlementService.Require(ctx, FeatureHistory)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.Name == "" || len(payload.Name) > backtestNameMaxLength {
+		return nil, &ErrResp{Code: 400, Message: fmt.Sprintf("name is required and must be at most %d characters", backtestNameMaxLength)}
+	}
+
+	if payload.Strategy == nil {
+		return nil, &ErrResp{Code: 400, Message: "strategy is required"}
+	}
+
+	if err = s.validateStrategy(ctx, entitlement, payload.Strategy); err != nil {
+		return nil, err
+	}
+
+	count, err := s.store.Count(ctx, &stores.BacktestFilter{UserID: principal.UserID, Statuses: []string{BacktestQueued, BacktestRunning}})
+	if err != nil {
+		return nil, err
+	}
+
+	if count >= backtestMaxInProgress {
+		return nil, &ErrResp{Code: 400, Message: fmt.Sprintf("at most %d backtests can be in progress at once", backtestMaxInProgress)}
+	}
+
+	serialized, err := json.Marshal(payload.Strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	model := &stores.Backtest{
+		UserID:    principal.UserID,
+		Name:      payload.Name,
+		Strategy:  string(serialized),
+		MaxTier:   entitlement.Tier,
+		Status:    BacktestQueued,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	backtest, err := s.store.Create(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(backtest), nil
+}
+
+func (s *backtestService) Delete(ctx *gofr.Context, id int) error {
+	backtest, err := s.retrieveOwned(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if backtest.Status == BacktestRunning {
+		return &ErrResp{Code: 400, Message: "cannot delete a running backtest"}
+	}
+
+	return s.store.Delete(ctx, id)
+}
+
+// Run fails backtests that outlived backtestTimeout and then runs the oldest queued one, Claim keeps concurrent
+// runners from picking the same backtest.
+func (s *backtestService) Run(ctx *gofr.Context) {
+	now := time.Now().UTC()
+
+	stale, err := s.store.Index(ctx, &stores.BacktestFilter{Statuses: []string{BacktestRunning}, StartedBefore: now.Add(-backtestTimeout)}, 0, 0)
+	if err != nil {
+		ctx.Logger.Errorf("failed to read stale backtests, err: %v", err)
+
+		return
+	}
+
+	for _, backtest := range stale {
+		s.complete(ctx, backtest, nil, fmt.Errorf("timed out after %s", backtestTimeout))
+	}
+
+	queued, err := s.store.Index(ctx, &stores.BacktestFilter{Statuses: []string{BacktestQueued}}, 1, 0)
+	if err != nil {
+		ctx.Logger.Errorf("failed to read queued backtests, err: %v", err)
+
+		return
+	}
+
+	if len(queued) == 0 {
+		return
+	}
+
+	claimed, err := s.store.Claim(ctx, queued[0].ID, now)
+	if err != nil || !claimed {
+		return
+	}
+
+	backtest, err := s.store.Retrieve(ctx, queued[0].ID)
+	if err != nil {
+		ctx.Logger.Errorf("failed to read backtest %d, err: %v", queued[0].ID, err)
+
+		return
+	}
+
+	result, err := s.execute(ctx, backtest)
+
+	s.complete(ctx, backtest, result, err)
+}
+
+func (s *backtestService) execute(ctx *gofr.Context, backtest *stores.Backtest) (*BacktestResult, error) {
+	var strategy BacktestStrategy
+
+	if err := json.Unmarshal([]byte(backtest.Strategy), &strategy); err != nil {
+		return nil, err
+	}
+
+	industryIDs, err := s.industryService.Expand(ctx, strategy.Universe.Industries)
+	if err != nil {
+		return nil, err
+	}
+
+	securities, err := s.securityStore.Index(ctx, &stores.SecurityFilter{
+		IDs:         strategy.Universe.SecurityIDs,
+		Symbols:     strategy.Universe.Symbols,
+		IndustryIDs: industryIDs,
+		MaxTier:     backtest.MaxTier,
+	}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(securities) == 0 {
+		return nil, &ErrResp{Code: 400, Message: "universe does not match any security"}
+	}
+
+	if len(securities) > backtestMaxUniverse {
+		return nil, &ErrResp{Code: 400, Message: fmt.Sprintf("universe matches %d securities, at most %d are allowed", len(securities), backtestMaxUniverse)}
+	}
+
+	marketDays, err := marketDaysBetween(ctx, s.marketDayService, strategy.StartDate, strategy.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var securityIDs = make([]int, len(securities))
+
+	for i := range securities {
+		securityIDs[i] = securities[i].ID
+	}
+
+	securityStats, err := s.securityStatStore.Index(ctx, &stores.SecurityStatFilter{
+		SecurityIDs: securityIDs,
+		MinDate:     strategy.StartDate,
+		MaxDate:     strategy.EndDate,
+	}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var securityMetrics []*stores.SecurityMetric
+
+	if metricIDs := strategy.metricIDs(); len(metricIDs) > 0 {
+		securityMetrics, err = s.securityMetricStore.Index(ctx, &stores.SecurityMetricFilter{
+			SecurityIDs: securityIDs,
+			MetricIDs:   metricIDs,
+			MinDate:     strategy.StartDate,
+			MaxDate:     strategy.EndDate,
+		}, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return simulateBacktest(&strategy, marketDays, securities, newBacktestData(securityStats, securityMetrics)), nil
+}
+
+func (s *backtestService) complete(ctx *gofr.Context, backtest *stores.Backtest, result *BacktestResult, err error) {
+	completedAt := time.Now().UTC()
+
+	backtest.Status = BacktestCompleted
+	backtest.CompletedAt = &completedAt
+
+	if err != nil {
+		ctx.Logger.Warnf("backtest %d failed, err: %v", backtest.ID, err)
+
+		backtest.Status = BacktestFailed
+		backtest.Error = err.Error()
+	} else {
+		serialized, _ := json.Marshal(result)
+
+		backtest.Result = string(serialized)
+	}
+
+	if err = s.store.Complete(ctx, backtest.ID, backtest); err != nil {
+		ctx.Logger.Errorf("failed to complete backtest %d, err: %v", backtest.ID, err)
+	}
+}
+
+func (s *backtestService) validateStrategy(ctx *gofr.Context, entitlement *Entitlement, strategy *BacktestStrategy) error {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	switch {
+	case strategy.StartDate.IsZero() || strategy.EndDate.IsZero():
+		return &ErrResp{Code: 400, Message: "startDate and endDate are required"}
+	case !strategy.StartDate.Before(strategy.EndDate):
+		return &ErrResp{Code: 400, Message: "startDate must be before endDate"}
+	case strategy.EndDate.After(today):
+		return &ErrResp{Code: 400, Message: "endDate cannot be in the future"}
+	case strategy.EndDate.Sub(strategy.StartDate) > backtestMaxSpan:
+		return &ErrResp{Code: 400, Message: "a backtest can span at most 5 years"}
+	}
+
+	if err := entitlement.CheckDate(strategy.StartDate); err != nil {
+		return err
+	}
+
+	switch {
+	case strategy.InitialCapital <= 0:
+		return &ErrResp{Code: 400, Message: "initialCapital must be positive"}
+	case len(strategy.Entry) == 0:
+		return &ErrResp{Code: 400, Message: "at least one entry condition is required"}
+	case len(strategy.Entry) > backtestMaxConditions || len(strategy.Exit) > backtestMaxConditions:
+		return &ErrResp{Code: 400, Message: fmt.Sprintf("at most %d entry and %d exit conditions are allowed", backtestMaxConditions, backtestMaxConditions)}
+	case strategy.StopLossPercent < 0 || strategy.StopLossPercent >= 100 || strategy.TakeProfitPercent < 0:
+		return &ErrResp{Code: 400, Message: "stopLossPercent must be between 0 and 100 and takeProfitPercent cannot be negative"}
+	}
+
+	if _, err := s.industryService.Expand(ctx, strategy.Universe.Industries); err != nil {
+		return err
+	}
+
+	switch strategy.Sizing.Type {
+	case BacktestSizingEqualWeight:
+	case BacktestSizingFixedAmount, BacktestSizingPercentEquity:
+		if strategy.Sizing.Value <= 0 {
+			return &ErrResp{Code: 400, Message: "sizing value must be positive"}
+		}
+	default:
+		return &ErrResp{Code: 400, Message: "invalid sizing type - " + strategy.Sizing.Type}
+	}
+
+	if strategy.Sizing.MaxPositions < 1 || strategy.Sizing.MaxPositions > backtestMaxPositions {
+		return &ErrResp{Code: 400, Message: fmt.Sprintf("sizing maxPositions must be between 1 and %d", backtestMaxPositions)}
+	}
+
+	for _, condition := range append(slices.Clone(strategy.Entry), strategy.Exit...) {
+		if condition == nil || !slices.Contains(alertOperators, condition.Operator) {
+			return &ErrResp{Code: 400, Message: "conditions need an operator, one of above, below, crosses_above or crosses_below"}
+		}
+
+		for _, operand := range []BacktestOperand{condition.Left, condition.Right} {
+			if err := s.validateOperand(ctx, entitlement, operand); err != nil {
+				return err
+			}
+		}
+
+		if condition.Left.Value != nil && condition.Right.Value != nil {
+			return &ErrResp{Code: 400, Message: "a condition cannot compare two constant values"}
+		}
+	}
+
+	return nil
+}
+
+func (s *backtestService) validateOperand(ctx *gofr.Context, entitlement *Entitlement, operand BacktestOperand) error {
+	var set int
+
+	if operand.Field != "" {
+		set++
+
+		if !slices.Contains(statFields, operand.Field) {
+			return &ErrResp{Code: 400, Message: "invalid field - " + operand.Field}
+		}
+	}
+
+	if operand.Value != nil {
+		set++
+	}
+
+	if operand.MetricID != 0 {
+		set++
+
+		metric, err := s.metricStore.Retrieve(ctx, operand.MetricID)
+		if err != nil {
+			return err
+		}
+
+		if entitlement.Tier != nil && metric.Tier > *entitlement.Tier {
+			return &ErrResp{Code: 403, Message: "metric is not available on your plan"}
+		}
+	}
+
+	if set != 1 {
+		return &ErrResp{Code: 400, Message: "an operand needs exactly one of field, metricId or value"}
+	}
+
+	return nil
+}
+
+func (s *backtestService) retrieveOwned(ctx *gofr.Context, id int) (*stores.Backtest, error) {
+	if err := authorize(ctx, auth.RunBacktests); err != nil {
+		return nil, err
+	}
+
+	backtest, err := s.store.Retrieve(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if backtest.UserID != auth.PrincipalFromContext(ctx).UserID {
+		return nil, http.ErrorEntityNotFound{Name: "backtests", Value: strconv.Itoa(id)}
+	}
+
+	return backtest, nil
+}
+
+func (s *backtestService) buildResp(model *stores.Backtest) *Backtest {
+	resp := &Backtest{
+		ID:          model.ID,
+		UserID:      model.UserID,
+		Name:        model.Name,
+		Status:      model.Status,
+		Error:       model.Error,
+		StartedAt:   model.StartedAt,
+		CompletedAt: model.CompletedAt,
+		CreatedAt:   model.CreatedAt,
+	}
+
+	var strategy BacktestStrategy
+
+	if json.Unmarshal([]byte(model.Strategy), &strategy) == nil {
+		resp.Strategy = &strategy
+	}
+
+	if model.Result != "" {
+		var result BacktestResult
+
+		if json.Unmarshal([]byte(model.Result), &result) == nil {
+			resp.Result = &result
+		}
+	}
+
+	return resp
+}
+
+func (st *BacktestStrategy) metricIDs() []int {
+	var metricIDs []int
+
+	for _, condition := range append(slices.Clone(st.Entry), st.Exit...) {
+		for _, operand := range []BacktestOperand{condition.Left, condition.Right} {
+			if operand.MetricID != 0 && !slices.Contains(metricIDs, operand.MetricID) {
+				metricIDs = append(metricIDs, operand.MetricID)
+			}
+		}
+	}
+
+	return metricIDs
+}
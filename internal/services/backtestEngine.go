@@ -0,0 +1,420 @@
+package services
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"github.com/stratifyr/security-service/internal/stores"
+)
+
+const (
+	BacktestExitSignal     = "exit_signal"
+	BacktestExitStopLoss   = "stop_loss"
+	BacktestExitTakeProfit = "take_profit"
+	BacktestExitOpen       = "open"
+)
+
+const backtestTradingDaysPerYear = 252
+
+type BacktestResult struct {
+	FinalEquity float64                `json:"finalEquity"`
+	TotalReturn float64                `json:"totalReturn"`
+	CAGR        float64                `json:"cagr"`
+	MaxDrawdown float64                `json:"maxDrawdown"`
+	Sharpe      float64                `json:"sharpe"`
+	WinRate     float64                `json:"winRate"`
+	TradeCount  int                    `json:"tradeCount"`
+	EquityCurve []*BacktestEquityPoint `json:"equityCurve"`
+	Trades      []*BacktestTrade       `json:"trades"`
+}
+
+type BacktestEquityPoint struct {
+	Date      time.Time `json:"date"`
+	Equity    float64   `json:"equity"`
+	Cash      float64   `json:"cash"`
+	Positions int       `json:"positions"`
+}
+
+type BacktestTrade struct {
+	SecurityID    int        `json:"securityId"`
+	Symbol        string     `json:"symbol"`
+	Quantity      float64    `json:"quantity"`
+	EntryDate     time.Time  `json:"entryDate"`
+	EntryPrice    float64    `json:"entryPrice"`
+	ExitDate      *time.Time `json:"exitDate"`
+	ExitPrice     float64    `json:"exitPrice"`
+	ExitReason    string     `json:"exitReason"`
+	PnL           float64    `json:"pnl"`
+	ReturnPercent float64    `json:"returnPercent"`
+}
+
+type backtestData struct {
+	stats   map[string]map[int]*stores.SecurityStat
+	metrics map[string]map[int]map[int]float64
+}
+
+func newBacktestData(securityStats []*stores.SecurityStat, securityMetrics []*stores.SecurityMetric) *backtestData {
+	data := &backtestData{
+		stats:   make(map[string]map[int]*stores.SecurityStat),
+		metrics: make(map[string]map[int]map[int]float64),
+	}
+
+	for _, ss := range securityStats {
+		date := ss.Date.Format(time.DateOnly)
+
+		if data.stats[date] == nil {
+			data.stats[date] = make(map[int]*stores.SecurityStat)
+		}
+
+		data.stats[date][ss.SecurityID] = ss
+	}
+
+	for _, sm := range securityMetrics {
+		date := sm.Date.Format(time.DateOnly)
+
+		if data.metrics[date] == nil {
+			data.metrics[date] = make(map[int]map[int]float64)
+		}
+
+		if data.metrics[date][sm.SecurityID] == nil {
+			data.metrics[date][sm.SecurityID] = make(map[int]float64)
+		}
+
+		data.metrics[date][sm.SecurityID][sm.MetricID] = sm.Value
+	}
+
+	return data
+}
+
+// simulateBacktest replays the strategy one market day at a time. Conditions, stop losses and take profits are evaluated on
+// each day's close and the orders they raise fill at the next market day's open, so a signal never trades on the price that
+// produced it. Exits fill before entries so freed up cash can be reused the same morning, orders for a security without a stat
+// that day wait for its next one, and orders raised on the last day are dropped.
+func simulateBacktest(strategy *BacktestStrategy, marketDays []time.Time, securities []*stores.Security, data *backtestData) *BacktestResult {
+	securities = slices.Clone(securities)
+
+	slices.SortFunc(securities, func(a, b *stores.Security) int {
+		if a.Symbol < b.Symbol {
+			return -1
+		}
+
+		if a.Symbol > b.Symbol {
+			return 1
+		}
+
+		return 0
+	})
+
+	var (
+		resp           = &BacktestResult{EquityCurve: []*BacktestEquityPoint{}, Trades: []*BacktestTrade{}}
+		cash           = strategy.InitialCapital
+		positions      = make(map[int]*BacktestTrade)
+		lastClose      = make(map[int]float64)
+		pendingExits   = make(map[int]string)
+		pendingEntries = make(map[int]bool)
+	)
+
+	for i, day := range marketDays {
+		date := day.Format(time.DateOnly)
+
+		var previous string
+		if i > 0 {
+			previous = marketDays[i-1].Format(time.DateOnly)
+		}
+
+		for _, security := range securities {
+			reason, ok := pendingExits[security.ID]
+			if !ok || data.stats[date][security.ID] == nil {
+				continue
+			}
+
+			price := backtestFillPrice(data.stats[date][security.ID])
+			trade := positions[security.ID]
+
+			cash += trade.Quantity * price
+
+			closeBacktestTrade(trade, day, price, reason)
+			delete(positions, security.ID)
+			delete(pendingExits, security.ID)
+		}
+
+		equity := cash
+
+		for securityID, trade := range positions {
+			price := lastClose[securityID]
+
+			if ss := data.stats[date][securityID]; ss != nil {
+				price = backtestFillPrice(ss)
+			}
+
+			equity += trade.Quantity * price
+		}
+
+		for _, security := range securities {
+			if !pendingEntries[security.ID] || data.stats[date][security.ID] == nil {
+				continue
+			}
+
+			delete(pendingEntries, security.ID)
+
+			if len(positions) >= strategy.Sizing.MaxPositions {
+				continue
+			}
+
+			price := backtestFillPrice(data.stats[date][security.ID])
+			if price <= 0 {
+				continue
+			}
+
+			amount := equity / float64(strategy.Sizing.MaxPositions)
+
+			switch strategy.Sizing.Type {
+			case BacktestSizingFixedAmount:
+				amount = strategy.Sizing.Value
+			case BacktestSizingPercentEquity:
+				amount = equity * strategy.Sizing.Value / 100
+			}
+
+			quantity := math.Floor(math.Min(amount, cash) / price)
+			if quantity < 1 {
+				continue
+			}
+
+			cash -= quantity * price
+
+			trade := &BacktestTrade{
+				SecurityID: security.ID,
+				Symbol:     security.Symbol,
+				Quantity:   quantity,
+				EntryDate:  day,
+				EntryPrice: price,
+			}
+
+			positions[security.ID] = trade
+			resp.Trades = append(resp.Trades, trade)
+		}
+
+		for securityID, ss := range data.stats[date] {
+			lastClose[securityID] = ss.Close
+		}
+
+		for _, security := range securities {
+			trade, ok := positions[security.ID]
+			if !ok || data.stats[date][security.ID] == nil {
+				continue
+			}
+
+			if _, ok = pendingExits[security.ID]; ok {
+				continue
+			}
+
+			price := data.stats[date][security.ID].Close
+
+			switch {
+			case strategy.StopLossPercent > 0 && price <= trade.EntryPrice*(1-strategy.StopLossPercent/100):
+				pendingExits[security.ID] = BacktestExitStopLoss
+			case strategy.TakeProfitPercent > 0 && price >= trade.EntryPrice*(1+strategy.TakeProfitPercent/100):
+				pendingExits[security.ID] = BacktestExitTakeProfit
+			case slices.ContainsFunc(strategy.Exit, func(c *BacktestCondition) bool { return data.evaluate(c, security.ID, date, previous) }):
+				pendingExits[security.ID] = BacktestExitSignal
+			}
+		}
+
+		for _, security := range securities {
+			if _, ok := positions[security.ID]; ok || data.stats[date][security.ID] == nil {
+				continue
+			}
+
+			if slices.ContainsFunc(strategy.Entry, func(c *BacktestCondition) bool { return !data.evaluate(c, security.ID, date, previous) }) {
+				delete(pendingEntries, security.ID)
+				continue
+			}
+
+			pendingEntries[security.ID] = true
+		}
+
+		point := &BacktestEquityPoint{Date: day, Equity: cash, Cash: cash, Positions: len(positions)}
+
+		for securityID, trade := range positions {
+			point.Equity += trade.Quantity * lastClose[securityID]
+		}
+
+		resp.EquityCurve = append(resp.EquityCurve, point)
+	}
+
+	for securityID, trade := range positions {
+		trade.ExitReason = BacktestExitOpen
+		trade.ExitPrice = lastClose[securityID]
+		trade.PnL = trade.Quantity * (trade.ExitPrice - trade.EntryPrice)
+		trade.ReturnPercent = (trade.ExitPrice/trade.EntryPrice - 1) * 100
+	}
+
+	resp.summarise(strategy.InitialCapital)
+
+	return resp
+}
+
+// backtestFillPrice is the open an order fills at, falling back to the close for stats recorded without one.
+func backtestFillPrice(ss *stores.SecurityStat) float64 {
+	if ss.Open > 0 {
+		return ss.Open
+	}
+
+	return ss.Close
+}
+
+func closeBacktestTrade(trade *BacktestTrade, day time.Time, price float64, reason string) {
+	trade.ExitDate = &day
+	trade.ExitPrice = price
+	trade.ExitReason = reason
+	trade.PnL = trade.Quantity * (price - trade.EntryPrice)
+	trade.ReturnPercent = (price/trade.EntryPrice - 1) * 100
+}
+
+func (r *BacktestResult) summarise(initialCapital float64) {
+	r.TradeCount = len(r.Trades)
+	r.FinalEquity = initialCapital
+
+	if len(r.EquityCurve) == 0 {
+		return
+	}
+
+	first, last := r.EquityCurve[0], r.EquityCurve[len(r.EquityCurve)-1]
+
+	r.FinalEquity = last.Equity
+	r.TotalReturn = r.FinalEquity/initialCapital - 1
+
+	if years := last.Date.Sub(first.Date).Hours() / 24 / 365.25; years > 0 && r.FinalEquity > 0 {
+		r.CAGR = math.Pow(r.FinalEquity/initialCapital, 1/years) - 1
+	}
+
+	peak := initialCapital
+
+	var returns []float64
+
+	previous := initialCapital
+
+	for _, point := range r.EquityCurve {
+		peak = math.Max(peak, point.Equity)
+
+		if peak > 0 {
+			r.MaxDrawdown = math.Max(r.MaxDrawdown, (peak-point.Equity)/peak)
+		}
+
+		if previous > 0 {
+			returns = append(returns, point.Equity/previous-1)
+		}
+
+		previous = point.Equity
+	}
+
+	if len(returns) > 1 {
+		var mean, variance float64
+
+		for _, ret := range returns {
+			mean += ret
+		}
+
+		mean /= float64(len(returns))
+
+		for _, ret := range returns {
+			variance += (ret - mean) * (ret - mean)
+		}
+
+		if std := math.Sqrt(variance / float64(len(returns)-1)); std > 0 {
+			r.Sharpe = mean / std * math.Sqrt(backtestTradingDaysPerYear)
+		}
+	}
+
+	var closed, wins int
+
+	for _, trade := range r.Trades {
+		if trade.ExitDate == nil {
+			continue
+		}
+
+		closed++
+
+		if trade.PnL > 0 {
+			wins++
+		}
+	}
+
+	if closed > 0 {
+		r.WinRate = float64(wins) / float64(closed)
+	}
+}
+
+func (d *backtestData) evaluate(condition *BacktestCondition, securityID int, date, previous string) bool {
+	left, ok := d.value(condition.Left, securityID, date)
+	if !ok {
+		return false
+	}
+
+	right, ok := d.value(condition.Right, securityID, date)
+	if !ok {
+		return false
+	}
+
+	switch condition.Operator {
+	case AlertOperatorAbove:
+		return left > right
+	case AlertOperatorBelow:
+		return left < right
+	}
+
+	if previous == "" {
+		return false
+	}
+
+	previousLeft, ok := d.value(condition.Left, securityID, previous)
+	if !ok {
+		return false
+	}
+
+	previousRight, ok := d.value(condition.Right, securityID, previous)
+	if !ok {
+		return false
+	}
+
+	switch condition.Operator {
+	case AlertOperatorCrossesAbove:
+		return previousLeft <= previousRight && left > right
+	case AlertOperatorCrossesBelow:
+		return previousLeft >= previousRight && left < right
+	default:
+		return false
+	}
+}
+
+func (d *backtestData) value(operand BacktestOperand, securityID int, date string) (float64, bool) {
+	switch {
+	case operand.Value != nil:
+		return *operand.Value, true
+	case operand.MetricID != 0:
+		value, ok := d.metrics[date][securityID][operand.MetricID]
+
+		return value, ok
+	}
+
+	ss := d.stats[date][securityID]
+	if ss == nil {
+		return 0, false
+	}
+
+	switch operand.Field {
+	case AlertFieldOpen:
+		return ss.Open, true
+	case AlertFieldClose:
+		return ss.Close, true
+	case AlertFieldHigh:
+		return ss.High, true
+	case AlertFieldLow:
+		return ss.Low, true
+	case AlertFieldVolume:
+		return float64(ss.Volume), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stratifyr/security-service/internal/stores"
+)
+
+func TestBacktestResultSummarise(t *testing.T) {
+	at := func(value string) time.Time {
+		d, _ := time.Parse(time.DateTime, value)
+		return d
+	}
+
+	exitDate := at("2021-01-01 00:00:00")
+
+	tests := []struct {
+		name            string
+		curve           []*BacktestEquityPoint
+		trades          []*BacktestTrade
+		wantFinalEquity float64
+		wantTotalReturn float64
+		wantCAGR        float64
+		wantMaxDrawdown float64
+		wantSharpe      float64
+		wantWinRate     float64
+	}{
+		{
+			name:            "no market days",
+			wantFinalEquity: 100,
+		},
+		{
+			// 100 -> 121 over exactly two 365.25 day years is a 10% CAGR, the 110 -> 99 dip is a 10% drawdown and the daily
+			// returns 0, 0.1, -0.1 and 0.2222 have a mean of 0.05556 and a sample deviation of 0.13789, annualised over 252 days
+			name: "growth with a drawdown",
+			curve: []*BacktestEquityPoint{
+				{Date: at("2020-01-01 00:00:00"), Equity: 100},
+				{Date: at("2020-06-01 00:00:00"), Equity: 110},
+				{Date: at("2021-01-01 00:00:00"), Equity: 99},
+				{Date: at("2021-12-31 12:00:00"), Equity: 121},
+			},
+			trades: []*BacktestTrade{
+				{PnL: 10, ExitDate: &exitDate},
+				{PnL: -11, ExitDate: &exitDate},
+				{PnL: 22, ExitDate: &exitDate},
+				{PnL: -5},
+			},
+			wantFinalEquity: 121,
+			wantTotalReturn: 0.21,
+			wantCAGR:        0.1,
+			wantMaxDrawdown: 0.1,
+			wantSharpe:      0.05555555555555558 / math.Sqrt(0.019012345679012357) * math.Sqrt(252),
+			wantWinRate:     2.0 / 3,
+		},
+		{
+			// identical daily returns have no deviation, so there is no sharpe to report
+			name: "steady growth",
+			curve: []*BacktestEquityPoint{
+				{Date: at("2021-01-01 00:00:00"), Equity: 110},
+				{Date: at("2022-01-01 06:00:00"), Equity: 121},
+			},
+			wantFinalEquity: 121,
+			wantTotalReturn: 0.21,
+			wantCAGR:        0.21,
+		},
+		{
+			// the drawdown runs from the initial capital to 75, the returns -0.1, -0.1667 and 0.0667 have a mean of -0.0667
+			// and a sample deviation of 0.1202
+			name: "losses below the initial capital",
+			curve: []*BacktestEquityPoint{
+				{Date: at("2021-01-01 00:00:00"), Equity: 90},
+				{Date: at("2021-01-02 00:00:00"), Equity: 75},
+				{Date: at("2021-01-03 00:00:00"), Equity: 80},
+			},
+			wantFinalEquity: 80,
+			wantTotalReturn: -0.2,
+			wantCAGR:        math.Pow(0.8, 365.25/2) - 1,
+			wantMaxDrawdown: 0.25,
+			wantSharpe:      -0.06666666666666665 / math.Sqrt(0.01444444444444444) * math.Sqrt(252),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := &BacktestResult{EquityCurve: tc.curve, Trades: tc.trades}
+			result.summarise(100)
+
+			for _, check := range []struct {
+				name      string
+				got, want float64
+			}{
+				{"FinalEquity", result.FinalEquity, tc.wantFinalEquity},
+				{"TotalReturn", result.TotalReturn, tc.wantTotalReturn},
+				{"CAGR", result.CAGR, tc.wantCAGR},
+				{"MaxDrawdown", result.MaxDrawdown, tc.wantMaxDrawdown},
+				{"Sharpe", result.Sharpe, tc.wantSharpe},
+				{"WinRate", result.WinRate, tc.wantWinRate},
+			} {
+				if math.Abs(check.got-check.want) > 1e-9 {
+					t.Errorf("%s = %v, want %v", check.name, check.got, check.want)
+				}
+			}
+
+			if result.TradeCount != len(tc.trades) {
+				t.Errorf("TradeCount = %d, want %d", result.TradeCount, len(tc.trades))
+			}
+		})
+	}
+}
+
+func TestSimulateBacktestFillsNextDay(t *testing.T) {
+	date := func(value string) time.Time {
+		d, _ := time.Parse(time.DateOnly, value)
+		return d
+	}
+
+	threshold := 100.0
+
+	strategy := &BacktestStrategy{
+		InitialCapital: 1000,
+		Entry:          []*BacktestCondition{{Left: BacktestOperand{Field: AlertFieldClose}, Operator: AlertOperatorAbove, Right: BacktestOperand{Value: &threshold}}},
+		Exit:           []*BacktestCondition{{Left: BacktestOperand{Field: AlertFieldClose}, Operator: AlertOperatorBelow, Right: BacktestOperand{Value: &threshold}}},
+		Sizing:         BacktestSizing{Type: BacktestSizingEqualWeight, MaxPositions: 1},
+	}
+
+	marketDays := []time.Time{date("2024-01-01"), date("2024-01-02"), date("2024-01-03"), date("2024-01-04"), date("2024-01-05"), date("2024-01-08")}
+
+	// the entry signal fires on the 2nd close and the exit signal on the 4th close, the 5th has no stat so the exit waits for
+	// the 8th and the entry signal on the 8th close is dropped as there is no next day to fill it
+	securityStats := []*stores.SecurityStat{
+		{SecurityID: 1, Date: date("2024-01-01"), Open: 94, Close: 95},
+		{SecurityID: 1, Date: date("2024-01-02"), Open: 96, Close: 105},
+		{SecurityID: 1, Date: date("2024-01-03"), Open: 106, Close: 110},
+		{SecurityID: 1, Date: date("2024-01-04"), Open: 108, Close: 90},
+		{SecurityID: 1, Date: date("2024-01-08"), Open: 92, Close: 101},
+	}
+
+	result := simulateBacktest(strategy, marketDays, []*stores.Security{{ID: 1, Symbol: "ABC"}}, newBacktestData(securityStats, nil))
+
+	if len(result.Trades) != 1 {
+		t.Fatalf("got %d trades, want 1", len(result.Trades))
+	}
+
+	trade := result.Trades[0]
+
+	// 1000 buys 9 shares at the 106 open of the 3rd, leaving 46 in cash
+	if !trade.EntryDate.Equal(date("2024-01-03")) || trade.EntryPrice != 106 || trade.Quantity != 9 {
+		t.Errorf("entry = %d on %s at %v, want 9 on 2024-01-03 at 106", int(trade.Quantity), trade.EntryDate.Format(time.DateOnly), trade.EntryPrice)
+	}
+
+	if trade.ExitDate == nil || !trade.ExitDate.Equal(date("2024-01-08")) || trade.ExitPrice != 92 || trade.ExitReason != BacktestExitSignal {
+		t.Fatalf("exit = %+v, want an exit signal filled on 2024-01-08 at 92", trade)
+	}
+
+	wantEquity := []float64{1000, 1000, 46 + 9*110, 46 + 9*90, 46 + 9*90, 46 + 9*92}
+
+	for i, point := range result.EquityCurve {
+		if point.Equity != wantEquity[i] {
+			t.Errorf("equity on %s = %v, want %v", point.Date.Format(time.DateOnly), point.Equity, wantEquity[i])
+		}
+	}
+
+	if result.FinalEquity != 874 || result.TradeCount != 1 || result.WinRate != 0 {
+		t.Errorf("final equity %v with %d trades at a %v win rate, want 874 with 1 at 0", result.FinalEquity, result.TradeCount, result.WinRate)
+	}
+}
@@ -0,0 +1,179 @@
+package services
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/stratifyr/security-service/internal/stores"
+)
+
+const (
+	TopicSecurityStatUpserted   = "security.stat.upserted"
+	TopicSecurityMetricUpserted = "security.metric.upserted"
+	TopicSecurityLTPUpdated     = "security.ltp.updated"
+	TopicMarketHolidayChanged   = "market-holiday.changed"
+)
+
+const (
+	outboxRelayBatchSize = 100
+	outboxRetention      = 7 * 24 * time.Hour
+)
+
+type DomainEventService interface {
+	Emit(ctx *gofr.Context, topic string, data any) error
+	EmitBatch(ctx *gofr.Context, topic string, data []any) error
+	Relay(ctx *gofr.Context)
+}
+
+type DomainEvent struct {
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurredAt"`
+	Data       any       `json:"data"`
+}
+
+type SecurityStatEvent struct {
+	ID         int     `json:"id"`
+	SecurityID int     `json:"securityId"`
+	Date       string  `json:"date"`
+	Open       float64 `json:"open"`
+	Close      float64 `json:"close"`
+	High       float64 `json:"high"`
+	Low        float64 `json:"low"`
+	Volume     int     `json:"volume"`
+}
+
+type SecurityMetricEvent struct {
+	ID         int     `json:"id"`
+	SecurityID int     `json:"securityId"`
+	MetricID   int     `json:"metricId"`
+	Date       string  `json:"date"`
+	Value      float64 `json:"value"`
+}
+
+type SecurityLTPEvent struct {
+	SecurityID  int     `json:"securityId"`
+	Symbol      string  `json:"symbol"`
+	LTP         float64 `json:"ltp"`
+	PreviousLTP float64 `json:"previousLtp"`
+}
+
+type MarketHolidayEvent struct {
+	ID          int    `json:"id"`
+	Date        string `json:"date"`
+	Description string `json:"description"`
+	Action      string `json:"action"`
+}
+
+type domainEventService struct {
+	store stores.OutboxEventStore
+}
+
+func NewDomainEventService(store stores.OutboxEventStore) *domainEventService {
+	return &domainEventService{store: store}
+}
+
+// Emit stores the event in the outbox, Relay publishes it later so a broker outage does not drop events. Callers emit inside the
+// stores.InTx of the write the event describes, so the event is stored if and only if the write commits.
+func (s *domainEventService) Emit(ctx *gofr.Context, topic string, data any) error {
+	model, err := newOutboxEvent(topic, data)
+	if err != nil {
+		return err
+	}
+
+	return s.store.Create(ctx, model)
+}
+
+// EmitBatch stores one event per element of data like Emit, with a single outbox write for all of them.
+func (s *domainEventService) EmitBatch(ctx *gofr.Context, topic string, data []any) error {
+	models := make([]*stores.OutboxEvent, len(data))
+
+	for i := range data {
+		model, err := newOutboxEvent(topic, data[i])
+		if err != nil {
+			return err
+		}
+
+		models[i] = model
+	}
+
+	return s.store.CreateBatch(ctx, models)
+}
+
+// Relay publishes pending outbox events in order, stopping at the first failure so it is retried on the next run.
+// Delivery is at least once, consumers should de-duplicate on the event id.
+func (s *domainEventService) Relay(ctx *gofr.Context) {
+	publisher := ctx.GetPublisher()
+	if publisher == nil {
+		return
+	}
+
+	outboxEvents, err := s.store.IndexPending(ctx, outboxRelayBatchSize)
+	if err != nil {
+		ctx.Logger.Errorf("failed to read pending outbox events, err: %v", err)
+
+		return
+	}
+
+	for _, outboxEvent := range outboxEvents {
+		if err = publisher.Publish(ctx, outboxEvent.Topic, []byte(outboxEvent.Payload)); err != nil {
+			ctx.Logger.Warnf("failed to publish outbox event %s, attempt: %d, err: %v", outboxEvent.EventID, outboxEvent.Attempts+1, err)
+
+			if err = s.store.MarkFailed(ctx, outboxEvent.ID, err.Error()); err != nil {
+				ctx.Logger.Errorf("failed to mark outbox event %s as failed, err: %v", outboxEvent.EventID, err)
+			}
+
+			return
+		}
+
+		if err = s.store.MarkPublished(ctx, outboxEvent.ID, time.Now().UTC()); err != nil {
+			ctx.Logger.Errorf("failed to mark outbox event %s as published, err: %v", outboxEvent.EventID, err)
+
+			return
+		}
+	}
+
+	if err = s.store.DeletePublishedBefore(ctx, time.Now().UTC().Add(-outboxRetention)); err != nil {
+		ctx.Logger.Warnf("failed to prune published outbox events, err: %v", err)
+	}
+}
+
+func newOutboxEvent(topic string, data any) (*stores.OutboxEvent, error) {
+	eventID, err := generateEventID()
+	if err != nil {
+		return nil, err
+	}
+
+	event := &DomainEvent{
+		ID:         eventID,
+		Type:       topic,
+		OccurredAt: time.Now().UTC(),
+		Data:       data,
+	}
+
+	serialized, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stores.OutboxEvent{
+		EventID:   eventID,
+		Topic:     topic,
+		Payload:   string(serialized),
+		CreatedAt: event.OccurredAt,
+	}, nil
+}
+
+func generateEventID() (string, error) {
+	id := make([]byte, 16)
+
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(id), nil
+}
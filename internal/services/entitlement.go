@@ -0,0 +1,161 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/stratifyr/security-service/internal/auth"
+)
+
+const (
+	FeatureScreener Feature = "screener"
+	FeatureHistory  Feature = "history"
+	FeatureExport   Feature = "export"
+)
+
+type Feature string
+
+type EntitlementService interface {
+	Resolve(ctx *gofr.Context) (*Entitlement, error)
+	Require(ctx *gofr.Context, feature Feature) (*Entitlement, error)
+}
+
+type Entitlement struct {
+	Tier          *int
+	HistoryDays   int
+	LTPDelay      time.Duration
+	MaxAlertRules int
+	MaxExportRows int
+	Features      []Feature
+}
+
+type entitlementKey struct{}
+
+var errOutsideHistory = &ErrResp{Code: 403, Message: "requested date is beyond the history allowed by your plan"}
+
+var unrestrictedEntitlement = &Entitlement{
+	Features: []Feature{FeatureScreener, FeatureHistory, FeatureExport},
+}
+
+var DefaultTierEntitlements = map[int]*Entitlement{
+	0: {HistoryDays: 30, LTPDelay: 15 * time.Minute, MaxAlertRules: 3, Features: []Feature{FeatureScreener, FeatureHistory}},
+	1: {HistoryDays: 365, MaxAlertRules: 25, Features: []Feature{FeatureScreener, FeatureHistory}},
+	2: {MaxAlertRules: 100, MaxExportRows: 2000000, Features: []Feature{FeatureScreener, FeatureHistory, FeatureExport}},
+}
+
+type entitlementService struct {
+	userTierService  UserTierService
+	tierEntitlements map[int]*Entitlement
+}
+
+func NewEntitlementService(userTierService UserTierService, tierEntitlements map[int]*Entitlement) *entitlementService {
+	return &entitlementService{
+		userTierService:  userTierService,
+		tierEntitlements: tierEntitlements,
+	}
+}
+
+func (s *entitlementService) Resolve(ctx *gofr.Context) (*Entitlement, error) {
+	if entitlement, ok := ctx.Value(entitlementKey{}).(*Entitlement); ok {
+		return entitlement, nil
+	}
+
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, err
+	}
+
+	maxTier, err := s.maxTier(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entitlement := unrestrictedEntitlement
+
+	if maxTier != nil {
+		entitlement = s.forTier(*maxTier)
+	}
+
+	ctx.Context = context.WithValue(ctx.Context, entitlementKey{}, entitlement)
+
+	return entitlement, nil
+}
+
+func (s *entitlementService) Require(ctx *gofr.Context, feature Feature) (*Entitlement, error) {
+	entitlement, err := s.Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(entitlement.Features, feature) {
+		return nil, &ErrResp{Code: 403, Message: "your plan does not include " + string(feature)}
+	}
+
+	return entitlement, nil
+}
+
+func (s *entitlementService) maxTier(ctx *gofr.Context) (*int, error) {
+	principal := auth.PrincipalFromContext(ctx)
+	if principal.Can(auth.ReadAllTiers) {
+		return nil, nil
+	}
+
+	var userTier int
+
+	if principal != nil && principal.UserID != 0 {
+		var err error
+
+		userTier, err = s.userTierService.Read(ctx, principal.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &userTier, nil
+}
+
+func (s *entitlementService) forTier(tier int) *Entitlement {
+	var (
+		entitlement *Entitlement
+		matchedTier = -1
+	)
+
+	for t, e := range s.tierEntitlements {
+		if t <= tier && t > matchedTier {
+			entitlement, matchedTier = e, t
+		}
+	}
+
+	if entitlement == nil {
+		entitlement = &Entitlement{}
+	}
+
+	return &Entitlement{
+		Tier:          &tier,
+		HistoryDays:   entitlement.HistoryDays,
+		LTPDelay:      entitlement.LTPDelay,
+		MaxAlertRules: entitlement.MaxAlertRules,
+		MaxExportRows: entitlement.MaxExportRows,
+		Features:      entitlement.Features,
+	}
+}
+
+func (e *Entitlement) HistoryStart() time.Time {
+	if e.HistoryDays == 0 {
+		return time.Time{}
+	}
+
+	return time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -e.HistoryDays)
+}
+
+// CheckDate is the single place a requested date is held against the plan's history window, every read of dated data goes
+// through it.
+func (e *Entitlement) CheckDate(date time.Time) error {
+	if e.HistoryDays != 0 && date.Before(e.HistoryStart()) {
+		return errOutsideHistory
+	}
+
+	return nil
+}
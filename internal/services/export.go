@@ -0,0 +1,263 @@
+package services
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/stratifyr/security-service/internal/auth"
+	"github.com/stratifyr/security-service/internal/stores"
+)
+
+const (
+	ExportFormatCSV     = "csv"
+	ExportFormatParquet = "parquet"
+)
+
+const (
+	ExportString ExportColumnType = iota
+	ExportInt
+	ExportFloat
+	ExportDate
+)
+
+const exportMaxSecurities = 500
+
+type ExportService interface {
+	SecurityStats(ctx *gofr.Context, f *ExportFilter) (*Export, error)
+	SecurityMetrics(ctx *gofr.Context, f *ExportFilter) (*Export, error)
+}
+
+type ExportFilter struct {
+	Symbols   []string
+	MetricIDs []int
+	From      time.Time
+	To        time.Time
+}
+
+type ExportColumnType int
+
+type ExportColumn struct {
+	Name string
+	Type ExportColumnType
+}
+
+// Export is a validated export, nothing is read until Rows is called so that callers can commit to a response first.
+type Export struct {
+	Name    string
+	Columns []*ExportColumn
+	Count   int
+	rows    func(fn func(values []any) error) error
+}
+
+// Rows calls fn with the values of every row in Columns order, it stops at the first error fn returns.
+func (e *Export) Rows(fn func(values []any) error) error {
+	return e.rows(fn)
+}
+
+var securityStatExportColumns = []*ExportColumn{
+	{Name: "security_id", Type: ExportInt},
+	{Name: "symbol", Type: ExportString},
+	{Name: "date", Type: ExportDate},
+	{Name: "open", Type: ExportFloat},
+	{Name: "high", Type: ExportFloat},
+	{Name: "low", Type: ExportFloat},
+	{Name: "close", Type: ExportFloat},
+	{Name: "volume", Type: ExportInt},
+}
+
+var securityMetricExportColumns = []*ExportColumn{
+	{Name: "security_id", Type: ExportInt},
+	{Name: "symbol", Type: ExportString},
+	{Name: "date", Type: ExportDate},
+	{Name: "metric_id", Type: ExportInt},
+	{Name: "metric", Type: ExportString},
+	{Name: "value", Type: ExportFloat},
+}
+
+type exportService struct {
+	entitlementService  EntitlementService
+	metricStore         stores.MetricStore
+	securityStore       stores.SecurityStore
+	securityStatStore   stores.SecurityStatStore
+	securityMetricStore stores.SecurityMetricStore
+}
+
+func NewExportService(entitlementService EntitlementService, metricStore stores.MetricStore, securityStore stores.SecurityStore,
+	securityStatStore stores.SecurityStatStore, securityMetricStore stores.SecurityMetricStore) *exportService {
+	return &exportService{
+		entitlementService:  entitlementService,
+		metricStore:         metricStore,
+		securityStore:       securityStore,
+		securityStatStore:   securityStatStore,
+		securityMetricStore: securityMetricStore,
+	}
+}
+
+func (s *exportService) SecurityStats(ctx *gofr.Context, f *ExportFilter) (*Export, error) {
+	entitlement, symbols, err := s.prepare(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &stores.SecurityStatFilter{SecurityIDs: slices.Sorted(maps.Keys(symbols)), MinDate: f.From, MaxDate: f.To}
+
+	count, err := s.securityStatStore.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkExportRows(entitlement, count); err != nil {
+		return nil, err
+	}
+
+	return &Export{
+		Name:    "security-stats",
+		Columns: securityStatExportColumns,
+		Count:   count,
+		rows: func(fn func(values []any) error) error {
+			return s.securityStatStore.Stream(ctx, filter, func(ss *stores.SecurityStat) error {
+				return fn([]any{ss.SecurityID, symbols[ss.SecurityID], ss.Date, ss.Open, ss.High, ss.Low, ss.Close, ss.Volume})
+			})
+		},
+	}, nil
+}
+
+func (s *exportService) SecurityMetrics(ctx *gofr.Context, f *ExportFilter) (*Export, error) {
+	entitlement, symbols, err := s.prepare(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics, err := s.metricStore.Index(ctx, &stores.MetricFilter{MaxTier: entitlement.Tier}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	metricNames := make(map[int]string, len(metrics))
+
+	for i := range metrics {
+		metricNames[metrics[i].ID] = metrics[i].Name
+	}
+
+	for _, metricID := range f.MetricIDs {
+		if _, ok := metricNames[metricID]; ok {
+			continue
+		}
+
+		if _, err = s.metricStore.Retrieve(ctx, metricID); err != nil {
+			return nil, err
+		}
+
+		return nil, &ErrResp{Code: 403, Message: fmt.Sprintf("metric %d is not available on your plan", metricID)}
+	}
+
+	filter := &stores.SecurityMetricFilter{
+		SecurityIDs: slices.Sorted(maps.Keys(symbols)),
+		MetricIDs:   f.MetricIDs,
+		MinDate:     f.From,
+		MaxDate:     f.To,
+	}
+
+	if len(filter.MetricIDs) == 0 {
+		filter.MetricIDs = slices.Sorted(maps.Keys(metricNames))
+	}
+
+	if len(filter.MetricIDs) == 0 {
+		return &Export{Name: "security-metrics", Columns: securityMetricExportColumns, rows: func(func([]any) error) error { return nil }}, nil
+	}
+
+	count, err := s.securityMetricStore.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkExportRows(entitlement, count); err != nil {
+		return nil, err
+	}
+
+	return &Export{
+		Name:    "security-metrics",
+		Columns: securityMetricExportColumns,
+		Count:   count,
+		rows: func(fn func(values []any) error) error {
+			return s.securityMetricStore.Stream(ctx, filter, func(sm *stores.SecurityMetric) error {
+				return fn([]any{sm.SecurityID, symbols[sm.SecurityID], sm.Date, sm.MetricID, metricNames[sm.MetricID], sm.Value})
+			})
+		},
+	}, nil
+}
+
+// prepare checks the caller may export the requested range and securities, and resolves the securities to a map of their symbols.
+func (s *exportService) prepare(ctx *gofr.Context, f *ExportFilter) (*Entitlement, map[int]string, error) {
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, nil, err
+	}
+
+	entitlement, err := s.entitlementService.Require(ctx, FeatureExport)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(f.Symbols) == 0 || len(f.Symbols) > exportMaxSecurities {
+		return nil, nil, &ErrResp{Code: 400, Message: fmt.Sprintf("between 1 and %d symbols are required", exportMaxSecurities)}
+	}
+
+	if f.From.IsZero() {
+		return nil, nil, &ErrResp{Code: 400, Message: "from is required"}
+	}
+
+	if f.To.IsZero() {
+		f.To = time.Now().UTC().Truncate(24 * time.Hour)
+	}
+
+	if f.From.After(f.To) {
+		return nil, nil, &ErrResp{Code: 400, Message: "from cannot be after to"}
+	}
+
+	if err := entitlement.CheckDate(f.From); err != nil {
+		return nil, nil, err
+	}
+
+	securities, err := s.securityStore.Index(ctx, &stores.SecurityFilter{Symbols: f.Symbols}, 0, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	symbols := make(map[int]string, len(securities))
+
+	for i := range securities {
+		if entitlement.Tier != nil && securities[i].Tier > *entitlement.Tier {
+			return nil, nil, &ErrResp{Code: 403, Message: fmt.Sprintf("security %s is not available on your plan", securities[i].Symbol)}
+		}
+
+		symbols[securities[i].ID] = securities[i].Symbol
+	}
+
+	var missing []string
+
+	for _, symbol := range f.Symbols {
+		if !slices.ContainsFunc(securities, func(security *stores.Security) bool { return security.Symbol == symbol }) {
+			missing = append(missing, symbol)
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, nil, &ErrResp{Code: 404, Message: "securities not found: " + strings.Join(missing, ", ")}
+	}
+
+	return entitlement, symbols, nil
+}
+
+func checkExportRows(entitlement *Entitlement, count int) error {
+	if entitlement.MaxExportRows > 0 && count > entitlement.MaxExportRows {
+		return &ErrResp{Code: 403, Message: fmt.Sprintf("export of %d rows exceeds the %d allowed by your plan, narrow the symbols or dates",
+			count, entitlement.MaxExportRows)}
+	}
+
+	return nil
+}
@@ -1,23 +1,1020 @@
 package services
 
 import (
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
 	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
 
+	"github.com/stratifyr/security-service/internal/auth"
 	"github.com/stratifyr/security-service/internal/stores"
 )
 
+const (
+	IndustryLevelSector      = "sector"
+	IndustryLevelIndustry    = "industry"
+	IndustryLevelSubIndustry = "sub_industry"
+)
+
+const (
+	IndustryWeightingMarketCap   = "market_cap"
+	IndustryWeightingTradedValue = "traded_value"
+)
+
+const (
+	industryNameMaxLength    = 100
+	industrySummaryRSIPeriod = 14
+	industrySummarySMAPeriod = 200
+	industrySummaryLookback  = 30 * 24 * time.Hour
+
+	// industrySummaryMinMarketCapCoverage is the share of members with a return that need reported fundamentals before the
+	// weighted return is market cap weighted rather than traded value weighted.
+	industrySummaryMinMarketCapCoverage = 0.8
+)
+
+// industryLevels is ordered by depth, a parent always sits one level above its children.
+var industryLevels = []string{IndustryLevelSector, IndustryLevelIndustry, IndustryLevelSubIndustry}
+
+// industryAssignmentEpoch is the effective date of a security's first classification, so it covers the whole of its history.
+var industryAssignmentEpoch = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+
 type IndustryService interface {
-	Index(ctx *gofr.Context) []stores.Industry
+	Index(ctx *gofr.Context, f *IndustryFilter) ([]*Industry, error)
+	Read(ctx *gofr.Context, id int) (*Industry, error)
+	Create(ctx *gofr.Context, payload *IndustryCreate) (*Industry, error)
+	Patch(ctx *gofr.Context, id int, payload *IndustryUpdate) (*Industry, error)
+	Delete(ctx *gofr.Context, id int) error
+	Assignments(ctx *gofr.Context, securityID int) ([]*IndustryAssignment, error)
+	Assign(ctx *gofr.Context, securityID int, payload *IndustryAssignmentCreate) (*IndustryAssignment, error)
+	Unassign(ctx *gofr.Context, securityID, id int) error
+	Expand(ctx *gofr.Context, names []string) ([]int, error)
+	Summary(ctx *gofr.Context, name string, date time.Time) (*IndustrySummary, error)
+	Summaries(ctx *gofr.Context, name string, f *IndustrySummaryFilter) ([]*IndustrySummary, error)
+}
+
+type IndustryFilter struct {
+	Level    string
+	ParentID int
+}
+
+type Industry struct {
+	ID        int
+	Name      string
+	Level     string
+	ParentID  *int
+	Path      []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type IndustryCreate struct {
+	Name     string
+	Level    string
+	ParentID *int
+}
+
+type IndustryUpdate struct {
+	Name     string
+	ParentID *int
+}
+
+type IndustryAssignment struct {
+	ID            int
+	SecurityID    int
+	IndustryID    int
+	Industry      string
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type IndustryAssignmentCreate struct {
+	IndustryID    int
+	Industry      string
+	EffectiveFrom time.Time
+}
+
+type IndustrySummaryFilter struct {
+	From time.Time
+	To   time.Time
+}
+
+type IndustrySummary struct {
+	Industry           string
+	Date               time.Time
+	Securities         int
+	Advances           int
+	Declines           int
+	Unchanged          int
+	MedianReturn       *float64
+	WeightedReturn     *float64
+	WeightingBasis     string
+	WeightingCoverage  *float64
+	AverageRSI         *float64
+	PercentAboveSMA200 *float64
 }
 
 type industryService struct {
-	store stores.IndustryStore
+	entitlementService       EntitlementService
+	auditEventService        AuditEventService
+	marketDayService         MarketDayService
+	metricStore              stores.MetricStore
+	securityStore            stores.SecurityStore
+	securityStatStore        stores.SecurityStatStore
+	securityMetricStore      stores.SecurityMetricStore
+	securityIndustryStore    stores.SecurityIndustryStore
+	securityFundamentalStore stores.SecurityFundamentalStore
+	store                    stores.IndustryStore
+}
+
+func NewIndustryService(entitlementService EntitlementService, auditEventService AuditEventService, marketDayService MarketDayService,
+	metricStore stores.MetricStore, securityStore stores.SecurityStore, securityStatStore stores.SecurityStatStore,
+	securityMetricStore stores.SecurityMetricStore, securityIndustryStore stores.SecurityIndustryStore,
+	securityFundamentalStore stores.SecurityFundamentalStore, store stores.IndustryStore) *industryService {
+	return &industryService{
+		entitlementService:       entitlementService,
+		auditEventService:        auditEventService,
+		marketDayService:         marketDayService,
+		metricStore:              metricStore,
+		securityStore:            securityStore,
+		securityStatStore:        securityStatStore,
+		securityMetricStore:      securityMetricStore,
+		securityIndustryStore:    securityIndustryStore,
+		securityFundamentalStore: securityFundamentalStore,
+		store:                    store,
+	}
+}
+
+func (s *industryService) Index(ctx *gofr.Context, f *IndustryFilter) ([]*Industry, error) {
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, err
+	}
+
+	industriesMap, err := s.getIndustriesMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	industries, err := s.store.Index(ctx, &stores.IndustryFilter{Level: f.Level, ParentID: f.ParentID}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp = make([]*Industry, len(industries))
+
+	for i := range industries {
+		resp[i] = s.buildResp(industries[i], industriesMap)
+	}
+
+	return resp, nil
+}
+
+func (s *industryService) Read(ctx *gofr.Context, id int) (*Industry, error) {
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, err
+	}
+
+	industry, err := s.store.Retrieve(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	industriesMap, err := s.getIndustriesMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(industry, industriesMap), nil
+}
+
+func (s *industryService) Create(ctx *gofr.Context, payload *IndustryCreate) (*Industry, error) {
+	if err := authorize(ctx, auth.WriteIndustries); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateName(ctx, 0, payload.Name); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateParent(ctx, payload.Level, payload.ParentID); err != nil {
+		return nil, err
+	}
+
+	model := &stores.Industry{
+		Name:      payload.Name,
+		Level:     payload.Level,
+		ParentID:  payload.ParentID,
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	var (
+		industry *stores.Industry
+		err      error
+	)
+
+	err = stores.InTx(ctx, func() error {
+		if industry, err = s.store.Create(ctx, model); err != nil {
+
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "industries", industry.ID, AuditActionCreate, nil, industry)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	industriesMap, err := s.getIndustriesMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(industry, industriesMap), nil
+}
+
+func (s *industryService) Patch(ctx *gofr.Context, id int, payload *IndustryUpdate) (*Industry, error) {
+	if err := authorize(ctx, auth.WriteIndustries); err != nil {
+		return nil, err
+	}
+
+	industry, err := s.store.Retrieve(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *industry
+
+	if payload.Name != "" && payload.Name != industry.Name {
+		if err = s.validateName(ctx, id, payload.Name); err != nil {
+			return nil, err
+		}
+
+		industry.Name = payload.Name
+	}
+
+	if payload.ParentID != nil {
+		if err = s.validateParent(ctx, industry.Level, payload.ParentID); err != nil {
+			return nil, err
+		}
+
+		industry.ParentID = payload.ParentID
+	}
+
+	industry.UpdatedAt = time.Now().UTC()
+
+	err = stores.InTx(ctx, func() error {
+		if industry, err = s.store.Update(ctx, id, industry); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "industries", industry.ID, AuditActionUpdate, &before, industry)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	industriesMap, err := s.getIndustriesMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(industry, industriesMap), nil
+}
+
+func (s *industryService) Delete(ctx *gofr.Context, id int) error {
+	if err := authorize(ctx, auth.WriteIndustries); err != nil {
+		return err
+	}
+
+	industry, err := s.store.Retrieve(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	children, err := s.store.Index(ctx, &stores.IndustryFilter{ParentID: id}, 1, 0)
+	if err != nil {
+		return err
+	}
+
+	if len(children) > 0 {
+		return &ErrResp{Code: 409, Message: industry.Name + " has child classifications"}
+	}
+
+	count, err := s.securityIndustryStore.Count(ctx, &stores.SecurityIndustryFilter{IndustryIDs: []int{id}})
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return &ErrResp{Code: 409, Message: industry.Name + " is assigned to securities"}
+	}
+
+	return stores.InTx(ctx, func() error {
+		if err := s.store.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "industries", id, AuditActionDelete, industry, nil)
+	})
+}
+
+func (s *industryService) Assignments(ctx *gofr.Context, securityID int) ([]*IndustryAssignment, error) {
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.securityStore.Retrieve(ctx, securityID); err != nil {
+		return nil, err
+	}
+
+	assignments, err := s.securityIndustryStore.Index(ctx, &stores.SecurityIndustryFilter{SecurityID: securityID}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	industriesMap, err := s.getIndustriesMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp = make([]*IndustryAssignment, len(assignments))
+
+	for i := range assignments {
+		resp[i] = s.buildAssignmentResp(assignments[i], industriesMap)
+	}
+
+	return resp, nil
+}
+
+// Assign classifies a security from EffectiveFrom onwards, closing the assignment it replaces. Assignments form a contiguous timeline, so
+// EffectiveFrom has to be later than that of the current assignment.
+func (s *industryService) Assign(ctx *gofr.Context, securityID int, payload *IndustryAssignmentCreate) (*IndustryAssignment, error) {
+	if err := authorize(ctx, auth.WriteSecurities); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.securityStore.Retrieve(ctx, securityID); err != nil {
+		return nil, err
+	}
+
+	var (
+		industry *stores.Industry
+		err      error
+	)
+
+	switch {
+	case payload.IndustryID != 0:
+		industry, err = s.store.Retrieve(ctx, payload.IndustryID)
+	case payload.Industry != "":
+		industry, err = stores.IndustryFromString(ctx, payload.Industry)
+	default:
+		return nil, http.ErrorMissingParam{Params: []string{"industry"}}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	industriesMap, err := s.getIndustriesMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	effectiveFrom := payload.EffectiveFrom
+	if effectiveFrom.IsZero() {
+		effectiveFrom = time.Now().UTC().Truncate(24 * time.Hour)
+	}
+
+	current, err := s.securityIndustryStore.Index(ctx, &stores.SecurityIndustryFilter{SecurityID: securityID}, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(current) > 0 {
+		if current[0].IndustryID == industry.ID {
+			return s.buildAssignmentResp(current[0], industriesMap), nil
+		}
+
+		if !effectiveFrom.After(current[0].EffectiveFrom) {
+			return nil, &ErrResp{Code: 409, Message: "effectiveFrom must be after " + current[0].EffectiveFrom.Format(time.DateOnly) +
+				", the start of the current assignment"}
+		}
+	}
+
+	now := time.Now().UTC()
+
+	var assignment *stores.SecurityIndustry
+
+	err = stores.InTx(ctx, func() error {
+		assignment, err = s.securityIndustryStore.Create(ctx, &stores.SecurityIndustry{
+			SecurityID:    securityID,
+			IndustryID:    industry.ID,
+			EffectiveFrom: effectiveFrom,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(current) > 0 {
+			before := *current[0]
+
+			current[0].EffectiveTo = &effectiveFrom
+			current[0].UpdatedAt = now
+
+			if _, err = s.securityIndustryStore.Update(ctx, current[0].ID, current[0]); err != nil {
+				return err
+			}
+
+			if err = s.auditEventService.Record(ctx, "security-industries", current[0].ID, AuditActionUpdate, &before, current[0]); err != nil {
+				return err
+			}
+		}
+
+		return s.auditEventService.Record(ctx, "security-industries", assignment.ID, AuditActionCreate, nil, assignment)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildAssignmentResp(assignment, industriesMap), nil
+}
+
+// Unassign removes the current assignment of a security and reopens the one it replaced.
+func (s *industryService) Unassign(ctx *gofr.Context, securityID, id int) error {
+	if err := authorize(ctx, auth.WriteSecurities); err != nil {
+		return err
+	}
+
+	assignment, err := s.securityIndustryStore.Retrieve(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if assignment.SecurityID != securityID {
+		return http.ErrorEntityNotFound{Name: "security-industries", Value: strconv.Itoa(id)}
+	}
+
+	assignments, err := s.securityIndustryStore.Index(ctx, &stores.SecurityIndustryFilter{SecurityID: securityID}, 2, 0)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case assignments[0].ID != id:
+		return &ErrResp{Code: 409, Message: "only the current assignment of a security can be removed"}
+	case len(assignments) == 1:
+		return &ErrResp{Code: 409, Message: "a security must keep at least one industry assignment"}
+	}
+
+	before := *assignments[1]
+
+	assignments[1].EffectiveTo = nil
+	assignments[1].UpdatedAt = time.Now().UTC()
+
+	return stores.InTx(ctx, func() error {
+		if err := s.securityIndustryStore.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		if err := s.auditEventService.Record(ctx, "security-industries", id, AuditActionDelete, assignments[0], nil); err != nil {
+			return err
+		}
+
+		if _, err := s.securityIndustryStore.Update(ctx, assignments[1].ID, assignments[1]); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "security-industries", assignments[1].ID, AuditActionUpdate, &before, assignments[1])
+	})
+}
+
+// Expand resolves industry names to their ids together with the ids of every classification beneath them.
+func (s *industryService) Expand(ctx *gofr.Context, names []string) ([]int, error) {
+	industries, err := s.store.Index(ctx, &stores.IndustryFilter{}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		ids      []int
+		children = make(map[int][]int)
+	)
+
+	for _, industry := range industries {
+		if industry.ParentID != nil {
+			children[*industry.ParentID] = append(children[*industry.ParentID], industry.ID)
+		}
+	}
+
+	for _, name := range names {
+		i := slices.IndexFunc(industries, func(industry *stores.Industry) bool { return industry.Name == name })
+		if i == -1 {
+			return nil, http.ErrorEntityNotFound{Name: "industries", Value: name}
+		}
+
+		for queue := []int{industries[i].ID}; len(queue) > 0; queue = queue[1:] {
+			if !slices.Contains(ids, queue[0]) {
+				ids = append(ids, queue[0])
+				queue = append(queue, children[queue[0]]...)
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+func (s *industryService) Summary(ctx *gofr.Context, name string, date time.Time) (*IndustrySummary, error) {
+	if date.IsZero() {
+		dates, _, err := s.marketDayService.Index(ctx, &MarketDayFilter{LastNDays: 2})
+		if err != nil {
+			return nil, err
+		}
+
+		date = dates[0]
+		if dates[0].Format(time.DateOnly) == time.Now().Format(time.DateOnly) {
+			date = dates[1]
+		}
+	}
+
+	summaries, err := s.Summaries(ctx, name, &IndustrySummaryFilter{From: date, To: date})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(summaries) == 0 {
+		return nil, &ErrResp{Code: 400, Message: date.Format(time.DateOnly) + " is not a market day"}
+	}
+
+	return summaries[0], nil
+}
+
+// Summaries aggregates the securities classified under the industry, or any classification beneath it, on each market day.
+func (s *industryService) Summaries(ctx *gofr.Context, name string, f *IndustrySummaryFilter) ([]*IndustrySummary, error) {
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, err
+	}
+
+	industryIDs, err := s.Expand(ctx, []string{name})
+	if err != nil {
+		return nil, err
+	}
+
+	to := f.To
+	if to.IsZero() {
+		to = time.Now().UTC().Truncate(24 * time.Hour)
+	}
+
+	from := f.From
+	if from.IsZero() {
+		from = to.Add(-industrySummaryLookback)
+	}
+
+	if from.After(to) {
+		return nil, &ErrResp{Code: 400, Message: "from cannot be after to"}
+	}
+
+	entitlement, err := s.entitlementService.Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := entitlement.CheckDate(from); err != nil {
+		return nil, err
+	}
+
+	marketDays, _, err := s.marketDayService.Index(ctx, &MarketDayFilter{DateBetween: &struct {
+		StartDate time.Time
+		EndDate   time.Time
+	}{StartDate: from, EndDate: to}})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(marketDays) == 0 {
+		return []*IndustrySummary{}, nil
+	}
+
+	slices.SortFunc(marketDays, func(a, b time.Time) int { return a.Compare(b) })
+
+	var summaries = make([]*IndustrySummary, len(marketDays))
+
+	for i := range marketDays {
+		summaries[i] = &IndustrySummary{Industry: name, Date: marketDays[i]}
+	}
+
+	assignments, err := s.securityIndustryStore.Index(ctx, &stores.SecurityIndustryFilter{IndustryIDs: industryIDs}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var securityIDs []int
+
+	for _, assignment := range assignments {
+		if !slices.Contains(securityIDs, assignment.SecurityID) {
+			securityIDs = append(securityIDs, assignment.SecurityID)
+		}
+	}
+
+	if len(securityIDs) == 0 {
+		return summaries, nil
+	}
+
+	securities, err := s.securityStore.Index(ctx, &stores.SecurityFilter{IDs: securityIDs, MaxTier: entitlement.Tier}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var visible = make(map[int]bool, len(securities))
+
+	securityIDs = securityIDs[:0]
+
+	for i := range securities {
+		securityIDs = append(securityIDs, securities[i].ID)
+		visible[securities[i].ID] = true
+	}
+
+	if len(securityIDs) == 0 {
+		return summaries, nil
+	}
+
+	previousDay, err := s.previousMarketDay(ctx, marketDays[0])
+	if err != nil {
+		return nil, err
+	}
+
+	statsFrom := marketDays[0]
+	if !previousDay.IsZero() {
+		statsFrom = previousDay
+	}
+
+	statsMap, err := s.getStatsMap(ctx, securityIDs, statsFrom, marketDays[len(marketDays)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	rsiMap, err := s.getMetricValuesMap(ctx, stores.RSI, industrySummaryRSIPeriod, entitlement.Tier, securityIDs, marketDays)
+	if err != nil {
+		return nil, err
+	}
+
+	smaMap, err := s.getMetricValuesMap(ctx, stores.SMA, industrySummarySMAPeriod, entitlement.Tier, securityIDs, marketDays)
+	if err != nil {
+		return nil, err
+	}
+
+	securityFundamentals, err := s.securityFundamentalStore.Index(ctx,
+		&stores.SecurityFundamentalFilter{SecurityIDs: securityIDs, ReportedBy: marketDays[len(marketDays)-1]}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, summary := range summaries {
+		date := summary.Date.Format(time.DateOnly)
+
+		var previous map[int]*stores.SecurityStat
+
+		switch {
+		case i > 0:
+			previous = statsMap[marketDays[i-1].Format(time.DateOnly)]
+		case !previousDay.IsZero():
+			previous = statsMap[previousDay.Format(time.DateOnly)]
+		}
+
+		var members []int
+
+		for _, assignment := range assignments {
+			if visible[assignment.SecurityID] && !assignment.EffectiveFrom.After(summary.Date) &&
+				(assignment.EffectiveTo == nil || assignment.EffectiveTo.After(summary.Date)) {
+				members = append(members, assignment.SecurityID)
+			}
+		}
+
+		aggregateIndustrySummary(summary, members, statsMap[date], previous, rsiMap[date], smaMap[date],
+			sharesOutstandingAsOf(securityFundamentals, summary.Date))
+	}
+
+	return summaries, nil
+}
+
+func (s *industryService) validateName(ctx *gofr.Context, id int, name string) error {
+	if name == "" || len(name) > industryNameMaxLength {
+		return &ErrResp{Code: 400, Message: fmt.Sprintf("name must be between 1 and %d characters", industryNameMaxLength)}
+	}
+
+	existing, err := stores.IndustryFromString(ctx, name)
+	if err == nil && existing.ID != id {
+		return &ErrResp{Code: 409, Message: "an industry named " + name + " already exists"}
+	}
+
+	if _, ok := err.(http.ErrorEntityNotFound); err != nil && !ok {
+		return err
+	}
+
+	return nil
+}
+
+func (s *industryService) validateParent(ctx *gofr.Context, level string, parentID *int) error {
+	depth := slices.Index(industryLevels, level)
+
+	switch {
+	case depth == -1:
+		return &ErrResp{Code: 400, Message: "invalid level - " + level}
+	case depth == 0 && parentID != nil:
+		return &ErrResp{Code: 400, Message: "a sector cannot have a parent"}
+	case depth > 0 && parentID == nil:
+		return &ErrResp{Code: 400, Message: "parentId is required for level " + level}
+	case depth == 0:
+		return nil
+	}
+
+	parent, err := s.store.Retrieve(ctx, *parentID)
+	if err != nil {
+		return err
+	}
+
+	if parent.Level != industryLevels[depth-1] {
+		return &ErrResp{Code: 400, Message: "the parent of an " + level + " must be a " + industryLevels[depth-1]}
+	}
+
+	return nil
+}
+
+func (s *industryService) getIndustriesMap(ctx *gofr.Context) (map[int]*stores.Industry, error) {
+	industries, err := s.store.Index(ctx, &stores.IndustryFilter{}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var industriesMap = make(map[int]*stores.Industry)
+
+	for i := range industries {
+		industriesMap[industries[i].ID] = industries[i]
+	}
+
+	return industriesMap, nil
 }
 
-func NewIndustryService(store stores.IndustryStore) *industryService {
-	return &industryService{store: store}
+func (s *industryService) previousMarketDay(ctx *gofr.Context, date time.Time) (time.Time, error) {
+	dates, _, err := s.marketDayService.Index(ctx, &MarketDayFilter{LastNDaysFromReference: &struct {
+		N         int
+		Reference time.Time
+	}{N: 2, Reference: date}})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	for _, d := range dates {
+		if d.Before(date) {
+			return d, nil
+		}
+	}
+
+	return time.Time{}, nil
+}
+
+func (s *industryService) getStatsMap(ctx *gofr.Context, securityIDs []int, from, to time.Time) (map[string]map[int]*stores.SecurityStat, error) {
+	securityStats, err := s.securityStatStore.Index(ctx, &stores.SecurityStatFilter{SecurityIDs: securityIDs, MinDate: from, MaxDate: to}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var statsMap = make(map[string]map[int]*stores.SecurityStat)
+
+	for _, securityStat := range securityStats {
+		date := securityStat.Date.Format(time.DateOnly)
+
+		if statsMap[date] == nil {
+			statsMap[date] = make(map[int]*stores.SecurityStat)
+		}
+
+		statsMap[date][securityStat.SecurityID] = securityStat
+	}
+
+	return statsMap, nil
+}
+
+func (s *industryService) getMetricValuesMap(ctx *gofr.Context, metricType stores.MetricType, period int, maxTier *int, securityIDs []int,
+	marketDays []time.Time) (map[string]map[int]float64, error) {
+	metrics, err := s.metricStore.Index(ctx, &stores.MetricFilter{Type: &metricType, Period: period, MaxTier: maxTier}, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var valuesMap = make(map[string]map[int]float64)
+
+	if len(metrics) == 0 {
+		return valuesMap, nil
+	}
+
+	securityMetrics, err := s.securityMetricStore.Index(ctx, &stores.SecurityMetricFilter{
+		SecurityIDs: securityIDs,
+		MetricID:    metrics[0].ID,
+		MinDate:     marketDays[0],
+		MaxDate:     marketDays[len(marketDays)-1],
+	}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, securityMetric := range securityMetrics {
+		date := securityMetric.Date.Format(time.DateOnly)
+
+		if valuesMap[date] == nil {
+			valuesMap[date] = make(map[int]float64)
+		}
+
+		valuesMap[date][securityMetric.SecurityID] = securityMetric.Value
+	}
+
+	return valuesMap, nil
 }
 
-func (s *industryService) Index(ctx *gofr.Context) []stores.Industry {
-	return s.store.Index(ctx)
+// sharesOutstandingAsOf picks the share count of each security from the latest results reported by date. securityFundamentals must be
+// ordered by security and latest period first.
+func sharesOutstandingAsOf(securityFundamentals []*stores.SecurityFundamental, date time.Time) map[int]int64 {
+	var shares = make(map[int]int64)
+
+	for _, sf := range securityFundamentals {
+		if _, ok := shares[sf.SecurityID]; ok {
+			continue
+		}
+
+		reportedAt := sf.PeriodEnd
+		if sf.ReportedAt != nil {
+			reportedAt = *sf.ReportedAt
+		}
+
+		if !reportedAt.After(date) {
+			shares[sf.SecurityID] = sf.SharesOutstanding
+		}
+	}
+
+	return shares
+}
+
+// aggregateIndustrySummary weights returns by the previous day's market cap. Members without reported fundamentals are left out of the
+// weighted return, so unless they make up at least industrySummaryMinMarketCapCoverage of the members with a return it weights by the
+// previous day's traded value instead. The basis used and the percentage of members with a return it covers are set on the summary.
+func aggregateIndustrySummary(summary *IndustrySummary, members []int, current, previous map[int]*stores.SecurityStat,
+	rsiValues, smaValues map[int]float64, sharesOutstanding map[int]int64) {
+	var (
+		returns                 []float64
+		weightedSum, weightSum  float64
+		tradedSum, tradedWeight float64
+		weighted, traded        int
+		rsiSum                  float64
+		rsiCount                int
+		aboveSMA, comparedToSMA int
+	)
+
+	summary.Securities = len(members)
+
+	for _, securityID := range members {
+		if rsi, ok := rsiValues[securityID]; ok {
+			rsiSum += rsi
+			rsiCount++
+		}
+
+		securityStat, ok := current[securityID]
+		if !ok {
+			continue
+		}
+
+		if sma, ok := smaValues[securityID]; ok {
+			comparedToSMA++
+
+			if securityStat.Close > sma {
+				aboveSMA++
+			}
+		}
+
+		prev, ok := previous[securityID]
+		if !ok || prev.Close <= 0 {
+			continue
+		}
+
+		change := (securityStat.Close - prev.Close) / prev.Close * 100
+
+		switch {
+		case change > 0:
+			summary.Advances++
+		case change < 0:
+			summary.Declines++
+		default:
+			summary.Unchanged++
+		}
+
+		returns = append(returns, change)
+
+		if shares, ok := sharesOutstanding[securityID]; ok {
+			weight := prev.Close * float64(shares)
+			weightedSum += change * weight
+			weightSum += weight
+
+			if weight > 0 {
+				weighted++
+			}
+		}
+
+		weight := prev.Close * float64(prev.Volume)
+		tradedSum += change * weight
+		tradedWeight += weight
+
+		if weight > 0 {
+			traded++
+		}
+	}
+
+	summary.WeightingBasis = IndustryWeightingMarketCap
+
+	if weightSum == 0 || float64(weighted) < industrySummaryMinMarketCapCoverage*float64(len(returns)) {
+		weightedSum, weightSum, weighted = tradedSum, tradedWeight, traded
+		summary.WeightingBasis = IndustryWeightingTradedValue
+	}
+
+	if len(returns) > 0 {
+		slices.Sort(returns)
+
+		median := returns[len(returns)/2]
+		if len(returns)%2 == 0 {
+			median = (returns[len(returns)/2-1] + returns[len(returns)/2]) / 2
+		}
+
+		summary.MedianReturn = float64Ptr(median)
+	}
+
+	if weightSum > 0 {
+		summary.WeightedReturn = float64Ptr(weightedSum / weightSum)
+		summary.WeightingCoverage = float64Ptr(float64(weighted) / float64(len(returns)) * 100)
+	} else {
+		summary.WeightingBasis = ""
+	}
+
+	if rsiCount > 0 {
+		summary.AverageRSI = float64Ptr(rsiSum / float64(rsiCount))
+	}
+
+	if comparedToSMA > 0 {
+		summary.PercentAboveSMA200 = float64Ptr(float64(aboveSMA) / float64(comparedToSMA) * 100)
+	}
+}
+
+func float64Ptr(value float64) *float64 {
+	return &value
+}
+
+func (s *industryService) buildResp(model *stores.Industry, industriesMap map[int]*stores.Industry) *Industry {
+	resp := &Industry{
+		ID:        model.ID,
+		Name:      model.Name,
+		Level:     model.Level,
+		ParentID:  model.ParentID,
+		Path:      industryPath(model, industriesMap),
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+
+	return resp
+}
+
+func (s *industryService) buildAssignmentResp(model *stores.SecurityIndustry, industriesMap map[int]*stores.Industry) *IndustryAssignment {
+	resp := &IndustryAssignment{
+		ID:            model.ID,
+		SecurityID:    model.SecurityID,
+		IndustryID:    model.IndustryID,
+		EffectiveFrom: model.EffectiveFrom,
+		EffectiveTo:   model.EffectiveTo,
+		CreatedAt:     model.CreatedAt,
+		UpdatedAt:     model.UpdatedAt,
+	}
+
+	if industry, ok := industriesMap[model.IndustryID]; ok {
+		resp.Industry = industry.Name
+	}
+
+	return resp
+}
+
+// industryPath lists the names from the sector down to the given industry.
+func industryPath(industry *stores.Industry, industriesMap map[int]*stores.Industry) []string {
+	path := []string{industry.Name}
+
+	for parentID := industry.ParentID; parentID != nil && len(path) < len(industryLevels); {
+		parent, ok := industriesMap[*parentID]
+		if !ok {
+			break
+		}
+
+		path = append([]string{parent.Name}, path...)
+		parentID = parent.ParentID
+	}
+
+	return path
 }
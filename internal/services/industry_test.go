@@ -0,0 +1,105 @@
+package services
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stratifyr/security-service/internal/stores"
+)
+
+func TestAggregateIndustrySummaryWeighting(t *testing.T) {
+	members := []int{1, 2, 3, 4, 5}
+
+	previous := make(map[int]*stores.SecurityStat)
+	current := make(map[int]*stores.SecurityStat)
+
+	// returns of 10, -10, 0, 5 and 0 percent, each with a previous traded value of 1000
+	for i, closePrice := range []float64{110, 90, 100, 105, 100} {
+		previous[i+1] = &stores.SecurityStat{SecurityID: i + 1, Close: 100, Volume: 10}
+		current[i+1] = &stores.SecurityStat{SecurityID: i + 1, Close: closePrice}
+	}
+
+	tests := []struct {
+		name              string
+		previous          map[int]*stores.SecurityStat
+		sharesOutstanding map[int]int64
+		wantReturn        *float64
+		wantBasis         string
+		wantCoverage      *float64
+	}{
+		{
+			name:              "every member has fundamentals",
+			previous:          previous,
+			sharesOutstanding: map[int]int64{1: 1, 2: 1, 3: 1, 4: 1, 5: 6},
+			wantReturn:        float64Ptr(0.5),
+			wantBasis:         IndustryWeightingMarketCap,
+			wantCoverage:      float64Ptr(100),
+		},
+		{
+			name:              "fundamentals at the coverage threshold",
+			previous:          previous,
+			sharesOutstanding: map[int]int64{1: 1, 2: 1, 3: 1, 4: 2},
+			wantReturn:        float64Ptr(2),
+			wantBasis:         IndustryWeightingMarketCap,
+			wantCoverage:      float64Ptr(80),
+		},
+		{
+			name:              "fundamentals below the coverage threshold",
+			previous:          previous,
+			sharesOutstanding: map[int]int64{1: 1, 2: 1, 3: 1},
+			wantReturn:        float64Ptr(1),
+			wantBasis:         IndustryWeightingTradedValue,
+			wantCoverage:      float64Ptr(100),
+		},
+		{
+			name:              "no fundamentals",
+			previous:          previous,
+			sharesOutstanding: map[int]int64{},
+			wantReturn:        float64Ptr(1),
+			wantBasis:         IndustryWeightingTradedValue,
+			wantCoverage:      float64Ptr(100),
+		},
+		{
+			name:              "no returns",
+			previous:          map[int]*stores.SecurityStat{},
+			sharesOutstanding: map[int]int64{1: 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			summary := &IndustrySummary{}
+
+			aggregateIndustrySummary(summary, members, current, tc.previous, nil, nil, tc.sharesOutstanding)
+
+			if !float64PtrEqual(summary.WeightedReturn, tc.wantReturn) {
+				t.Errorf("WeightedReturn = %v, want %v", ptrString(summary.WeightedReturn), ptrString(tc.wantReturn))
+			}
+
+			if summary.WeightingBasis != tc.wantBasis {
+				t.Errorf("WeightingBasis = %q, want %q", summary.WeightingBasis, tc.wantBasis)
+			}
+
+			if !float64PtrEqual(summary.WeightingCoverage, tc.wantCoverage) {
+				t.Errorf("WeightingCoverage = %v, want %v", ptrString(summary.WeightingCoverage), ptrString(tc.wantCoverage))
+			}
+		})
+	}
+}
+
+func float64PtrEqual(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return math.Abs(*a-*b) < 1e-9
+}
+
+func ptrString(v *float64) string {
+	if v == nil {
+		return "nil"
+	}
+
+	return strconv.FormatFloat(*v, 'f', -1, 64)
+}
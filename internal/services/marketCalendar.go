@@ -0,0 +1,108 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/stratifyr/security-service/internal/stores"
+)
+
+// calendarExpiry bounds how long a replica serves holidays written through another one, Invalidate only clears the calendar of the
+// replica that handled the write. The holiday list is small, so reloading it every minute costs little.
+const calendarExpiry = time.Minute
+
+type MarketCalendar interface {
+	IsMarketDay(ctx *gofr.Context, date time.Time) (bool, error)
+	MarketDays(ctx *gofr.Context, startDate, endDate time.Time, n int) ([]time.Time, error)
+	Invalidate()
+}
+
+type marketCalendar struct {
+	marketHolidayStore stores.MarketHolidayStore
+
+	mu       sync.RWMutex
+	holidays map[string]struct{}
+	loadedAt time.Time
+}
+
+func NewMarketCalendar(marketHolidayStore stores.MarketHolidayStore) *marketCalendar {
+	return &marketCalendar{marketHolidayStore: marketHolidayStore}
+}
+
+func (c *marketCalendar) IsMarketDay(ctx *gofr.Context, date time.Time) (bool, error) {
+	holidays, err := c.getHolidays(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return isMarketDay(holidays, date), nil
+}
+
+func (c *marketCalendar) MarketDays(ctx *gofr.Context, startDate, endDate time.Time, n int) ([]time.Time, error) {
+	holidays, err := c.getHolidays(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var marketDays []time.Time
+
+	for date := endDate; len(marketDays) < n && date.Unix() >= startDate.Unix(); date = date.Add(-24 * time.Hour) {
+		if isMarketDay(holidays, date) {
+			marketDays = append(marketDays, date)
+		}
+	}
+
+	return marketDays, nil
+}
+
+func (c *marketCalendar) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.holidays = nil
+}
+
+func (c *marketCalendar) getHolidays(ctx *gofr.Context) (map[string]struct{}, error) {
+	c.mu.RLock()
+	holidays, loadedAt := c.holidays, c.loadedAt
+	c.mu.RUnlock()
+
+	if holidays != nil && time.Since(loadedAt) < calendarExpiry {
+		return holidays, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.holidays != nil && time.Since(c.loadedAt) < calendarExpiry {
+		return c.holidays, nil
+	}
+
+	marketHolidays, err := c.marketHolidayStore.Index(ctx, &stores.MarketHolidayFilter{}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	holidays = make(map[string]struct{}, len(marketHolidays))
+
+	for i := range marketHolidays {
+		holidays[marketHolidays[i].Date.Format(time.DateOnly)] = struct{}{}
+	}
+
+	c.holidays = holidays
+	c.loadedAt = time.Now()
+
+	return holidays, nil
+}
+
+func isMarketDay(holidays map[string]struct{}, date time.Time) bool {
+	if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+		return false
+	}
+
+	_, isHoliday := holidays[date.Format(time.DateOnly)]
+
+	return !isHoliday
+}
@@ -1,15 +1,16 @@
 package services
 
 import (
-	"gofr.dev/pkg/gofr/http"
 	"slices"
 	"time"
 
 	"gofr.dev/pkg/gofr"
-
-	"github.com/stratifyr/security-service/internal/stores"
+	"gofr.dev/pkg/gofr/http"
 )
 
+// marketDayChunk keeps each DateBetween range within the year Index accepts.
+const marketDayChunk = 365 * 24 * time.Hour
+
 type MarketDayService interface {
 	Index(ctx *gofr.Context, f *MarketDayFilter) ([]time.Time, int, error)
 }
@@ -27,11 +28,11 @@ type MarketDayFilter struct {
 }
 
 type marketDayService struct {
-	marketHolidayStore stores.MarketHolidayStore
+	marketCalendar MarketCalendar
 }
 
-func NewMarketDayService(marketHolidayStore stores.MarketHolidayStore) *marketDayService {
-	return &marketDayService{marketHolidayStore: marketHolidayStore}
+func NewMarketDayService(marketCalendar MarketCalendar) *marketDayService {
+	return &marketDayService{marketCalendar: marketCalendar}
 }
 
 func (s *marketDayService) Index(ctx *gofr.Context, f *MarketDayFilter) ([]time.Time, int, error) {
@@ -62,33 +63,56 @@ func (s *marketDayService) Index(ctx *gofr.Context, f *MarketDayFilter) ([]time.
 		return nil, 0, &ErrResp{Code: 400, Message: "date range is too long, please pass interval within a year"}
 	}
 
-	marketHolidays, err := s.marketHolidayStore.Index(ctx, &stores.MarketHolidayFilter{
-		DateBetween: &struct {
-			StartDate time.Time
-			EndDate   time.Time
-		}{StartDate: startDate, EndDate: endDate},
-	}, 0, 0)
+	marketDays, err := s.marketCalendar.MarketDays(ctx, startDate, endDate, n)
 	if err != nil {
 		return nil, 0, err
 	}
 
+	return marketDays, len(marketDays), nil
+}
+
+// marketDaysBetween lists the market days from startDate to endDate however far apart they are, by querying a year at a time.
+func marketDaysBetween(ctx *gofr.Context, marketDayService MarketDayService, startDate, endDate time.Time) ([]time.Time, error) {
 	var marketDays []time.Time
 
-	for date := endDate; len(marketDays) < n && date.Unix() >= startDate.Unix(); date = date.Add(-24 * time.Hour) {
-		if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
-			continue
+	for chunkStart := startDate; !chunkStart.After(endDate); chunkStart = chunkStart.Add(marketDayChunk) {
+		chunkEnd := chunkStart.Add(marketDayChunk - 24*time.Hour)
+		if chunkEnd.After(endDate) {
+			chunkEnd = endDate
 		}
 
-		isHoliday := slices.ContainsFunc(marketHolidays, func(marketHoliday *stores.MarketHoliday) bool {
-			return marketHoliday.Date.Format(time.DateOnly) == date.Format(time.DateOnly)
-		})
-
-		if isHoliday {
-			continue
+		days, _, err := marketDayService.Index(ctx, &MarketDayFilter{DateBetween: &struct {
+			StartDate time.Time
+			EndDate   time.Time
+		}{StartDate: chunkStart, EndDate: chunkEnd}})
+		if err != nil {
+			return nil, err
 		}
 
-		marketDays = append(marketDays, date)
+		marketDays = append(marketDays, days...)
 	}
 
-	return marketDays, len(marketDays), nil
+	slices.SortFunc(marketDays, func(a, b time.Time) int { return a.Compare(b) })
+
+	return slices.CompactFunc(marketDays, func(a, b time.Time) bool { return a.Equal(b) }), nil
+}
+
+// marketDaysWithLookback lists the market days from startDate to endDate preceded by the n-1 market days before startDate, so that
+// every day in the range can be paired with its n-1 predecessors.
+func marketDaysWithLookback(ctx *gofr.Context, marketDayService MarketDayService, n int, startDate, endDate time.Time) ([]time.Time, error) {
+	lookback, _, err := marketDayService.Index(ctx, &MarketDayFilter{LastNDaysFromReference: &struct {
+		N         int
+		Reference time.Time
+	}{N: n, Reference: startDate}})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, day := range lookback {
+		if day.Before(startDate) {
+			startDate = day
+		}
+	}
+
+	return marketDaysBetween(ctx, marketDayService, startDate, endDate)
 }
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+)
+
+// weekdayMarketDayService treats weekdays as market days and enforces the same one year limit as marketDayService.
+type weekdayMarketDayService struct{}
+
+func (weekdayMarketDayService) Index(_ *gofr.Context, f *MarketDayFilter) ([]time.Time, int, error) {
+	if f.DateBetween.EndDate.Sub(f.DateBetween.StartDate) >= 366*24*time.Hour {
+		return nil, 0, &ErrResp{Code: 400, Message: "date range is too long, please pass interval within a year"}
+	}
+
+	var days []time.Time
+
+	for d := f.DateBetween.StartDate; !d.After(f.DateBetween.EndDate); d = d.AddDate(0, 0, 1) {
+		if d.Weekday() != time.Saturday && d.Weekday() != time.Sunday {
+			days = append(days, d)
+		}
+	}
+
+	return days, len(days), nil
+}
+
+func TestMarketDaysBetween(t *testing.T) {
+	date := func(value string) time.Time {
+		d, _ := time.Parse(time.DateOnly, value)
+		return d
+	}
+
+	tests := []struct {
+		name      string
+		startDate time.Time
+		endDate   time.Time
+		wantCount int
+	}{
+		{name: "single day", startDate: date("2024-01-01"), endDate: date("2024-01-01"), wantCount: 1},
+		{name: "within a year", startDate: date("2024-01-01"), endDate: date("2024-01-31"), wantCount: 23},
+		{name: "leap year", startDate: date("2024-01-01"), endDate: date("2024-12-31"), wantCount: 262},
+		{name: "five years", startDate: date("2020-01-01"), endDate: date("2024-12-31"), wantCount: 1305},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			days, err := marketDaysBetween(newTestContext(), weekdayMarketDayService{}, tc.startDate, tc.endDate)
+			if err != nil {
+				t.Fatalf("marketDaysBetween() err = %v", err)
+			}
+
+			if len(days) != tc.wantCount {
+				t.Errorf("got %d market days, want %d", len(days), tc.wantCount)
+			}
+
+			for i := 1; i < len(days); i++ {
+				if !days[i].After(days[i-1]) {
+					t.Fatalf("days are not strictly increasing at %s", days[i].Format(time.DateOnly))
+				}
+			}
+
+			if days[0].Before(tc.startDate) || days[len(days)-1].After(tc.endDate) {
+				t.Errorf("days span %s to %s, outside the range", days[0].Format(time.DateOnly), days[len(days)-1].Format(time.DateOnly))
+			}
+		})
+	}
+}
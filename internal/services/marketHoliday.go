@@ -5,6 +5,7 @@ import (
 
 	"gofr.dev/pkg/gofr"
 
+	"github.com/stratifyr/security-service/internal/auth"
 	"github.com/stratifyr/security-service/internal/stores"
 )
 
@@ -13,7 +14,7 @@ type MarketHolidayService interface {
 	Read(ctx *gofr.Context, id int) (*MarketHoliday, error)
 	Create(ctx *gofr.Context, payload *MarketHolidayCreate) (*MarketHoliday, error)
 	Patch(ctx *gofr.Context, id int, payload *MarketHolidayUpdate) (*MarketHoliday, error)
-	Delete(ctx *gofr.Context, id, userID int) error
+	Delete(ctx *gofr.Context, id int) error
 }
 
 type MarketHolidayFilter struct {
@@ -33,23 +34,30 @@ type MarketHoliday struct {
 }
 
 type MarketHolidayCreate struct {
-	UserID      int
 	Date        time.Time
 	Description string
 }
 
 type MarketHolidayUpdate struct {
-	UserID      int
 	Date        time.Time
 	Description string
 }
 
 type marketHolidayService struct {
-	store stores.MarketHolidayStore
+	marketCalendar     MarketCalendar
+	auditEventService  AuditEventService
+	domainEventService DomainEventService
+	store              stores.MarketHolidayStore
 }
 
-func NewMarketHolidayService(store stores.MarketHolidayStore) *marketHolidayService {
-	return &marketHolidayService{store: store}
+func NewMarketHolidayService(marketCalendar MarketCalendar, auditEventService AuditEventService, domainEventService DomainEventService,
+	store stores.MarketHolidayStore) *marketHolidayService {
+	return &marketHolidayService{
+		marketCalendar:     marketCalendar,
+		auditEventService:  auditEventService,
+		domainEventService: domainEventService,
+		store:              store,
+	}
 }
 
 func (s *marketHolidayService) Index(ctx *gofr.Context, f *MarketHolidayFilter, page, perPage int) ([]*MarketHoliday, int, error) {
@@ -94,8 +102,8 @@ func (s *marketHolidayService) Read(ctx *gofr.Context, id int) (*MarketHoliday,
 }
 
 func (s *marketHolidayService) Create(ctx *gofr.Context, payload *MarketHolidayCreate) (*MarketHoliday, error) {
-	if payload.UserID != 1 {
-		return nil, &ErrResp{Code: 403, Message: "Forbidden"}
+	if err := authorize(ctx, auth.WriteMarketHolidays); err != nil {
+		return nil, err
 	}
 
 	model := &stores.MarketHoliday{
@@ -105,17 +113,34 @@ func (s *marketHolidayService) Create(ctx *gofr.Context, payload *MarketHolidayC
 		UpdatedAt:   time.Now().UTC(),
 	}
 
-	marketHoliday, err := s.store.Create(ctx, model)
+	var (
+		marketHoliday *stores.MarketHoliday
+		err           error
+	)
+
+	err = stores.InTx(ctx, func() error {
+		if marketHoliday, err = s.store.Create(ctx, model); err != nil {
+			return err
+		}
+
+		if err := s.auditEventService.Record(ctx, "market-holidays", marketHoliday.ID, AuditActionCreate, nil, marketHoliday); err != nil {
+			return err
+		}
+
+		return s.emitChanged(ctx, marketHoliday, AuditActionCreate)
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	s.marketCalendar.Invalidate()
+
 	return s.buildResp(marketHoliday), nil
 }
 
 func (s *marketHolidayService) Patch(ctx *gofr.Context, id int, payload *MarketHolidayUpdate) (*MarketHoliday, error) {
-	if payload.UserID != 1 {
-		return nil, &ErrResp{Code: 403, Message: "Forbidden"}
+	if err := authorize(ctx, auth.WriteMarketHolidays); err != nil {
+		return nil, err
 	}
 
 	marketHoliday, err := s.store.Retrieve(ctx, id)
@@ -123,6 +148,8 @@ func (s *marketHolidayService) Patch(ctx *gofr.Context, id int, payload *MarketH
 		return nil, err
 	}
 
+	before := *marketHoliday
+
 	if payload.Date != (time.Time{}) {
 		marketHoliday.Date = payload.Date
 	}
@@ -131,32 +158,65 @@ func (s *marketHolidayService) Patch(ctx *gofr.Context, id int, payload *MarketH
 		marketHoliday.Description = payload.Description
 	}
 
-	marketHoliday, err = s.store.Update(ctx, id, marketHoliday)
+	err = stores.InTx(ctx, func() error {
+		if marketHoliday, err = s.store.Update(ctx, id, marketHoliday); err != nil {
+			return err
+		}
+
+		if err := s.auditEventService.Record(ctx, "market-holidays", marketHoliday.ID, AuditActionUpdate, &before, marketHoliday); err != nil {
+			return err
+		}
+
+		return s.emitChanged(ctx, marketHoliday, AuditActionUpdate)
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	s.marketCalendar.Invalidate()
+
 	return s.buildResp(marketHoliday), nil
 }
 
-func (s *marketHolidayService) Delete(ctx *gofr.Context, id, userID int) error {
-	if userID != 1 {
-		return &ErrResp{Code: 403, Message: "Forbidden"}
+func (s *marketHolidayService) Delete(ctx *gofr.Context, id int) error {
+	if err := authorize(ctx, auth.WriteMarketHolidays); err != nil {
+		return err
 	}
 
-	_, err := s.store.Retrieve(ctx, id)
+	marketHoliday, err := s.store.Retrieve(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	err = s.store.Delete(ctx, id)
+	err = stores.InTx(ctx, func() error {
+		if err := s.store.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		if err := s.auditEventService.Record(ctx, "market-holidays", id, AuditActionDelete, marketHoliday, nil); err != nil {
+			return err
+		}
+
+		return s.emitChanged(ctx, marketHoliday, AuditActionDelete)
+	})
 	if err != nil {
 		return err
 	}
 
+	s.marketCalendar.Invalidate()
+
 	return nil
 }
 
+func (s *marketHolidayService) emitChanged(ctx *gofr.Context, marketHoliday *stores.MarketHoliday, action string) error {
+	return s.domainEventService.Emit(ctx, TopicMarketHolidayChanged, &MarketHolidayEvent{
+		ID:          marketHoliday.ID,
+		Date:        marketHoliday.Date.Format(time.DateOnly),
+		Description: marketHoliday.Description,
+		Action:      action,
+	})
+}
+
 func (s *marketHolidayService) buildResp(model *stores.MarketHoliday) *MarketHoliday {
 	resp := &MarketHoliday{
 		ID:          model.ID,
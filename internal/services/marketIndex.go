@@ -0,0 +1,455 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+
+	"github.com/stratifyr/security-service/internal/auth"
+	"github.com/stratifyr/security-service/internal/stores"
+)
+
+const (
+	marketIndexNameMaxLength   = 100
+	marketIndexSymbolMaxLength = 50
+	marketIndexWeightTolerance = 0.5
+)
+
+type MarketIndexService interface {
+	Index(ctx *gofr.Context, f *MarketIndexFilter, page, perPage int) ([]*MarketIndex, int, error)
+	Read(ctx *gofr.Context, id int) (*MarketIndex, error)
+	Create(ctx *gofr.Context, payload *MarketIndexCreate) (*MarketIndex, error)
+	Patch(ctx *gofr.Context, id int, payload *MarketIndexUpdate) (*MarketIndex, error)
+	Delete(ctx *gofr.Context, id int) error
+	Constituents(ctx *gofr.Context, id int, date time.Time) ([]*IndexConstituent, error)
+	ReplaceConstituents(ctx *gofr.Context, id int, payload *IndexConstituentsReplace) ([]*IndexConstituent, error)
+}
+
+type MarketIndexFilter struct {
+	Name   string
+	Symbol string
+}
+
+type MarketIndex struct {
+	ID        int
+	Name      string
+	Symbol    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type MarketIndexCreate struct {
+	Name   string
+	Symbol string
+}
+
+type MarketIndexUpdate struct {
+	Name   string
+	Symbol string
+}
+
+type IndexConstituent struct {
+	SecurityID    int
+	Symbol        string
+	Weight        *float64
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time
+}
+
+type IndexConstituentsReplace struct {
+	EffectiveFrom time.Time
+	Constituents  []*IndexConstituentCreate
+}
+
+type IndexConstituentCreate struct {
+	SecurityID int
+	Weight     *float64
+}
+
+type marketIndexService struct {
+	auditEventService     AuditEventService
+	securityStore         stores.SecurityStore
+	indexConstituentStore stores.IndexConstituentStore
+	store                 stores.MarketIndexStore
+}
+
+func NewMarketIndexService(auditEventService AuditEventService, securityStore stores.SecurityStore,
+	indexConstituentStore stores.IndexConstituentStore, store stores.MarketIndexStore) *marketIndexService {
+	return &marketIndexService{
+		auditEventService:     auditEventService,
+		securityStore:         securityStore,
+		indexConstituentStore: indexConstituentStore,
+		store:                 store,
+	}
+}
+
+func (s *marketIndexService) Index(ctx *gofr.Context, f *MarketIndexFilter, page, perPage int) ([]*MarketIndex, int, error) {
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, 0, err
+	}
+
+	limit := perPage
+	offset := limit * (page - 1)
+
+	filter := &stores.MarketIndexFilter{Name: f.Name, Symbol: f.Symbol}
+
+	marketIndices, err := s.store.Index(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.store.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var resp = make([]*MarketIndex, len(marketIndices))
+
+	for i := range marketIndices {
+		resp[i] = s.buildResp(marketIndices[i])
+	}
+
+	return resp, count, nil
+}
+
+func (s *marketIndexService) Read(ctx *gofr.Context, id int) (*MarketIndex, error) {
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, err
+	}
+
+	marketIndex, err := s.store.Retrieve(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(marketIndex), nil
+}
+
+func (s *marketIndexService) Create(ctx *gofr.Context, payload *MarketIndexCreate) (*MarketIndex, error) {
+	if err := authorize(ctx, auth.WriteIndices); err != nil {
+		return nil, err
+	}
+
+	if err := s.validate(ctx, 0, payload.Name, payload.Symbol); err != nil {
+		return nil, err
+	}
+
+	model := &stores.MarketIndex{
+		Name:      payload.Name,
+		Symbol:    payload.Symbol,
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	var (
+		marketIndex *stores.MarketIndex
+		err         error
+	)
+
+	err = stores.InTx(ctx, func() error {
+		if marketIndex, err = s.store.Create(ctx, model); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "indices", marketIndex.ID, AuditActionCreate, nil, marketIndex)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(marketIndex), nil
+}
+
+func (s *marketIndexService) Patch(ctx *gofr.Context, id int, payload *MarketIndexUpdate) (*MarketIndex, error) {
+	if err := authorize(ctx, auth.WriteIndices); err != nil {
+		return nil, err
+	}
+
+	marketIndex, err := s.store.Retrieve(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *marketIndex
+
+	if payload.Name != "" {
+		marketIndex.Name = payload.Name
+	}
+
+	if payload.Symbol != "" {
+		marketIndex.Symbol = payload.Symbol
+	}
+
+	if err = s.validate(ctx, id, marketIndex.Name, marketIndex.Symbol); err != nil {
+		return nil, err
+	}
+
+	marketIndex.UpdatedAt = time.Now().UTC()
+
+	err = stores.InTx(ctx, func() error {
+		if marketIndex, err = s.store.Update(ctx, id, marketIndex); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "indices", marketIndex.ID, AuditActionUpdate, &before, marketIndex)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(marketIndex), nil
+}
+
+func (s *marketIndexService) Delete(ctx *gofr.Context, id int) error {
+	if err := authorize(ctx, auth.WriteIndices); err != nil {
+		return err
+	}
+
+	marketIndex, err := s.store.Retrieve(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return stores.InTx(ctx, func() error {
+		if err := s.store.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "indices", id, AuditActionDelete, marketIndex, nil)
+	})
+}
+
+// Constituents lists the members of an index as of date, today when date is zero.
+func (s *marketIndexService) Constituents(ctx *gofr.Context, id int, date time.Time) ([]*IndexConstituent, error) {
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.store.Retrieve(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if date.IsZero() {
+		date = time.Now().UTC()
+	}
+
+	constituents, err := s.indexConstituentStore.Index(ctx, &stores.IndexConstituentFilter{IndexID: id, Date: date}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildConstituentsResp(ctx, constituents)
+}
+
+// ReplaceConstituents records a new constituent list effective from EffectiveFrom, closing the one it replaces. Resubmitting the latest
+// list corrects it in place, while lists older than the latest are rejected as the history is append-only.
+func (s *marketIndexService) ReplaceConstituents(ctx *gofr.Context, id int, payload *IndexConstituentsReplace) ([]*IndexConstituent, error) {
+	if err := authorize(ctx, auth.WriteIndices); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.store.Retrieve(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateConstituents(ctx, payload.Constituents); err != nil {
+		return nil, err
+	}
+
+	effectiveFrom := payload.EffectiveFrom
+	if effectiveFrom.IsZero() {
+		effectiveFrom = time.Now().UTC().Truncate(24 * time.Hour)
+	}
+
+	latest, err := s.indexConstituentStore.LatestEffectiveFrom(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := s.indexConstituentStore.Index(ctx, &stores.IndexConstituentFilter{IndexID: id, Date: effectiveFrom}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if latest != nil && effectiveFrom.Before(*latest) {
+		return nil, &ErrResp{Code: 409, Message: "effectiveFrom must not be before " + latest.Format(time.DateOnly) +
+			", the start of the current constituents"}
+	}
+
+	var constituents []*stores.IndexConstituent
+
+	err = stores.InTx(ctx, func() error {
+		switch {
+		case latest == nil:
+		case effectiveFrom.Equal(*latest):
+			err = s.indexConstituentStore.DeleteSnapshot(ctx, id, effectiveFrom)
+		default:
+			err = s.indexConstituentStore.Close(ctx, id, effectiveFrom)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, c := range payload.Constituents {
+			err = s.indexConstituentStore.Create(ctx, &stores.IndexConstituent{
+				IndexID:       id,
+				SecurityID:    c.SecurityID,
+				Weight:        c.Weight,
+				EffectiveFrom: effectiveFrom,
+				CreatedAt:     time.Now().UTC(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		constituents, err = s.indexConstituentStore.Index(ctx, &stores.IndexConstituentFilter{IndexID: id, Date: effectiveFrom}, 0, 0)
+		if err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "indices", id, AuditActionUpdate, before, constituents)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildConstituentsResp(ctx, constituents)
+
+}
+
+func (s *marketIndexService) validate(ctx *gofr.Context, id int, name, symbol string) error {
+	if name == "" || len(name) > marketIndexNameMaxLength {
+		return &ErrResp{Code: 400, Message: fmt.Sprintf("name must be between 1 and %d characters", marketIndexNameMaxLength)}
+	}
+
+	if symbol == "" || len(symbol) > marketIndexSymbolMaxLength {
+		return &ErrResp{Code: 400, Message: fmt.Sprintf("symbol must be between 1 and %d characters", marketIndexSymbolMaxLength)}
+	}
+
+	existing, err := s.store.Index(ctx, &stores.MarketIndexFilter{Name: name}, 1, 0)
+	if err != nil {
+		return err
+	}
+
+	if len(existing) > 0 && existing[0].ID != id {
+		return &ErrResp{Code: 409, Message: "an index named " + name + " already exists"}
+	}
+
+	existing, err = s.store.Index(ctx, &stores.MarketIndexFilter{Symbol: symbol}, 1, 0)
+	if err != nil {
+		return err
+	}
+
+	if len(existing) > 0 && existing[0].ID != id {
+		return &ErrResp{Code: 409, Message: "an index with symbol " + symbol + " already exists"}
+	}
+
+	return nil
+}
+
+// validateConstituents requires weights to be given for either all or none of the constituents, and to add up to 100 when given.
+func (s *marketIndexService) validateConstituents(ctx *gofr.Context, constituents []*IndexConstituentCreate) error {
+	if len(constituents) == 0 {
+		return http.ErrorMissingParam{Params: []string{"constituents"}}
+	}
+
+	var (
+		securityIDs []int
+		weighted    int
+		total       float64
+	)
+
+	for _, c := range constituents {
+		if slices.Contains(securityIDs, c.SecurityID) {
+			return &ErrResp{Code: 400, Message: "duplicate securityId - " + strconv.Itoa(c.SecurityID)}
+		}
+
+		securityIDs = append(securityIDs, c.SecurityID)
+
+		if c.Weight == nil {
+			continue
+		}
+
+		if *c.Weight <= 0 || *c.Weight > 100 {
+			return &ErrResp{Code: 400, Message: "weight must be greater than 0 and at most 100 for securityId - " + strconv.Itoa(c.SecurityID)}
+		}
+
+		weighted++
+		total += *c.Weight
+	}
+
+	if weighted != 0 && weighted != len(constituents) {
+		return &ErrResp{Code: 400, Message: "weights must be given for all constituents or none"}
+	}
+
+	if weighted != 0 && math.Abs(total-100) > marketIndexWeightTolerance {
+		return &ErrResp{Code: 400, Message: fmt.Sprintf("weights must add up to 100, got %.4f", total)}
+	}
+
+	securities, err := s.securityStore.Index(ctx, &stores.SecurityFilter{IDs: securityIDs}, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, securityID := range securityIDs {
+		if !slices.ContainsFunc(securities, func(security *stores.Security) bool { return security.ID == securityID }) {
+			return http.ErrorEntityNotFound{Name: "securities", Value: strconv.Itoa(securityID)}
+		}
+	}
+
+	return nil
+}
+
+func (s *marketIndexService) buildConstituentsResp(ctx *gofr.Context, constituents []*stores.IndexConstituent) ([]*IndexConstituent, error) {
+	if len(constituents) == 0 {
+		return nil, nil
+	}
+
+	var securityIDs = make([]int, len(constituents))
+
+	for i := range constituents {
+		securityIDs[i] = constituents[i].SecurityID
+	}
+
+	securities, err := s.securityStore.Index(ctx, &stores.SecurityFilter{IDs: securityIDs}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var symbols = make(map[int]string, len(securities))
+
+	for _, security := range securities {
+		symbols[security.ID] = security.Symbol
+	}
+
+	var resp = make([]*IndexConstituent, len(constituents))
+
+	for i, c := range constituents {
+		resp[i] = &IndexConstituent{
+			SecurityID:    c.SecurityID,
+			Symbol:        symbols[c.SecurityID],
+			Weight:        c.Weight,
+			EffectiveFrom: c.EffectiveFrom,
+			EffectiveTo:   c.EffectiveTo,
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *marketIndexService) buildResp(marketIndex *stores.MarketIndex) *MarketIndex {
+	return &MarketIndex{
+		ID:        marketIndex.ID,
+		Name:      marketIndex.Name,
+		Symbol:    marketIndex.Symbol,
+		CreatedAt: marketIndex.CreatedAt,
+		UpdatedAt: marketIndex.UpdatedAt,
+	}
+}
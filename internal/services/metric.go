@@ -1,13 +1,11 @@
 package services
 
 import (
-	"encoding/json"
-	"fmt"
-	"io"
 	"time"
 
 	"gofr.dev/pkg/gofr"
 
+	"github.com/stratifyr/security-service/internal/auth"
 	"github.com/stratifyr/security-service/internal/stores"
 )
 
@@ -19,9 +17,9 @@ type MetricService interface {
 }
 
 type MetricFilter struct {
-	UserID int
-	Type   string
-	Period int
+	Type           string
+	Period         int
+	InstrumentType string
 }
 
 type Metric struct {
@@ -36,7 +34,6 @@ type Metric struct {
 }
 
 type MetricCreate struct {
-	UserID int
 	Name   string
 	Type   string
 	Period int
@@ -44,29 +41,52 @@ type MetricCreate struct {
 }
 
 type MetricUpdate struct {
-	UserID int
-	Name   string
-	Tier   *int
+	Name string
+	Tier *int
 }
 
 var MetricTypeIndicator = map[stores.MetricType]stores.MetricIndicator{
-	stores.SMA: stores.Trend,
-	stores.EMA: stores.Trend,
-	stores.RSI: stores.Momentum,
-	stores.ROC: stores.Momentum,
-	stores.ATR: stores.Volatility,
-	stores.VMA: stores.Volume,
+	stores.SMA:  stores.Trend,
+	stores.EMA:  stores.Trend,
+	stores.RSI:  stores.Momentum,
+	stores.ROC:  stores.Momentum,
+	stores.ATR:  stores.Volatility,
+	stores.VMA:  stores.Volume,
+	stores.PE:   stores.Fundamental,
+	stores.PB:   stores.Fundamental,
+	stores.DY:   stores.Fundamental,
+	stores.MCAP: stores.Fundamental,
+}
+
+// InstrumentTypeExcludedIndicators lists the indicators that carry no meaning for an instrument type, indices have no traded volume and
+// only companies report fundamentals.
+var InstrumentTypeExcludedIndicators = map[stores.InstrumentType][]stores.MetricIndicator{
+	stores.ETF:    {stores.Fundamental},
+	stores.Index:  {stores.Volume, stores.Fundamental},
+	stores.Bond:   {stores.Fundamental},
+	stores.Gold:   {stores.Fundamental},
+	stores.Silver: {stores.Fundamental},
 }
 
 type metricService struct {
-	store stores.MetricStore
+	entitlementService EntitlementService
+	auditEventService  AuditEventService
+	store              stores.MetricStore
 }
 
-func NewMetricService(store stores.MetricStore) *metricService {
-	return &metricService{store: store}
+func NewMetricService(entitlementService EntitlementService, auditEventService AuditEventService, store stores.MetricStore) *metricService {
+	return &metricService{
+		entitlementService: entitlementService,
+		auditEventService:  auditEventService,
+		store:              store,
+	}
 }
 
 func (s *metricService) Index(ctx *gofr.Context, f *MetricFilter, page, perPage int) ([]*Metric, int, error) {
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, 0, err
+	}
+
 	limit := perPage
 	offset := limit * (page - 1)
 
@@ -85,15 +105,22 @@ func (s *metricService) Index(ctx *gofr.Context, f *MetricFilter, page, perPage
 		filter.Type = &metricType
 	}
 
-	if f.UserID != 0 {
-		userTier, err := s.getUserTier(ctx, f.UserID)
+	if f.InstrumentType != "" {
+		instrumentType, err := stores.InstrumentTypeFromString(f.InstrumentType)
 		if err != nil {
 			return nil, 0, err
 		}
 
-		filter.MaxTier = &userTier
+		filter.ExcludedIndicators = InstrumentTypeExcludedIndicators[instrumentType]
+	}
+
+	entitlement, err := s.entitlementService.Resolve(ctx)
+	if err != nil {
+		return nil, 0, err
 	}
 
+	filter.MaxTier = entitlement.Tier
+
 	metrics, err := s.store.Index(ctx, filter, limit, offset)
 	if err != nil {
 		return nil, 0, err
@@ -118,6 +145,10 @@ func (s *metricService) Index(ctx *gofr.Context, f *MetricFilter, page, perPage
 }
 
 func (s *metricService) Read(ctx *gofr.Context, id int) (*Metric, error) {
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, err
+	}
+
 	metric, err := s.store.Retrieve(ctx, id)
 	if err != nil {
 		return nil, err
@@ -127,8 +158,8 @@ func (s *metricService) Read(ctx *gofr.Context, id int) (*Metric, error) {
 }
 
 func (s *metricService) Create(ctx *gofr.Context, payload *MetricCreate) (*Metric, error) {
-	if payload.UserID != 1 {
-		return nil, &ErrResp{Code: 403, Message: "Forbidden"}
+	if err := authorize(ctx, auth.WriteMetrics); err != nil {
+		return nil, err
 	}
 
 	metricType, err := stores.MetricTypeFromString(payload.Type)
@@ -146,7 +177,15 @@ func (s *metricService) Create(ctx *gofr.Context, payload *MetricCreate) (*Metri
 		UpdatedAt: time.Now().UTC(),
 	}
 
-	metric, err := s.store.Create(ctx, model)
+	var metric *stores.Metric
+
+	err = stores.InTx(ctx, func() error {
+		if metric, err = s.store.Create(ctx, model); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "metrics", metric.ID, AuditActionCreate, nil, metric)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -155,8 +194,8 @@ func (s *metricService) Create(ctx *gofr.Context, payload *MetricCreate) (*Metri
 }
 
 func (s *metricService) Patch(ctx *gofr.Context, id int, payload *MetricUpdate) (*Metric, error) {
-	if payload.UserID != 1 {
-		return nil, &ErrResp{Code: 403, Message: "Forbidden"}
+	if err := authorize(ctx, auth.WriteMetrics); err != nil {
+		return nil, err
 	}
 
 	metric, err := s.store.Retrieve(ctx, id)
@@ -164,6 +203,8 @@ func (s *metricService) Patch(ctx *gofr.Context, id int, payload *MetricUpdate)
 		return nil, err
 	}
 
+	before := *metric
+
 	if payload.Name != "" {
 		metric.Name = payload.Name
 	}
@@ -172,7 +213,13 @@ func (s *metricService) Patch(ctx *gofr.Context, id int, payload *MetricUpdate)
 		metric.Tier = *payload.Tier
 	}
 
-	metric, err = s.store.Update(ctx, id, metric)
+	err = stores.InTx(ctx, func() error {
+		if metric, err = s.store.Update(ctx, id, metric); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "metrics", metric.ID, AuditActionUpdate, &before, metric)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -180,53 +227,6 @@ func (s *metricService) Patch(ctx *gofr.Context, id int, payload *MetricUpdate)
 	return s.buildResp(metric), nil
 }
 
-func (s *metricService) getUserTier(ctx *gofr.Context, userID int) (int, error) {
-	httpService := ctx.GetHTTPService("account-service")
-
-	resp, err := httpService.Get(ctx, fmt.Sprintf("users/%d", userID), nil)
-	if err != nil {
-		ctx.Logger.Errorf("failed GET /account-service/users/{id}, %v", map[string]interface{}{
-			"err":    err.Error(),
-			"userId": userID,
-		})
-
-		return 0, &ErrResp{Code: 503, Message: "something went wrong!"}
-	}
-
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 200 {
-		body, _ := io.ReadAll(resp.Body)
-
-		ctx.Logger.Errorf("non 200 resp GET /account-service/users/{id}, %v", map[string]interface{}{
-			"code": resp.StatusCode,
-			"resp": string(body),
-		})
-
-		return 0, &ErrResp{Code: 503, Message: "something went wrong!"}
-	}
-
-	var res struct {
-		Data *struct {
-			Plan *struct {
-				Tier int `json:"tier"`
-			} `json:"plan"`
-		} `json:"data"`
-	}
-
-	err = json.NewDecoder(resp.Body).Decode(&res)
-	if err != nil {
-		ctx.Logger.Error("unexpected response from GET /account-service/users/{id}, %v", map[string]interface{}{
-			"resp":         fmt.Sprintf("%s", resp.Body),
-			"unmarshalErr": err,
-		})
-
-		return 0, &ErrResp{Code: 503, Message: "something went wrong!"}
-	}
-
-	return res.Data.Plan.Tier, nil
-}
-
 func (s *metricService) buildResp(model *stores.Metric) *Metric {
 	resp := &Metric{
 		ID:        model.ID,
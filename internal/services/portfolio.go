@@ -0,0 +1,472 @@
+package services
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+
+	"github.com/stratifyr/security-service/internal/auth"
+	"github.com/stratifyr/security-service/internal/stores"
+)
+
+const (
+	PortfolioTransactionBuy  = "buy"
+	PortfolioTransactionSell = "sell"
+)
+
+const (
+	portfolioMaxPerUser    = 20
+	portfolioNameMaxLength = 100
+)
+
+type PortfolioService interface {
+	Index(ctx *gofr.Context, page, perPage int) ([]*Portfolio, int, error)
+	Read(ctx *gofr.Context, id int) (*Portfolio, error)
+	Create(ctx *gofr.Context, payload *PortfolioCreate) (*Portfolio, error)
+	Patch(ctx *gofr.Context, id int, payload *PortfolioUpdate) (*Portfolio, error)
+	Delete(ctx *gofr.Context, id int) error
+	IndexTransactions(ctx *gofr.Context, id int, f *PortfolioTransactionFilter, page, perPage int) ([]*PortfolioTransaction, int, error)
+	CreateTransaction(ctx *gofr.Context, id int, payload *PortfolioTransactionCreate) (*PortfolioTransaction, error)
+	DeleteTransaction(ctx *gofr.Context, id, transactionID int) error
+	Holdings(ctx *gofr.Context, id int) ([]*PortfolioHolding, error)
+	Summary(ctx *gofr.Context, id int) (*PortfolioSummary, error)
+	Valuations(ctx *gofr.Context, id int, f *PortfolioValuationFilter) ([]*PortfolioValuation, error)
+}
+
+type Portfolio struct {
+	ID        int
+	UserID    int
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type PortfolioCreate struct {
+	Name string
+}
+
+type PortfolioUpdate struct {
+	Name string
+}
+
+type PortfolioTransactionFilter struct {
+	SecurityID int
+	Type       string
+	From       time.Time
+	To         time.Time
+}
+
+type PortfolioTransaction struct {
+	ID          int
+	PortfolioID int
+	SecurityID  int
+	Type        string
+	Quantity    float64
+	Price       float64
+	Date        time.Time
+	CreatedAt   time.Time
+}
+
+type PortfolioTransactionCreate struct {
+	SecurityID int
+	Type       string
+	Quantity   float64
+	Price      float64
+	Date       time.Time
+}
+
+type portfolioService struct {
+	entitlementService        EntitlementService
+	auditEventService         AuditEventService
+	marketDayService          MarketDayService
+	securityService           SecurityService
+	industryStore             stores.IndustryStore
+	securityStore             stores.SecurityStore
+	securityStatStore         stores.SecurityStatStore
+	portfolioTransactionStore stores.PortfolioTransactionStore
+	store                     stores.PortfolioStore
+}
+
+func NewPortfolioService(entitlementService EntitlementService, auditEventService AuditEventService, marketDayService MarketDayService,
+	securityService SecurityService, industryStore stores.IndustryStore, securityStore stores.SecurityStore, securityStatStore stores.SecurityStatStore,
+	portfolioTransactionStore stores.PortfolioTransactionStore, store stores.PortfolioStore) *portfolioService {
+	return &portfolioService{
+		entitlementService:        entitlementService,
+		auditEventService:         auditEventService,
+		marketDayService:          marketDayService,
+		securityService:           securityService,
+		industryStore:             industryStore,
+		securityStore:             securityStore,
+		securityStatStore:         securityStatStore,
+		portfolioTransactionStore: portfolioTransactionStore,
+		store:                     store,
+	}
+}
+
+func (s *portfolioService) Index(ctx *gofr.Context, page, perPage int) ([]*Portfolio, int, error) {
+	if err := authorize(ctx, auth.WritePortfolios); err != nil {
+		return nil, 0, err
+	}
+
+	limit := perPage
+	offset := limit * (page - 1)
+
+	filter := &stores.PortfolioFilter{UserID: auth.PrincipalFromContext(ctx).UserID}
+
+	if filter.UserID == 0 {
+		return nil, 0, nil
+	}
+
+	portfolios, err := s.store.Index(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.store.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if count == 0 {
+		return nil, 0, nil
+	}
+
+	var resp = make([]*Portfolio, len(portfolios))
+
+	for i := range portfolios {
+		resp[i] = s.buildResp(portfolios[i])
+	}
+
+	return resp, count, nil
+}
+
+func (s *portfolioService) Read(ctx *gofr.Context, id int) (*Portfolio, error) {
+	portfolio, err := s.retrieveOwned(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(portfolio), nil
+}
+
+func (s *portfolioService) Create(ctx *gofr.Context, payload *PortfolioCreate) (*Portfolio, error) {
+	if err := authorize(ctx, auth.WritePortfolios); err != nil {
+		return nil, err
+	}
+
+	principal := auth.PrincipalFromContext(ctx)
+	if principal.UserID == 0 {
+		return nil, &ErrResp{Code: 403, Message: "portfolios can only be created by user accounts"}
+	}
+
+	if err := s.validateName(ctx, principal.UserID, 0, payload.Name); err != nil {
+		return nil, err
+	}
+
+	count, err := s.store.Count(ctx, &stores.PortfolioFilter{UserID: principal.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	if count >= portfolioMaxPerUser {
+		return nil, &ErrResp{Code: 400, Message: fmt.Sprintf("at most %d portfolios are allowed per user", portfolioMaxPerUser)}
+	}
+
+	model := &stores.Portfolio{
+		UserID:    principal.UserID,
+		Name:      payload.Name,
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	var portfolio *stores.Portfolio
+
+	err = stores.InTx(ctx, func() error {
+		if portfolio, err = s.store.Create(ctx, model); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "portfolios", portfolio.ID, AuditActionCreate, nil, portfolio)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(portfolio), nil
+}
+
+func (s *portfolioService) Patch(ctx *gofr.Context, id int, payload *PortfolioUpdate) (*Portfolio, error) {
+	portfolio, err := s.retrieveOwned(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *portfolio
+
+	if payload.Name != "" && payload.Name != portfolio.Name {
+		if err = s.validateName(ctx, portfolio.UserID, portfolio.ID, payload.Name); err != nil {
+			return nil, err
+		}
+
+		portfolio.Name = payload.Name
+	}
+
+	portfolio.UpdatedAt = time.Now().UTC()
+
+	err = stores.InTx(ctx, func() error {
+		if portfolio, err = s.store.Update(ctx, id, portfolio); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "portfolios", portfolio.ID, AuditActionUpdate, &before, portfolio)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(portfolio), nil
+}
+
+func (s *portfolioService) Delete(ctx *gofr.Context, id int) error {
+	portfolio, err := s.retrieveOwned(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return stores.InTx(ctx, func() error {
+		if err := s.store.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "portfolios", id, AuditActionDelete, portfolio, nil)
+	})
+}
+
+func (s *portfolioService) IndexTransactions(ctx *gofr.Context, id int, f *PortfolioTransactionFilter, page, perPage int) ([]*PortfolioTransaction, int, error) {
+	if _, err := s.retrieveOwned(ctx, id); err != nil {
+		return nil, 0, err
+	}
+
+	limit := perPage
+	offset := limit * (page - 1)
+
+	filter := &stores.PortfolioTransactionFilter{
+		PortfolioID: id,
+		SecurityID:  f.SecurityID,
+		Type:        f.Type,
+		MinDate:     f.From,
+		MaxDate:     f.To,
+	}
+
+	portfolioTransactions, err := s.portfolioTransactionStore.Index(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.portfolioTransactionStore.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if count == 0 {
+		return nil, 0, nil
+	}
+
+	var resp = make([]*PortfolioTransaction, len(portfolioTransactions))
+
+	for i := range portfolioTransactions {
+		resp[i] = s.buildTransactionResp(portfolioTransactions[i])
+	}
+
+	return resp, count, nil
+}
+
+func (s *portfolioService) CreateTransaction(ctx *gofr.Context, id int, payload *PortfolioTransactionCreate) (*PortfolioTransaction, error) {
+	portfolio, err := s.retrieveOwned(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.Type != PortfolioTransactionBuy && payload.Type != PortfolioTransactionSell {
+		return nil, &ErrResp{Code: 400, Message: "invalid type - " + payload.Type}
+	}
+
+	if payload.Quantity <= 0 {
+		return nil, &ErrResp{Code: 400, Message: "quantity must be positive"}
+	}
+
+	if payload.Price <= 0 {
+		return nil, &ErrResp{Code: 400, Message: "price must be positive"}
+	}
+
+	if payload.Date.IsZero() || payload.Date.After(time.Now().UTC()) {
+		return nil, &ErrResp{Code: 400, Message: "date is required and cannot be in the future"}
+	}
+
+	security, err := s.securityStore.Retrieve(ctx, payload.SecurityID)
+	if err != nil {
+		return nil, err
+	}
+
+	entitlement, err := s.entitlementService.Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if entitlement.Tier != nil && security.Tier > *entitlement.Tier {
+		return nil, &ErrResp{Code: 403, Message: "security is not available on your plan"}
+	}
+
+	model := &stores.PortfolioTransaction{
+		PortfolioID: portfolio.ID,
+		SecurityID:  payload.SecurityID,
+		Type:        payload.Type,
+		Quantity:    payload.Quantity,
+		Price:       payload.Price,
+		Date:        payload.Date,
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	if payload.Type == PortfolioTransactionSell {
+		transactions, err := s.portfolioTransactionStore.Index(ctx,
+			&stores.PortfolioTransactionFilter{PortfolioID: portfolio.ID, SecurityID: payload.SecurityID}, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		i, _ := slices.BinarySearchFunc(transactions, model.Date, func(t *stores.PortfolioTransaction, d time.Time) int {
+			if t.Date.After(d) {
+				return 1
+			}
+
+			return -1
+		})
+
+		if _, err = replayPortfolio(slices.Insert(transactions, i, model)); err != nil {
+			return nil, err
+		}
+	}
+
+	var portfolioTransaction *stores.PortfolioTransaction
+
+	err = stores.InTx(ctx, func() error {
+		if portfolioTransaction, err = s.portfolioTransactionStore.Create(ctx, model); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "portfolio-transactions", portfolioTransaction.ID, AuditActionCreate, nil, portfolioTransaction)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildTransactionResp(portfolioTransaction), nil
+}
+
+func (s *portfolioService) DeleteTransaction(ctx *gofr.Context, id, transactionID int) error {
+	if _, err := s.retrieveOwned(ctx, id); err != nil {
+		return err
+	}
+
+	portfolioTransaction, err := s.portfolioTransactionStore.Retrieve(ctx, transactionID)
+	if err != nil {
+		return err
+	}
+
+	if portfolioTransaction.PortfolioID != id {
+		return http.ErrorEntityNotFound{Name: "portfolio-transactions", Value: strconv.Itoa(transactionID)}
+	}
+
+	if portfolioTransaction.Type == PortfolioTransactionBuy {
+		transactions, err := s.portfolioTransactionStore.Index(ctx,
+			&stores.PortfolioTransactionFilter{PortfolioID: id, SecurityID: portfolioTransaction.SecurityID}, 0, 0)
+		if err != nil {
+			return err
+		}
+
+		transactions = slices.DeleteFunc(transactions, func(t *stores.PortfolioTransaction) bool { return t.ID == transactionID })
+
+		if _, err = replayPortfolio(transactions); err != nil {
+			return &ErrResp{Code: 400, Message: "cannot delete a buy that later sells depend on"}
+		}
+	}
+
+	return stores.InTx(ctx, func() error {
+		if err := s.portfolioTransactionStore.Delete(ctx, transactionID); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "portfolio-transactions", transactionID, AuditActionDelete, portfolioTransaction, nil)
+	})
+}
+
+func (s *portfolioService) retrieveOwned(ctx *gofr.Context, id int) (*stores.Portfolio, error) {
+	if err := authorize(ctx, auth.WritePortfolios); err != nil {
+		return nil, err
+	}
+
+	portfolio, err := s.store.Retrieve(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if portfolio.UserID != auth.PrincipalFromContext(ctx).UserID {
+		return nil, http.ErrorEntityNotFound{Name: "portfolios", Value: strconv.Itoa(id)}
+	}
+
+	return portfolio, nil
+}
+
+func (s *portfolioService) validateName(ctx *gofr.Context, userID, id int, name string) error {
+	if name == "" {
+		return &ErrResp{Code: 400, Message: "name is required"}
+	}
+
+	if len(name) > portfolioNameMaxLength {
+		return &ErrResp{Code: 400, Message: fmt.Sprintf("name must be at most %d characters", portfolioNameMaxLength)}
+	}
+
+	portfolios, err := s.store.Index(ctx, &stores.PortfolioFilter{UserID: userID}, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range portfolios {
+		if p.ID != id && p.Name == name {
+			return &ErrResp{Code: 409, Message: "a portfolio named " + name + " already exists"}
+		}
+	}
+
+	return nil
+}
+
+func (s *portfolioService) buildResp(model *stores.Portfolio) *Portfolio {
+	resp := &Portfolio{
+		ID:        model.ID,
+		UserID:    model.UserID,
+		Name:      model.Name,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+
+	return resp
+}
+
+func (s *portfolioService) buildTransactionResp(model *stores.PortfolioTransaction) *PortfolioTransaction {
+	resp := &PortfolioTransaction{
+		ID:          model.ID,
+		PortfolioID: model.PortfolioID,
+		SecurityID:  model.SecurityID,
+		Type:        model.Type,
+		Quantity:    model.Quantity,
+		Price:       model.Price,
+		Date:        model.Date,
+		CreatedAt:   model.CreatedAt,
+	}
+
+	return resp
+}
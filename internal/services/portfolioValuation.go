@@ -0,0 +1,514 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/stratifyr/security-service/internal/stores"
+)
+
+const (
+	portfolioQuantityEpsilon   = 1e-9
+	portfolioValuationLookback = 30 * 24 * time.Hour
+	portfolioXIRRMaxIterations = 100
+	portfolioXIRRTolerance     = 1e-9
+)
+
+type PortfolioHolding struct {
+	SecurityID    int
+	Symbol        string
+	Name          string
+	Industry      string
+	Quantity      float64
+	AverageCost   float64
+	Invested      float64
+	LTP           float64
+	LTPDelay      time.Duration
+	PreviousClose float64
+	Value         float64
+	DayChange     float64
+	UnrealizedPnL float64
+	RealizedPnL   float64
+	// Priced is false when the security is no longer visible on the user's plan, the holding is then valued at cost.
+	Priced bool
+}
+
+type PortfolioSummary struct {
+	Invested      float64
+	Value         float64
+	DayChange     float64
+	UnrealizedPnL float64
+	RealizedPnL   float64
+	XIRR          *float64
+	Allocation    []*PortfolioAllocation
+}
+
+type PortfolioAllocation struct {
+	Industry string
+	Value    float64
+	Weight   float64
+}
+
+type PortfolioValuationFilter struct {
+	From time.Time
+	To   time.Time
+}
+
+type PortfolioValuation struct {
+	Date          time.Time
+	Invested      float64
+	Value         float64
+	UnrealizedPnL float64
+	RealizedPnL   float64
+}
+
+type portfolioLot struct {
+	quantity float64
+	price    float64
+}
+
+type portfolioPosition struct {
+	lots     []portfolioLot
+	realized float64
+}
+
+type portfolioCashFlow struct {
+	date   time.Time
+	amount float64
+}
+
+func (s *portfolioService) Holdings(ctx *gofr.Context, id int) ([]*PortfolioHolding, error) {
+	if _, err := s.retrieveOwned(ctx, id); err != nil {
+		return nil, err
+	}
+
+	holdings, _, _, err := s.holdings(ctx, id)
+
+	return holdings, err
+}
+
+func (s *portfolioService) Summary(ctx *gofr.Context, id int) (*PortfolioSummary, error) {
+	if _, err := s.retrieveOwned(ctx, id); err != nil {
+		return nil, err
+	}
+
+	holdings, positions, transactions, err := s.holdings(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &PortfolioSummary{Allocation: []*PortfolioAllocation{}}
+
+	var allocationMap = make(map[string]*PortfolioAllocation)
+
+	for _, holding := range holdings {
+		resp.Invested += holding.Invested
+		resp.Value += holding.Value
+		resp.DayChange += holding.DayChange
+		resp.UnrealizedPnL += holding.UnrealizedPnL
+
+		allocation, ok := allocationMap[holding.Industry]
+		if !ok {
+			allocation = &PortfolioAllocation{Industry: holding.Industry}
+			allocationMap[holding.Industry] = allocation
+			resp.Allocation = append(resp.Allocation, allocation)
+		}
+
+		allocation.Value += holding.Value
+	}
+
+	for _, position := range positions {
+		resp.RealizedPnL += position.realized
+	}
+
+	for _, allocation := range resp.Allocation {
+		if resp.Value > 0 {
+			allocation.Weight = allocation.Value / resp.Value
+		}
+	}
+
+	sort.Slice(resp.Allocation, func(i, j int) bool { return resp.Allocation[i].Value > resp.Allocation[j].Value })
+
+	var flows = make([]portfolioCashFlow, 0, len(transactions)+1)
+
+	for _, t := range transactions {
+		amount := t.Quantity * t.Price
+		if t.Type == PortfolioTransactionBuy {
+			amount = -amount
+		}
+
+		flows = append(flows, portfolioCashFlow{date: t.Date, amount: amount})
+	}
+
+	if resp.Value > 0 {
+		flows = append(flows, portfolioCashFlow{date: time.Now().UTC(), amount: resp.Value})
+	}
+
+	resp.XIRR = xirr(flows)
+
+	return resp, nil
+}
+
+// Valuations marks the portfolio to market at each market day's close, securities without a close yet are carried at cost.
+func (s *portfolioService) Valuations(ctx *gofr.Context, id int, f *PortfolioValuationFilter) ([]*PortfolioValuation, error) {
+	if _, err := s.retrieveOwned(ctx, id); err != nil {
+		return nil, err
+	}
+
+	to := f.To
+	if to.IsZero() {
+		to = time.Now().UTC().Truncate(24 * time.Hour)
+	}
+
+	from := f.From
+	if from.IsZero() {
+		from = to.Add(-portfolioValuationLookback)
+	}
+
+	if from.After(to) {
+		return nil, &ErrResp{Code: 400, Message: "from cannot be after to"}
+	}
+
+	entitlement, err := s.entitlementService.Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := entitlement.CheckDate(from); err != nil {
+		return nil, err
+	}
+
+	marketDays, _, err := s.marketDayService.Index(ctx, &MarketDayFilter{DateBetween: &struct {
+		StartDate time.Time
+		EndDate   time.Time
+	}{StartDate: from, EndDate: to}})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(marketDays) == 0 {
+		return []*PortfolioValuation{}, nil
+	}
+
+	slices.SortFunc(marketDays, func(a, b time.Time) int { return a.Compare(b) })
+
+	transactions, err := s.portfolioTransactionStore.Index(ctx, &stores.PortfolioTransactionFilter{PortfolioID: id, MaxDate: to}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var securityIDs []int
+
+	for _, t := range transactions {
+		if !slices.Contains(securityIDs, t.SecurityID) {
+			securityIDs = append(securityIDs, t.SecurityID)
+		}
+	}
+
+	var closeMap = make(map[string]map[int]float64)
+
+	if len(securityIDs) > 0 {
+		securityStats, err := s.securityStatStore.Index(ctx, &stores.SecurityStatFilter{SecurityIDs: securityIDs, Dates: marketDays}, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ss := range securityStats {
+			date := ss.Date.Format(time.DateOnly)
+
+			if closeMap[date] == nil {
+				closeMap[date] = make(map[int]float64)
+			}
+
+			closeMap[date][ss.SecurityID] = ss.Close
+		}
+	}
+
+	var (
+		resp      = make([]*PortfolioValuation, len(marketDays))
+		positions = make(map[int]*portfolioPosition)
+		lastClose = make(map[int]float64)
+		next      int
+	)
+
+	for i, day := range marketDays {
+		date := day.Format(time.DateOnly)
+
+		for ; next < len(transactions) && transactions[next].Date.Format(time.DateOnly) <= date; next++ {
+			if err = applyPortfolioTransaction(positions, transactions[next]); err != nil {
+				return nil, err
+			}
+		}
+
+		for securityID, c := range closeMap[date] {
+			lastClose[securityID] = c
+		}
+
+		valuation := &PortfolioValuation{Date: day}
+
+		for securityID, position := range positions {
+			quantity, invested := position.quantity(), position.invested()
+
+			value := invested
+			if c, ok := lastClose[securityID]; ok {
+				value = quantity * c
+			}
+
+			valuation.Invested += invested
+			valuation.Value += value
+			valuation.RealizedPnL += position.realized
+		}
+
+		valuation.UnrealizedPnL = valuation.Value - valuation.Invested
+
+		resp[i] = valuation
+	}
+
+	return resp, nil
+}
+
+func (s *portfolioService) holdings(ctx *gofr.Context, id int) ([]*PortfolioHolding, map[int]*portfolioPosition, []*stores.PortfolioTransaction, error) {
+	transactions, err := s.portfolioTransactionStore.Index(ctx, &stores.PortfolioTransactionFilter{PortfolioID: id}, 0, 0)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	positions, err := replayPortfolio(transactions)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var securityIDs []int
+
+	for securityID, position := range positions {
+		if position.quantity() > portfolioQuantityEpsilon {
+			securityIDs = append(securityIDs, securityID)
+		}
+	}
+
+	if len(securityIDs) == 0 {
+		return []*PortfolioHolding{}, positions, transactions, nil
+	}
+
+	securities, err := s.securityStore.Index(ctx, &stores.SecurityFilter{IDs: securityIDs}, 0, 0)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	priced, _, err := s.securityService.Index(ctx, &SecurityFilter{IDs: securityIDs}, 1, len(securityIDs))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	industries, err := s.industryStore.Index(ctx, &stores.IndustryFilter{}, 0, 0)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var industryNames = make(map[int]string, len(industries))
+
+	for i := range industries {
+		industryNames[industries[i].ID] = industries[i].Name
+	}
+
+	var pricedMap = make(map[int]*Security, len(priced))
+
+	for i := range priced {
+		pricedMap[priced[i].ID] = priced[i]
+	}
+
+	var resp = make([]*PortfolioHolding, 0, len(securities))
+
+	for _, security := range securities {
+		position := positions[security.ID]
+
+		holding := &PortfolioHolding{
+			SecurityID:  security.ID,
+			Symbol:      security.Symbol,
+			Name:        security.Name,
+			Quantity:    position.quantity(),
+			Invested:    position.invested(),
+			RealizedPnL: position.realized,
+		}
+
+		if security.IndustryID != nil {
+			holding.Industry = industryNames[*security.IndustryID]
+		}
+
+		holding.AverageCost = holding.Invested / holding.Quantity
+		holding.Value = holding.Invested
+
+		if p, ok := pricedMap[security.ID]; ok {
+			holding.Priced = true
+			holding.LTP = p.LTP
+			holding.LTPDelay = p.LTPDelay
+			holding.PreviousClose = p.PreviousClose
+			holding.Value = holding.Quantity * p.LTP
+
+			if p.PreviousClose != 0 {
+				holding.DayChange = holding.Quantity * (p.LTP - p.PreviousClose)
+			}
+		}
+
+		holding.UnrealizedPnL = holding.Value - holding.Invested
+
+		resp = append(resp, holding)
+	}
+
+	sort.Slice(resp, func(i, j int) bool { return resp[i].Symbol < resp[j].Symbol })
+
+	return resp, positions, transactions, nil
+}
+
+// replayPortfolio builds positions from transactions ordered by date, sells are matched against buys first in first out.
+func replayPortfolio(transactions []*stores.PortfolioTransaction) (map[int]*portfolioPosition, error) {
+	var positions = make(map[int]*portfolioPosition)
+
+	for _, t := range transactions {
+		if err := applyPortfolioTransaction(positions, t); err != nil {
+			return nil, err
+		}
+	}
+
+	return positions, nil
+}
+
+func applyPortfolioTransaction(positions map[int]*portfolioPosition, t *stores.PortfolioTransaction) error {
+	position, ok := positions[t.SecurityID]
+	if !ok {
+		position = &portfolioPosition{}
+		positions[t.SecurityID] = position
+	}
+
+	if t.Type == PortfolioTransactionBuy {
+		position.lots = append(position.lots, portfolioLot{quantity: t.Quantity, price: t.Price})
+
+		return nil
+	}
+
+	if t.Quantity > position.quantity()+portfolioQuantityEpsilon {
+		return &ErrResp{Code: 400, Message: fmt.Sprintf("sell of %g on %s exceeds the quantity held", t.Quantity, t.Date.Format(time.DateOnly))}
+	}
+
+	remaining := t.Quantity
+
+	for remaining > portfolioQuantityEpsilon && len(position.lots) > 0 {
+		matched := math.Min(remaining, position.lots[0].quantity)
+
+		position.realized += matched * (t.Price - position.lots[0].price)
+		position.lots[0].quantity -= matched
+		remaining -= matched
+
+		if position.lots[0].quantity <= portfolioQuantityEpsilon {
+			position.lots = position.lots[1:]
+		}
+	}
+
+	return nil
+}
+
+func (p *portfolioPosition) quantity() float64 {
+	var quantity float64
+
+	for _, lot := range p.lots {
+		quantity += lot.quantity
+	}
+
+	return quantity
+}
+
+func (p *portfolioPosition) invested() float64 {
+	var invested float64
+
+	for _, lot := range p.lots {
+		invested += lot.quantity * lot.price
+	}
+
+	return invested
+}
+
+// xirr solves for the annualised rate at which the cash flows net to zero, it returns nil when the flows do not
+// change sign or no rate converges.
+func xirr(flows []portfolioCashFlow) *float64 {
+	var hasInflow, hasOutflow bool
+
+	for _, flow := range flows {
+		hasInflow = hasInflow || flow.amount > 0
+		hasOutflow = hasOutflow || flow.amount < 0
+	}
+
+	if !hasInflow || !hasOutflow {
+		return nil
+	}
+
+	start := flows[0].date
+
+	for _, flow := range flows {
+		if flow.date.Before(start) {
+			start = flow.date
+		}
+	}
+
+	npv := func(rate float64) (value, derivative float64) {
+		for _, flow := range flows {
+			years := flow.date.Sub(start).Hours() / 24 / 365
+			discount := math.Pow(1+rate, years)
+
+			value += flow.amount / discount
+			derivative -= years * flow.amount / (discount * (1 + rate))
+		}
+
+		return value, derivative
+	}
+
+	rate := 0.1
+
+	for i := 0; i < portfolioXIRRMaxIterations; i++ {
+		value, derivative := npv(rate)
+		if derivative == 0 {
+			break
+		}
+
+		next := rate - value/derivative
+		if next <= -1 {
+			next = (rate - 1) / 2
+		}
+
+		if math.Abs(next-rate) < portfolioXIRRTolerance {
+			return &next
+		}
+
+		rate = next
+	}
+
+	low, high := -0.9999, 100.0
+
+	lowValue, _ := npv(low)
+	highValue, _ := npv(high)
+
+	if math.Signbit(lowValue) == math.Signbit(highValue) {
+		return nil
+	}
+
+	for i := 0; i < 4*portfolioXIRRMaxIterations; i++ {
+		mid := (low + high) / 2
+
+		midValue, _ := npv(mid)
+		if math.Abs(high-low) < portfolioXIRRTolerance {
+			return &mid
+		}
+
+		if math.Signbit(midValue) == math.Signbit(lowValue) {
+			low, lowValue = mid, midValue
+		} else {
+			high = mid
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stratifyr/security-service/internal/stores"
+)
+
+func TestApplyPortfolioTransaction(t *testing.T) {
+	buy := func(quantity, price float64) *stores.PortfolioTransaction {
+		return &stores.PortfolioTransaction{SecurityID: 1, Type: PortfolioTransactionBuy, Quantity: quantity, Price: price}
+	}
+
+	sell := func(quantity, price float64) *stores.PortfolioTransaction {
+		return &stores.PortfolioTransaction{SecurityID: 1, Type: PortfolioTransactionSell, Quantity: quantity, Price: price}
+	}
+
+	tests := []struct {
+		name         string
+		transactions []*stores.PortfolioTransaction
+		wantRealized float64
+		wantQuantity float64
+		wantInvested float64
+		wantErr      bool
+	}{
+		{
+			name:         "buys only",
+			transactions: []*stores.PortfolioTransaction{buy(10, 100), buy(5, 120)},
+			wantQuantity: 15,
+			wantInvested: 1600,
+		},
+		{
+			// 10 from the first lot at +30 and 5 from the second at +10
+			name:         "sell spans two lots",
+			transactions: []*stores.PortfolioTransaction{buy(10, 100), buy(10, 120), sell(15, 130)},
+			wantRealized: 350,
+			wantQuantity: 5,
+			wantInvested: 600,
+		},
+		{
+			// 4 at -10 then 6 at +10
+			name:         "loss then gain on one lot",
+			transactions: []*stores.PortfolioTransaction{buy(10, 100), sell(4, 90), sell(6, 110)},
+			wantRealized: 20,
+		},
+		{
+			// 0.3 at +20 from the first lot and 0.3 at +10 from the second
+			name:         "fractional quantities",
+			transactions: []*stores.PortfolioTransaction{buy(0.3, 10), buy(0.3, 20), sell(0.6, 30)},
+			wantRealized: 9,
+		},
+		{
+			name:         "buy after a full exit starts a new lot",
+			transactions: []*stores.PortfolioTransaction{buy(10, 100), sell(10, 150), buy(2, 80)},
+			wantRealized: 500,
+			wantQuantity: 2,
+			wantInvested: 160,
+		},
+		{
+			name:         "sell more than held",
+			transactions: []*stores.PortfolioTransaction{buy(10, 100), sell(11, 130)},
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			positions, err := replayPortfolio(tc.transactions)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("replayPortfolio() did not fail")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("replayPortfolio() err = %v", err)
+			}
+
+			position := positions[1]
+
+			for _, check := range []struct {
+				name      string
+				got, want float64
+			}{
+				{"realized", position.realized, tc.wantRealized},
+				{"quantity", position.quantity(), tc.wantQuantity},
+				{"invested", position.invested(), tc.wantInvested},
+			} {
+				if math.Abs(check.got-check.want) > 1e-9 {
+					t.Errorf("%s = %v, want %v", check.name, check.got, check.want)
+				}
+			}
+		})
+	}
+}
+
+func TestXIRR(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	flow := func(days int, amount float64) portfolioCashFlow {
+		return portfolioCashFlow{date: start.AddDate(0, 0, days), amount: amount}
+	}
+
+	rate := func(v float64) *float64 { return &v }
+
+	tests := []struct {
+		name  string
+		flows []portfolioCashFlow
+		want  *float64
+	}{
+		{name: "one year", flows: []portfolioCashFlow{flow(0, -1000), flow(365, 1100)}, want: rate(0.1)},
+		{name: "two years", flows: []portfolioCashFlow{flow(0, -1000), flow(730, 1210)}, want: rate(0.1)},
+		// 1000 compounds to 1210 and the second 1000 to 1100
+		{name: "two deposits", flows: []portfolioCashFlow{flow(0, -1000), flow(365, -1000), flow(730, 2310)}, want: rate(0.1)},
+		{name: "half a year", flows: []portfolioCashFlow{flow(0, -1000), flow(182, 1000*math.Pow(1.21, 182.0/365))}, want: rate(0.21)},
+		{name: "loss", flows: []portfolioCashFlow{flow(0, -1000), flow(365, 500)}, want: rate(-0.5)},
+		{name: "large gain", flows: []portfolioCashFlow{flow(0, -100), flow(365, 5000)}, want: rate(49)},
+		{name: "unordered flows", flows: []portfolioCashFlow{flow(365, 1100), flow(0, -1000)}, want: rate(0.1)},
+		{name: "only outflows", flows: []portfolioCashFlow{flow(0, -1000), flow(365, -100)}},
+		{name: "only inflows", flows: []portfolioCashFlow{flow(0, 1000)}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := xirr(tc.flows)
+
+			switch {
+			case tc.want == nil && got != nil:
+				t.Errorf("xirr() = %v, want nil", *got)
+			case tc.want != nil && got == nil:
+				t.Errorf("xirr() = nil, want %v", *tc.want)
+			case tc.want != nil && math.Abs(*got-*tc.want) > 1e-6:
+				t.Errorf("xirr() = %v, want %v", *got, *tc.want)
+			}
+		})
+	}
+}
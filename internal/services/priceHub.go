@@ -0,0 +1,176 @@
+package services
+
+import (
+	"sync"
+	"time"
+)
+
+const maxPendingSignals = 100
+
+type PriceHub interface {
+	Publish(update *PriceUpdate)
+	PublishSignal(signal *SignalUpdate)
+	Subscribe(securityIDs []int) *PriceSubscription
+}
+
+type PriceUpdate struct {
+	SecurityID    int
+	Symbol        string
+	LTP           float64
+	PreviousClose float64
+	UpdatedAt     time.Time
+}
+
+type SignalUpdate struct {
+	SecurityID int
+	MetricID   int
+	Metric     string
+	MetricTier int
+	Date       time.Time
+	Value      float64
+}
+
+// PriceSubscription keeps only the latest pending update per security, so a slow consumer skips
+// intermediate prices instead of blocking publishers or growing an unbounded queue.
+type PriceSubscription struct {
+	hub         *priceHub
+	securityIDs []int
+	notify      chan struct{}
+
+	mu      sync.Mutex
+	pending map[int]*PriceUpdate
+	order   []int
+	signals []*SignalUpdate
+}
+
+type priceHub struct {
+	mu          sync.RWMutex
+	subscribers map[int]map[*PriceSubscription]struct{}
+}
+
+func NewPriceHub() *priceHub {
+	return &priceHub{subscribers: make(map[int]map[*PriceSubscription]struct{})}
+}
+
+func (h *priceHub) Publish(update *PriceUpdate) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for subscription := range h.subscribers[update.SecurityID] {
+		subscription.push(update)
+	}
+}
+
+func (h *priceHub) PublishSignal(signal *SignalUpdate) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for subscription := range h.subscribers[signal.SecurityID] {
+		subscription.pushSignal(signal)
+	}
+}
+
+func (h *priceHub) Subscribe(securityIDs []int) *PriceSubscription {
+	subscription := &PriceSubscription{
+		hub:         h,
+		securityIDs: securityIDs,
+		notify:      make(chan struct{}, 1),
+		pending:     make(map[int]*PriceUpdate),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, securityID := range securityIDs {
+		if h.subscribers[securityID] == nil {
+			h.subscribers[securityID] = make(map[*PriceSubscription]struct{})
+		}
+
+		h.subscribers[securityID][subscription] = struct{}{}
+	}
+
+	return subscription
+}
+
+func (h *priceHub) unsubscribe(subscription *PriceSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, securityID := range subscription.securityIDs {
+		delete(h.subscribers[securityID], subscription)
+
+		if len(h.subscribers[securityID]) == 0 {
+			delete(h.subscribers, securityID)
+		}
+	}
+}
+
+// Ready is signalled whenever new updates are pending, Drain returns them in arrival order.
+func (s *PriceSubscription) Ready() <-chan struct{} {
+	return s.notify
+}
+
+func (s *PriceSubscription) Drain() []*PriceUpdate {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	updates := make([]*PriceUpdate, 0, len(s.order))
+
+	for _, securityID := range s.order {
+		updates = append(updates, s.pending[securityID])
+	}
+
+	s.pending = make(map[int]*PriceUpdate)
+	s.order = s.order[:0]
+
+	return updates
+}
+
+// DrainSignals returns pending signals, a slow consumer keeps only the latest maxPendingSignals.
+func (s *PriceSubscription) DrainSignals() []*SignalUpdate {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	signals := s.signals
+	s.signals = nil
+
+	return signals
+}
+
+func (s *PriceSubscription) Close() {
+	s.hub.unsubscribe(s)
+}
+
+func (s *PriceSubscription) push(update *PriceUpdate) {
+	s.mu.Lock()
+
+	if _, ok := s.pending[update.SecurityID]; !ok {
+		s.order = append(s.order, update.SecurityID)
+	}
+
+	s.pending[update.SecurityID] = update
+
+	s.mu.Unlock()
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (s *PriceSubscription) pushSignal(signal *SignalUpdate) {
+	s.mu.Lock()
+
+	s.signals = append(s.signals, signal)
+
+	if len(s.signals) > maxPendingSignals {
+		s.signals = s.signals[len(s.signals)-maxPendingSignals:]
+	}
+
+	s.mu.Unlock()
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,274 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/stratifyr/security-service/internal/auth"
+)
+
+const rateLimitBucketIdleExpiry = 10 * time.Minute
+
+// rateLimitScript refills and takes a token from the bucket stored at KEYS[1] atomically, ARGV holds
+// the capacity, the refill rate per millisecond and the current time in milliseconds.
+const rateLimitScript = `
+local capacity = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(bucket[1]) or capacity
+local ts = tonumber(bucket[2]) or now
+tokens = math.min(capacity, tokens + math.max(0, now - ts) * rate)
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "ts", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil(capacity / rate))
+return {allowed, tostring(tokens)}
+`
+
+type RateLimitService interface {
+	Allow(ctx *gofr.Context, clientIP string) (*RateLimit, error)
+}
+
+type RateLimits struct {
+	Anonymous int
+	Service   int
+	Tiers     map[int]int
+}
+
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Duration
+	Allowed   bool
+}
+
+type tokenBucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+type rateLimitService struct {
+	userTierService UserTierService
+	limits          *RateLimits
+	useRedis        bool
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+	sweptAt time.Time
+}
+
+func NewRateLimitService(userTierService UserTierService, limits *RateLimits, useRedis bool) *rateLimitService {
+	return &rateLimitService{
+		userTierService: userTierService,
+		limits:          limits,
+		useRedis:        useRedis,
+		buckets:         make(map[string]*tokenBucket),
+	}
+}
+
+// ParseRateLimits reads per minute request limits from comma separated key:limit entries, where the
+// key is anonymous, service or a tier number. A limit of 0 disables limiting for its key, so every key has to be
+// listed for a missing entry not to turn limiting off.
+func ParseRateLimits(limits string) (*RateLimits, error) {
+	var (
+		resp                     = &RateLimits{Tiers: make(map[int]int)}
+		hasAnonymous, hasService bool
+	)
+
+	for _, entry := range strings.Split(limits, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok {
+			return nil, errors.New("invalid rate limit entry, expected key:limit")
+		}
+
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			return nil, errors.New("invalid rate limit for " + key)
+		}
+
+		switch key {
+		case "anonymous":
+			resp.Anonymous, hasAnonymous = limit, true
+		case "service":
+			resp.Service, hasService = limit, true
+		default:
+			tier, err := strconv.Atoi(key)
+			if err != nil {
+				return nil, errors.New("invalid rate limit key " + key)
+			}
+
+			resp.Tiers[tier] = limit
+		}
+	}
+
+	if !hasAnonymous || !hasService || len(resp.Tiers) == 0 {
+		return nil, errors.New("rate limits need an anonymous, a service and at least one tier entry")
+	}
+
+	return resp, nil
+}
+
+func (s *rateLimitService) Allow(ctx *gofr.Context, clientIP string) (*RateLimit, error) {
+	key, limit, err := s.resolveLimit(ctx, clientIP)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit == 0 {
+		return &RateLimit{Allowed: true}, nil
+	}
+
+	var tokens float64
+
+	allowed := false
+
+	if s.useRedis {
+		allowed, tokens, err = s.takeRedis(ctx, key, limit)
+		if err != nil {
+			ctx.Logger.Warnf("failed to apply rate limit in redis, limiting in memory, key: %s, err: %v", key, err)
+
+			allowed, tokens = s.takeMemory(key, limit)
+		}
+	} else {
+		allowed, tokens = s.takeMemory(key, limit)
+	}
+
+	rate := float64(limit) / time.Minute.Seconds()
+
+	resp := &RateLimit{
+		Limit:     limit,
+		Remaining: int(math.Floor(tokens)),
+		Reset:     time.Duration((float64(limit) - tokens) / rate * float64(time.Second)),
+		Allowed:   allowed,
+	}
+
+	if !allowed {
+		resp.Reset = time.Duration((1 - tokens) / rate * float64(time.Second))
+	}
+
+	return resp, nil
+}
+
+func (s *rateLimitService) resolveLimit(ctx *gofr.Context, clientIP string) (string, int, error) {
+	principal := auth.PrincipalFromContext(ctx)
+
+	switch {
+	case principal == nil:
+		return "ip:" + clientIP, s.limits.Anonymous, nil
+	case principal.UserID == 0:
+		return "service:" + principal.Name, s.limits.Service, nil
+	case principal.Can(auth.ReadAllTiers):
+		return fmt.Sprintf("user:%d", principal.UserID), s.limits.Service, nil
+	}
+
+	tier, err := s.userTierService.Read(ctx, principal.UserID)
+	if err != nil {
+		ctx.Logger.Warnf("failed to read tier of userId %d, applying the lowest tier's rate limit, err: %v", principal.UserID, err)
+
+		tier = math.MinInt
+	}
+
+	return fmt.Sprintf("user:%d", principal.UserID), s.tierLimit(tier), nil
+}
+
+// tierLimit is the limit of the highest configured tier at or below tier, tiers below every configured one get the lowest's.
+func (s *rateLimitService) tierLimit(tier int) int {
+	var (
+		limit, lowestLimit      int
+		matchedTier, lowestTier = math.MinInt, math.MaxInt
+	)
+
+	for t, l := range s.limits.Tiers {
+		if t <= tier && t > matchedTier {
+			limit, matchedTier = l, t
+		}
+
+		if t < lowestTier {
+			lowestLimit, lowestTier = l, t
+		}
+	}
+
+	if matchedTier == math.MinInt {
+		return lowestLimit
+	}
+
+	return limit
+}
+
+func (s *rateLimitService) takeMemory(key string, limit int) (bool, float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	rate := float64(limit) / time.Minute.Seconds()
+
+	if now.Sub(s.sweptAt) > rateLimitBucketIdleExpiry {
+		for k, b := range s.buckets {
+			if now.Sub(b.updatedAt) > rateLimitBucketIdleExpiry {
+				delete(s.buckets, k)
+			}
+		}
+
+		s.sweptAt = now
+	}
+
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: float64(limit), updatedAt: now}
+		s.buckets[key] = bucket
+	}
+
+	bucket.tokens = math.Min(float64(limit), bucket.tokens+now.Sub(bucket.updatedAt).Seconds()*rate)
+	bucket.updatedAt = now
+
+	if bucket.tokens < 1 {
+		return false, bucket.tokens
+	}
+
+	bucket.tokens--
+
+	return true, bucket.tokens
+}
+
+func (s *rateLimitService) takeRedis(ctx *gofr.Context, key string, limit int) (bool, float64, error) {
+	rate := float64(limit) / float64(time.Minute.Milliseconds())
+
+	res, err := ctx.Redis.Eval(ctx, rateLimitScript, []string{rateLimitCacheKey(key)},
+		limit, strconv.FormatFloat(rate, 'f', -1, 64), time.Now().UnixMilli()).Slice()
+	if err != nil {
+		return false, 0, err
+	}
+
+	if len(res) != 2 {
+		return false, 0, errors.New("unexpected rate limit script response")
+	}
+
+	allowed, _ := res[0].(int64)
+	remaining, _ := res[1].(string)
+
+	tokens, err := strconv.ParseFloat(remaining, 64)
+	if err != nil {
+		return false, 0, err
+	}
+
+	return allowed == 1, tokens, nil
+}
+
+func rateLimitCacheKey(key string) string {
+	return "rate_limits:" + key
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/stratifyr/security-service/internal/auth"
+)
+
+type fakeUserTierService struct {
+	tier int
+	err  error
+}
+
+func (s *fakeUserTierService) Read(*gofr.Context, int) (int, error) {
+	return s.tier, s.err
+}
+
+func TestRateLimitService_TakeMemory(t *testing.T) {
+	// 60 a minute refills a token a second
+	s := NewRateLimitService(nil, &RateLimits{}, false)
+
+	for i := 0; i < 60; i++ {
+		if allowed, _ := s.takeMemory("ip:203.0.113.7", 60); !allowed {
+			t.Fatalf("request %d of a full bucket was limited", i+1)
+		}
+	}
+
+	if allowed, tokens := s.takeMemory("ip:203.0.113.7", 60); allowed || tokens >= 1 {
+		t.Fatalf("request past the capacity was allowed with %v tokens", tokens)
+	}
+
+	if allowed, _ := s.takeMemory("ip:203.0.113.8", 60); !allowed {
+		t.Error("a different key shares the exhausted bucket")
+	}
+
+	tests := []struct {
+		name        string
+		elapsed     time.Duration
+		wantAllowed bool
+		wantTokens  float64
+	}{
+		{name: "half a token refilled", elapsed: 500 * time.Millisecond, wantTokens: 0.5},
+		{name: "two and a half tokens refilled", elapsed: 2500 * time.Millisecond, wantAllowed: true, wantTokens: 1.5},
+		{name: "refill is capped at the capacity", elapsed: time.Hour, wantAllowed: true, wantTokens: 59},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s.buckets["ip:203.0.113.7"] = &tokenBucket{tokens: 0, updatedAt: time.Now().Add(-tc.elapsed)}
+
+			allowed, tokens := s.takeMemory("ip:203.0.113.7", 60)
+			if allowed != tc.wantAllowed || tokens < tc.wantTokens || tokens > tc.wantTokens+0.01 {
+				t.Errorf("allowed = %v with %v tokens left, want %v with %v", allowed, tokens, tc.wantAllowed, tc.wantTokens)
+			}
+		})
+	}
+}
+
+func TestRateLimitService_ResolveLimit(t *testing.T) {
+	limits, err := ParseRateLimits("anonymous:30,service:6000,1:300,2:1200")
+	if err != nil {
+		t.Fatalf("ParseRateLimits() err = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		userTier  *fakeUserTierService
+		wantKey   string
+		wantLimit int
+	}{
+		{name: "anonymous", wantKey: "ip:203.0.113.7", wantLimit: 30},
+		{name: "service", principal: &auth.Principal{Name: "loader"}, wantKey: "service:loader", wantLimit: 6000},
+		{name: "configured tier", principal: &auth.Principal{UserID: 4}, userTier: &fakeUserTierService{tier: 1}, wantKey: "user:4",
+			wantLimit: 300},
+		{name: "tier above the highest", principal: &auth.Principal{UserID: 4}, userTier: &fakeUserTierService{tier: 5}, wantKey: "user:4",
+			wantLimit: 1200},
+		{name: "tier below the lowest", principal: &auth.Principal{UserID: 4}, userTier: &fakeUserTierService{tier: 0}, wantKey: "user:4",
+			wantLimit: 300},
+		{name: "tier lookup failing", principal: &auth.Principal{UserID: 4}, userTier: &fakeUserTierService{tier: 2, err: errors.New("down")},
+			wantKey: "user:4", wantLimit: 300},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s := NewRateLimitService(tc.userTier, limits, false)
+
+			ctx := newTestContext()
+
+			if tc.principal != nil {
+				ctx.Context = auth.WithPrincipal(ctx.Context, tc.principal)
+			}
+
+			key, limit, err := s.resolveLimit(ctx, "203.0.113.7")
+			if err != nil || key != tc.wantKey || limit != tc.wantLimit {
+				t.Errorf("resolveLimit() = %s, %d, %v, want %s, %d", key, limit, err, tc.wantKey, tc.wantLimit)
+			}
+		})
+	}
+}
+
+func TestParseRateLimits(t *testing.T) {
+	tests := []struct {
+		limits  string
+		wantErr bool
+	}{
+		{limits: "anonymous:30,service:6000,0:60"},
+		{limits: "anonymous:0,service:0,0:0"},
+		{limits: "service:6000,0:60", wantErr: true},
+		{limits: "anonymous:30,0:60", wantErr: true},
+		{limits: "anonymous:30,service:6000", wantErr: true},
+		{limits: "anonymous:30,service:6000,0:-1", wantErr: true},
+		{limits: "anonymous:30,service:6000,gold:60", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		if _, err := ParseRateLimits(tc.limits); (err != nil) != tc.wantErr {
+			t.Errorf("ParseRateLimits(%q) err = %v, want error %v", tc.limits, err, tc.wantErr)
+		}
+	}
+}
@@ -1,43 +1,53 @@
 package services
 
 import (
-	"encoding/json"
-	"fmt"
-	"io"
 	"time"
 
 	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
 
+	"github.com/stratifyr/security-service/internal/auth"
 	"github.com/stratifyr/security-service/internal/stores"
 )
 
+const ltpHistoryRetention = 7 * 24 * time.Hour
+
 type SecurityService interface {
 	Index(ctx *gofr.Context, f *SecurityFilter, page, perPage int) ([]*Security, int, error)
-	Read(ctx *gofr.Context, id, userID int) (*Security, error)
+	Read(ctx *gofr.Context, id int) (*Security, error)
 	Create(ctx *gofr.Context, payload *SecurityCreate) (*Security, error)
 	Patch(ctx *gofr.Context, id int, payload *SecurityUpdate) (*Security, error)
 }
 
 type SecurityFilter struct {
-	UserID int
-	IDs    []int
-	ISIN   string
-	Symbol string
+	IDs            []int
+	ISIN           string
+	InstrumentType string
+	Symbol         string
+	Symbols        []string
+	WatchlistID    int
+	IndexID        int
+	LotSize        int
+	Surveillance   *bool
+	MTFEligible    *bool
 }
 
 type Security struct {
-	ID            int
-	ISIN          string
-	Symbol        string
-	Industry      string
-	Name          string
-	Image         string
-	LTP           float64
-	PreviousClose float64
-	Tier          int
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
-	SecurityStat  *struct {
+	ID             int
+	ISIN           string
+	Symbol         string
+	InstrumentType string
+	Industry       string
+	Name           string
+	Image          string
+	LTP            float64
+	LTPDelay       time.Duration
+	PreviousClose  float64
+	Tier           int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Tradability    *Tradability
+	SecurityStat   *struct {
 		ID         int
 		SecurityID int
 		Date       time.Time
@@ -66,63 +76,102 @@ type Security struct {
 }
 
 type SecurityCreate struct {
-	UserID   int
-	ISIN     string
-	Symbol   string
-	Industry string
-	Name     string
-	Image    string
-	LTP      float64
-	Tier     int
+	ISIN           string
+	Symbol         string
+	InstrumentType string
+	Industry       string
+	Name           string
+	Image          string
+	LTP            float64
+	Tier           int
 }
 
 type SecurityUpdate struct {
-	UserID   int
-	Symbol   string
-	Industry string
-	Name     string
-	Image    string
-	LTP      float64
-	Tier     *int
+	Symbol         string
+	InstrumentType string
+	Industry       string
+	Name           string
+	Image          string
+	LTP            float64
+	Tier           *int
 }
 
 type securityService struct {
 	marketDayService    MarketDayService
+	entitlementService  EntitlementService
+	auditEventService   AuditEventService
+	domainEventService  DomainEventService
+	watchlistService    WatchlistService
+	industryService     IndustryService
+	industryStore       stores.IndustryStore
 	metricsStore        stores.MetricStore
 	securityMetricStore stores.SecurityMetricStore
 	securityStatStore   stores.SecurityStatStore
+	securityLTPStore    stores.SecurityLTPStore
 	store               stores.SecurityStore
+	priceHub            PriceHub
 }
 
-func NewSecurityService(marketDayService MarketDayService, metricStore stores.MetricStore, securityMetricStore stores.SecurityMetricStore,
-	securityStatStore stores.SecurityStatStore, store stores.SecurityStore) *securityService {
+func NewSecurityService(marketDayService MarketDayService, entitlementService EntitlementService, auditEventService AuditEventService,
+	domainEventService DomainEventService, watchlistService WatchlistService, industryService IndustryService, industryStore stores.IndustryStore,
+	metricStore stores.MetricStore, securityMetricStore stores.SecurityMetricStore, securityStatStore stores.SecurityStatStore,
+	securityLTPStore stores.SecurityLTPStore, store stores.SecurityStore, priceHub PriceHub) *securityService {
 	return &securityService{
 		marketDayService:    marketDayService,
+		entitlementService:  entitlementService,
+		auditEventService:   auditEventService,
+		domainEventService:  domainEventService,
+		watchlistService:    watchlistService,
+		industryService:     industryService,
+		industryStore:       industryStore,
 		metricsStore:        metricStore,
 		securityMetricStore: securityMetricStore,
 		securityStatStore:   securityStatStore,
+		securityLTPStore:    securityLTPStore,
 		store:               store,
+		priceHub:            priceHub,
 	}
 }
 
 func (s *securityService) Index(ctx *gofr.Context, f *SecurityFilter, page, perPage int) ([]*Security, int, error) {
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, 0, err
+	}
+
 	limit := perPage
 	offset := limit * (page - 1)
 
-	filter := &stores.SecurityFilter{
-		IDs:     f.IDs,
-		Symbol:  f.Symbol,
-		ISIN:    f.ISIN,
-		MaxTier: nil,
+	entitlement, err := s.entitlementService.Resolve(ctx)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	if f.UserID != 0 {
-		userTier, err := s.getUserTier(ctx, f.UserID)
+	if f.WatchlistID != 0 {
+		if _, err = s.watchlistService.Read(ctx, f.WatchlistID); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	filter := &stores.SecurityFilter{
+		IDs:          f.IDs,
+		Symbol:       f.Symbol,
+		Symbols:      f.Symbols,
+		ISIN:         f.ISIN,
+		WatchlistID:  f.WatchlistID,
+		IndexID:      f.IndexID,
+		LotSize:      f.LotSize,
+		Surveillance: f.Surveillance,
+		MTFEligible:  f.MTFEligible,
+		MaxTier:      entitlement.Tier,
+	}
+
+	if f.InstrumentType != "" {
+		instrumentType, err := stores.InstrumentTypeFromString(f.InstrumentType)
 		if err != nil {
 			return nil, 0, err
 		}
 
-		filter.MaxTier = &userTier
+		filter.InstrumentTypes = []stores.InstrumentType{instrumentType}
 	}
 
 	securities, err := s.store.Index(ctx, filter, limit, offset)
@@ -139,7 +188,12 @@ func (s *securityService) Index(ctx *gofr.Context, f *SecurityFilter, page, perP
 		return nil, 0, nil
 	}
 
-	metricsMap, err := s.getMetricsMap(ctx, f.UserID)
+	metricsMap, err := s.getMetricsMap(ctx, entitlement.Tier)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	industriesMap, err := s.getIndustriesMap(ctx)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -160,41 +214,45 @@ func (s *securityService) Index(ctx *gofr.Context, f *SecurityFilter, page, perP
 		return nil, 0, err
 	}
 
-	var (
-		sem   = make(chan struct{}, 5)
-		resp  = make([]*Security, len(securities))
-		errCh = make(chan error, len(securities))
-	)
-
-	for i := range securities {
-		sem <- struct{}{}
-		i := i
-		resp[i] = &Security{}
+	securityMetricsMap, err := s.getSecurityMetricsMap(ctx, securityStatsMap)
+	if err != nil {
+		return nil, 0, err
+	}
 
-		go func() {
-			defer func() { <-sem }()
+	var resp = make([]*Security, len(securities))
 
-			resp[i], err = s.buildResp(ctx, securities[i], metricsMap, securityStatsMap, prevCloseMap)
-			errCh <- err
-		}()
+	for i := range securities {
+		resp[i] = s.buildResp(securities[i], industriesMap, metricsMap, securityStatsMap, prevCloseMap, securityMetricsMap)
 	}
 
-	for j := 0; j < len(securities); j++ {
-		if err := <-errCh; err != nil {
-			return nil, 0, err
-		}
+	if err = s.applyLTPDelay(ctx, resp, entitlement.LTPDelay); err != nil {
+		return nil, 0, err
 	}
 
 	return resp, count, nil
 }
 
-func (s *securityService) Read(ctx *gofr.Context, id, userID int) (*Security, error) {
+func (s *securityService) Read(ctx *gofr.Context, id int) (*Security, error) {
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, err
+	}
+
 	security, err := s.store.Retrieve(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	metricsMap, err := s.getMetricsMap(ctx, userID)
+	entitlement, err := s.entitlementService.Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	metricsMap, err := s.getMetricsMap(ctx, entitlement.Tier)
+	if err != nil {
+		return nil, err
+	}
+
+	industriesMap, err := s.getIndustriesMap(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -209,37 +267,87 @@ func (s *securityService) Read(ctx *gofr.Context, id, userID int) (*Security, er
 		return nil, err
 	}
 
-	return s.buildResp(ctx, security, metricsMap, securityStatsMap, prevCloseMap)
+	securityMetricsMap, err := s.getSecurityMetricsMap(ctx, securityStatsMap)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := s.buildResp(security, industriesMap, metricsMap, securityStatsMap, prevCloseMap, securityMetricsMap)
+
+	if err = s.applyLTPDelay(ctx, []*Security{resp}, entitlement.LTPDelay); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
 }
 
 func (s *securityService) Create(ctx *gofr.Context, payload *SecurityCreate) (*Security, error) {
-	if payload.UserID != 1 {
-		return nil, &ErrResp{Code: 403, Message: "Forbidden"}
+	if err := authorize(ctx, auth.WriteSecurities); err != nil {
+		return nil, err
 	}
 
-	industry, err := stores.IndustryFromString(payload.Industry)
+	industry, err := stores.IndustryFromString(ctx, payload.Industry)
 	if err != nil {
 		return nil, err
 	}
 
+	instrumentType := stores.Equity
+
+	if payload.InstrumentType != "" {
+		instrumentType, err = stores.InstrumentTypeFromString(payload.InstrumentType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if payload.ISIN == "" && instrumentType != stores.Index {
+		return nil, http.ErrorMissingParam{Params: []string{"isin"}}
+	}
+
 	model := &stores.Security{
-		ISIN:      payload.ISIN,
-		Symbol:    payload.Symbol,
-		Industry:  industry,
-		Name:      payload.Name,
-		Image:     payload.Image,
-		LTP:       payload.LTP,
-		Tier:      payload.Tier,
-		CreatedAt: time.Now().UTC(),
-		UpdatedAt: time.Now().UTC(),
+		ISIN:           payload.ISIN,
+		Symbol:         payload.Symbol,
+		InstrumentType: instrumentType,
+		Name:           payload.Name,
+		Image:          payload.Image,
+		LTP:            payload.LTP,
+		Tier:           payload.Tier,
+		CreatedAt:      time.Now().UTC(),
+		UpdatedAt:      time.Now().UTC(),
+	}
+
+	var security *stores.Security
+
+	err = stores.InTx(ctx, func() error {
+		if security, err = s.store.Create(ctx, model); err != nil {
+			return err
+		}
+
+		assignment, err := s.industryService.Assign(ctx, security.ID, &IndustryAssignmentCreate{IndustryID: industry.ID, EffectiveFrom: industryAssignmentEpoch})
+		if err != nil {
+			return err
+		}
+
+		security.IndustryID = &assignment.IndustryID
+
+		if err = s.auditEventService.Record(ctx, "securities", security.ID, AuditActionCreate, nil, security); err != nil {
+			return err
+		}
+
+		s.recordLTP(ctx, security)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	security, err := s.store.Create(ctx, model)
+	metricsMap, err := s.getMetricsMap(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	metricsMap, err := s.getMetricsMap(ctx, payload.UserID)
+	industriesMap, err := s.getIndustriesMap(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -254,12 +362,17 @@ func (s *securityService) Create(ctx *gofr.Context, payload *SecurityCreate) (*S
 		return nil, err
 	}
 
-	return s.buildResp(ctx, security, metricsMap, securityStatsMap, prevCloseMap)
+	securityMetricsMap, err := s.getSecurityMetricsMap(ctx, securityStatsMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(security, industriesMap, metricsMap, securityStatsMap, prevCloseMap, securityMetricsMap), nil
 }
 
 func (s *securityService) Patch(ctx *gofr.Context, id int, payload *SecurityUpdate) (*Security, error) {
-	if payload.UserID != 1 {
-		return nil, &ErrResp{Code: 403, Message: "Forbidden"}
+	if err := authorize(ctx, auth.WriteSecurities); err != nil {
+		return nil, err
 	}
 
 	security, err := s.store.Retrieve(ctx, id)
@@ -267,12 +380,20 @@ func (s *securityService) Patch(ctx *gofr.Context, id int, payload *SecurityUpda
 		return nil, err
 	}
 
+	before := *security
+
+	if payload.Industry != "" {
+		if _, err = stores.IndustryFromString(ctx, payload.Industry); err != nil {
+			return nil, err
+		}
+	}
+
 	if payload.Symbol != "" {
 		security.Symbol = payload.Symbol
 	}
 
-	if payload.Industry != "" {
-		security.Industry, err = stores.IndustryFromString(payload.Industry)
+	if payload.InstrumentType != "" {
+		security.InstrumentType, err = stores.InstrumentTypeFromString(payload.InstrumentType)
 		if err != nil {
 			return nil, err
 		}
@@ -294,83 +415,90 @@ func (s *securityService) Patch(ctx *gofr.Context, id int, payload *SecurityUpda
 		security.Tier = *payload.Tier
 	}
 
-	security, err = s.store.Update(ctx, id, security)
+	err = stores.InTx(ctx, func() error {
+		if security, err = s.store.Update(ctx, id, security); err != nil {
+			return err
+		}
+
+		if payload.Industry != "" {
+			assignment, err := s.industryService.Assign(ctx, id, &IndustryAssignmentCreate{Industry: payload.Industry})
+			if err != nil {
+				return err
+			}
+
+			security.IndustryID = &assignment.IndustryID
+		}
+
+		if err := s.auditEventService.Record(ctx, "securities", security.ID, AuditActionUpdate, &before, security); err != nil {
+			return err
+		}
+
+		if payload.LTP != 0 {
+			s.recordLTP(ctx, security)
+		}
+
+		if payload.LTP != 0 && before.LTP != security.LTP {
+			return s.domainEventService.Emit(ctx, TopicSecurityLTPUpdated, &SecurityLTPEvent{
+				SecurityID:  security.ID,
+				Symbol:      security.Symbol,
+				LTP:         security.LTP,
+				PreviousLTP: before.LTP,
+			})
+		}
+
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	metricsMap, err := s.getMetricsMap(ctx, payload.UserID)
+	metricsMap, err := s.getMetricsMap(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	securityStatsMap, err := s.getStatsMap(ctx, []int{security.ID})
+	industriesMap, err := s.getIndustriesMap(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	prevCloseMap, err := s.getPrevCloseMap(ctx, []int{security.ID})
+	securityStatsMap, err := s.getStatsMap(ctx, []int{security.ID})
 	if err != nil {
 		return nil, err
 	}
 
-	return s.buildResp(ctx, security, metricsMap, securityStatsMap, prevCloseMap)
-}
-
-func (s *securityService) getUserTier(ctx *gofr.Context, userID int) (int, error) {
-	httpService := ctx.GetHTTPService("account-service")
-
-	resp, err := httpService.Get(ctx, fmt.Sprintf("users/%d", userID), nil)
+	prevCloseMap, err := s.getPrevCloseMap(ctx, []int{security.ID})
 	if err != nil {
-		ctx.Logger.Errorf("failed GET /account-service/users/{id}, %v", map[string]interface{}{
-			"err":    err.Error(),
-			"userId": userID,
-		})
-
-		return 0, &ErrResp{Code: 503, Message: "something went wrong!"}
+		return nil, err
 	}
 
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 200 {
-		body, _ := io.ReadAll(resp.Body)
-
-		ctx.Logger.Errorf("non 200 resp GET /account-service/users/{id}, %v", map[string]interface{}{
-			"code": resp.StatusCode,
-			"resp": string(body),
-		})
-
-		return 0, &ErrResp{Code: 503, Message: "something went wrong!"}
+	securityMetricsMap, err := s.getSecurityMetricsMap(ctx, securityStatsMap)
+	if err != nil {
+		return nil, err
 	}
 
-	var res struct {
-		Data *struct {
-			Plan *struct {
-				Tier int `json:"tier"`
-			} `json:"plan"`
-		} `json:"data"`
-	}
+	resp := s.buildResp(security, industriesMap, metricsMap, securityStatsMap, prevCloseMap, securityMetricsMap)
 
-	err = json.NewDecoder(resp.Body).Decode(&res)
-	if err != nil {
-		ctx.Logger.Error("unexpected response from GET /account-service/users/{id}, %v", map[string]interface{}{
-			"resp":         fmt.Sprintf("%s", resp.Body),
-			"unmarshalErr": err,
+	if payload.LTP != 0 && before.LTP != security.LTP {
+		s.priceHub.Publish(&PriceUpdate{
+			SecurityID:    resp.ID,
+			Symbol:        resp.Symbol,
+			LTP:           resp.LTP,
+			PreviousClose: resp.PreviousClose,
+			UpdatedAt:     resp.UpdatedAt,
 		})
-
-		return 0, &ErrResp{Code: 503, Message: "something went wrong!"}
 	}
 
-	return res.Data.Plan.Tier, nil
+	return resp, nil
 }
 
-func (s *securityService) buildResp(ctx *gofr.Context, model *stores.Security, metricsMap map[int]*stores.Metric,
-	securityStatsMap map[int]*stores.SecurityStat, prevCloseMap map[int]float64) (*Security, error) {
+func (s *securityService) buildResp(model *stores.Security, industriesMap map[int]*stores.Industry, metricsMap map[int]*stores.Metric, securityStatsMap map[int]*stores.SecurityStat,
+	prevCloseMap map[int]float64, securityMetricsMap map[int][]*stores.SecurityMetric) *Security {
 	resp := &Security{
 		ID:              model.ID,
 		ISIN:            model.ISIN,
 		Symbol:          model.Symbol,
-		Industry:        model.Industry.String(),
+		InstrumentType:  model.InstrumentType.String(),
 		Name:            model.Name,
 		Image:           model.Image,
 		LTP:             model.LTP,
@@ -381,16 +509,41 @@ func (s *securityService) buildResp(ctx *gofr.Context, model *stores.Security, m
 		SecurityMetrics: nil,
 	}
 
+	if model.IndustryID != nil {
+		if industry, ok := industriesMap[*model.IndustryID]; ok {
+			resp.Industry = industry.Name
+		}
+	}
+
+	s.bindTradability(resp, model)
 	s.bindSecurityStat(resp, securityStatsMap)
 	s.bindPreviousClose(resp, prevCloseMap)
+	s.bindSecurityMetricsDetails(resp, metricsMap, securityMetricsMap)
+	s.computeAndSetNormalizedValues(resp)
 
-	if err := s.bindSecurityMetricsDetails(ctx, resp, metricsMap); err != nil {
-		return nil, err
+	return resp
+}
+
+func (s *securityService) bindTradability(resp *Security, model *stores.Security) {
+	if model.LotSize == nil || model.TickSize == nil || model.SurveillanceFlag == nil {
+		return
 	}
 
-	s.computeAndSetNormalizedValues(resp)
+	resp.Tradability = &Tradability{
+		SecurityID:        model.ID,
+		LotSize:           *model.LotSize,
+		TickSize:          *model.TickSize,
+		SurveillanceFlag:  *model.SurveillanceFlag,
+		UnderSurveillance: *model.SurveillanceFlag != SurveillanceFlagNone,
+	}
 
-	return resp, nil
+	if model.SurveillanceCategory != nil {
+		resp.Tradability.SurveillanceCategory = *model.SurveillanceCategory
+	}
+
+	if model.MTFLeverage != nil {
+		resp.Tradability.MTFLeverage = *model.MTFLeverage
+	}
 }
 
 func (s *securityService) bindSecurityStat(resp *Security, securityStatsMap map[int]*stores.SecurityStat) {
@@ -433,17 +586,12 @@ func (s *securityService) bindPreviousClose(resp *Security, prevCloseMap map[int
 	return
 }
 
-func (s *securityService) bindSecurityMetricsDetails(ctx *gofr.Context, resp *Security, metricsMap map[int]*stores.Metric) error {
+func (s *securityService) bindSecurityMetricsDetails(resp *Security, metricsMap map[int]*stores.Metric, securityMetricsMap map[int][]*stores.SecurityMetric) {
 	if resp.SecurityStat == nil {
-		return nil
+		return
 	}
 
-	date := resp.SecurityStat.Date
-
-	securityMetrics, err := s.securityMetricStore.Index(ctx, &stores.SecurityMetricFilter{SecurityID: resp.ID, Date: date}, 0, 0)
-	if err != nil {
-		return err
-	}
+	securityMetrics := securityMetricsMap[resp.ID]
 
 	resp.SecurityMetrics = make([]*struct {
 		ID              int
@@ -507,22 +655,78 @@ func (s *securityService) bindSecurityMetricsDetails(ctx *gofr.Context, resp *Se
 		})
 	}
 
-	return nil
+	return
 }
 
-func (s *securityService) getMetricsMap(ctx *gofr.Context, userID int) (map[int]*stores.Metric, error) {
-	var filter stores.MetricFilter
+func (s *securityService) applyLTPDelay(ctx *gofr.Context, resp []*Security, delay time.Duration) error {
+	if delay == 0 {
+		return nil
+	}
 
-	if userID != 0 {
-		userTier, err := s.getUserTier(ctx, userID)
-		if err != nil {
-			return nil, err
+	var securityIDs = make([]int, len(resp))
+
+	for i := range resp {
+		securityIDs[i] = resp[i].ID
+	}
+
+	securityLTPs, err := s.securityLTPStore.IndexAsOf(ctx, securityIDs, time.Now().UTC().Add(-delay))
+	if err != nil {
+		return err
+	}
+
+	var ltpMap = make(map[int]float64)
+
+	for i := range securityLTPs {
+		ltpMap[securityLTPs[i].SecurityID] = securityLTPs[i].LTP
+	}
+
+	for i := range resp {
+		resp[i].LTPDelay = delay
+
+		if ltp, ok := ltpMap[resp[i].ID]; ok {
+			resp[i].LTP = ltp
+
+			continue
 		}
 
-		filter.MaxTier = &userTier
+		resp[i].LTP = resp[i].PreviousClose
+	}
+
+	return nil
+}
+
+func (s *securityService) recordLTP(ctx *gofr.Context, security *stores.Security) {
+	now := time.Now().UTC()
+
+	err := s.securityLTPStore.Create(ctx, &stores.SecurityLTP{SecurityID: security.ID, LTP: security.LTP, RecordedAt: now})
+	if err != nil {
+		ctx.Logger.Errorf("failed to record ltp history for security %d, err: %v", security.ID, err)
+
+		return
+	}
+
+	if err = s.securityLTPStore.DeleteBefore(ctx, security.ID, now.Add(-ltpHistoryRetention)); err != nil {
+		ctx.Logger.Warnf("failed to prune ltp history for security %d, err: %v", security.ID, err)
+	}
+}
+
+func (s *securityService) getIndustriesMap(ctx *gofr.Context) (map[int]*stores.Industry, error) {
+	industries, err := s.industryStore.Index(ctx, &stores.IndustryFilter{}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var industriesMap = make(map[int]*stores.Industry)
+
+	for i := range industries {
+		industriesMap[industries[i].ID] = industries[i]
 	}
 
-	metrics, err := s.metricsStore.Index(ctx, &filter, 0, 0)
+	return industriesMap, nil
+}
+
+func (s *securityService) getMetricsMap(ctx *gofr.Context, maxTier *int) (map[int]*stores.Metric, error) {
+	metrics, err := s.metricsStore.Index(ctx, &stores.MetricFilter{MaxTier: maxTier}, 0, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -583,6 +787,35 @@ func (s *securityService) getPrevCloseMap(ctx *gofr.Context, securityIDs []int)
 	return prevCloseMap, nil
 }
 
+func (s *securityService) getSecurityMetricsMap(ctx *gofr.Context, securityStatsMap map[int]*stores.SecurityStat) (map[int][]*stores.SecurityMetric, error) {
+	var (
+		dates             = make(map[string]time.Time)
+		securityIDsByDate = make(map[string][]int)
+	)
+
+	for securityID, securityStat := range securityStatsMap {
+		date := securityStat.Date.Format(time.DateOnly)
+
+		dates[date] = securityStat.Date
+		securityIDsByDate[date] = append(securityIDsByDate[date], securityID)
+	}
+
+	var securityMetricsMap = make(map[int][]*stores.SecurityMetric)
+
+	for date, securityIDs := range securityIDsByDate {
+		securityMetrics, err := s.securityMetricStore.IndexBySecurityIDs(ctx, securityIDs, dates[date])
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range securityMetrics {
+			securityMetricsMap[securityMetrics[i].SecurityID] = append(securityMetricsMap[securityMetrics[i].SecurityID], securityMetrics[i])
+		}
+	}
+
+	return securityMetricsMap, nil
+}
+
 func (s *securityService) computeAndSetNormalizedValues(resp *Security) {
 	for _, metric := range resp.SecurityMetrics {
 		metricType, _ := stores.MetricTypeFromString(metric.Metric.Type)
@@ -611,6 +844,16 @@ func (s *securityService) computeAndSetNormalizedValues(resp *Security) {
 		case stores.VMA:
 			metric.NormalizedValue = (float64(resp.SecurityStat.Volume) - metric.Value) / metric.Value
 
+		case stores.PE, stores.PB:
+			metric.NormalizedValue = 1 / metric.Value
+
+		case stores.DY:
+			metric.NormalizedValue = metric.Value / 100
+
+		case stores.MCAP:
+			// market cap sizes a security rather than scoring it, so it stays neutral in a composite
+			metric.NormalizedValue = 0
+
 		default:
 			metric.NormalizedValue = metric.Value
 		}
@@ -0,0 +1,433 @@
+package services
+
+import (
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+
+	"github.com/stratifyr/security-service/internal/auth"
+	"github.com/stratifyr/security-service/internal/stores"
+)
+
+// fundamentalTTMPeriods is the number of quarterly results that make up the trailing twelve months.
+const fundamentalTTMPeriods = 4
+
+type SecurityFundamentalService interface {
+	Index(ctx *gofr.Context, f *SecurityFundamentalFilter, page, perPage int) ([]*SecurityFundamental, int, error)
+	Read(ctx *gofr.Context, id int) (*SecurityFundamental, error)
+	Create(ctx *gofr.Context, payload *SecurityFundamentalCreate) (*SecurityFundamental, error)
+	Patch(ctx *gofr.Context, id int, payload *SecurityFundamentalUpdate) (*SecurityFundamental, error)
+	Valuation(ctx *gofr.Context, securityID int, date time.Time) (*Valuation, error)
+}
+
+type SecurityFundamentalFilter struct {
+	SecurityID int
+	PeriodEnd  time.Time
+	From       time.Time
+	To         time.Time
+}
+
+type SecurityFundamental struct {
+	ID                int
+	SecurityID        int
+	PeriodEnd         time.Time
+	ReportedAt        *time.Time
+	SharesOutstanding int64
+	Revenue           *float64
+	NetIncome         *float64
+	EPS               *float64
+	BookValue         *float64
+	DividendPerShare  *float64
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+type SecurityFundamentalCreate struct {
+	SecurityID        int
+	PeriodEnd         time.Time
+	ReportedAt        *time.Time
+	SharesOutstanding int64
+	Revenue           *float64
+	NetIncome         *float64
+	EPS               *float64
+	BookValue         *float64
+	DividendPerShare  *float64
+}
+
+type SecurityFundamentalUpdate struct {
+	ReportedAt        *time.Time
+	SharesOutstanding int64
+	Revenue           *float64
+	NetIncome         *float64
+	EPS               *float64
+	BookValue         *float64
+	DividendPerShare  *float64
+}
+
+type Valuation struct {
+	SecurityID        int
+	Date              time.Time
+	Price             float64
+	PeriodEnd         time.Time
+	SharesOutstanding int64
+	MarketCap         float64
+	EPSTTM            *float64
+	BookValuePerShare *float64
+	PE                *float64
+	PB                *float64
+	DividendYield     *float64
+}
+
+type securityFundamentalService struct {
+	entitlementService EntitlementService
+	auditEventService  AuditEventService
+	securityStore      stores.SecurityStore
+	securityStatStore  stores.SecurityStatStore
+	store              stores.SecurityFundamentalStore
+}
+
+func NewSecurityFundamentalService(entitlementService EntitlementService, auditEventService AuditEventService, securityStore stores.SecurityStore,
+	securityStatStore stores.SecurityStatStore, store stores.SecurityFundamentalStore) *securityFundamentalService {
+	return &securityFundamentalService{
+		entitlementService: entitlementService,
+		auditEventService:  auditEventService,
+		securityStore:      securityStore,
+		securityStatStore:  securityStatStore,
+		store:              store,
+	}
+}
+
+func (s *securityFundamentalService) Index(ctx *gofr.Context, f *SecurityFundamentalFilter, page, perPage int) ([]*SecurityFundamental, int, error) {
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, 0, err
+	}
+
+	limit := perPage
+	offset := limit * (page - 1)
+
+	filter := &stores.SecurityFundamentalFilter{
+		PeriodEnd:    f.PeriodEnd,
+		MinPeriodEnd: f.From,
+		MaxPeriodEnd: f.To,
+	}
+
+	if f.SecurityID != 0 {
+		filter.SecurityIDs = []int{f.SecurityID}
+	}
+
+	securityFundamentals, err := s.store.Index(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.store.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if count == 0 {
+		return nil, 0, nil
+	}
+
+	var resp = make([]*SecurityFundamental, len(securityFundamentals))
+
+	for i := range securityFundamentals {
+		resp[i] = s.buildResp(securityFundamentals[i])
+	}
+
+	return resp, count, nil
+}
+
+func (s *securityFundamentalService) Read(ctx *gofr.Context, id int) (*SecurityFundamental, error) {
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, err
+	}
+
+	securityFundamental, err := s.store.Retrieve(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(securityFundamental), nil
+}
+
+func (s *securityFundamentalService) Create(ctx *gofr.Context, payload *SecurityFundamentalCreate) (*SecurityFundamental, error) {
+	if err := authorize(ctx, auth.WriteFundamentals); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.securityStore.Retrieve(ctx, payload.SecurityID); err != nil {
+		return nil, err
+	}
+
+	if payload.PeriodEnd.IsZero() {
+		return nil, http.ErrorMissingParam{Params: []string{"periodEnd"}}
+	}
+
+	if err := validateFundamental(payload.PeriodEnd, payload.ReportedAt, payload.SharesOutstanding); err != nil {
+		return nil, err
+	}
+
+	count, err := s.store.Count(ctx, &stores.SecurityFundamentalFilter{SecurityIDs: []int{payload.SecurityID}, PeriodEnd: payload.PeriodEnd})
+	if err != nil {
+		return nil, err
+	}
+
+	if count > 0 {
+		return nil, &ErrResp{Code: 409, Message: "fundamentals already exist for period ending " + payload.PeriodEnd.Format(time.DateOnly)}
+	}
+
+	model := &stores.SecurityFundamental{
+		SecurityID:        payload.SecurityID,
+		PeriodEnd:         payload.PeriodEnd,
+		ReportedAt:        payload.ReportedAt,
+		SharesOutstanding: payload.SharesOutstanding,
+		Revenue:           payload.Revenue,
+		NetIncome:         payload.NetIncome,
+		EPS:               payload.EPS,
+		BookValue:         payload.BookValue,
+		DividendPerShare:  payload.DividendPerShare,
+		CreatedAt:         time.Now().UTC(),
+		UpdatedAt:         time.Now().UTC(),
+	}
+
+	var securityFundamental *stores.SecurityFundamental
+
+	err = stores.InTx(ctx, func() error {
+		if securityFundamental, err = s.store.Create(ctx, model); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "security-fundamentals", securityFundamental.ID, AuditActionCreate, nil, securityFundamental)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(securityFundamental), nil
+}
+
+func (s *securityFundamentalService) Patch(ctx *gofr.Context, id int, payload *SecurityFundamentalUpdate) (*SecurityFundamental, error) {
+	if err := authorize(ctx, auth.WriteFundamentals); err != nil {
+		return nil, err
+	}
+
+	securityFundamental, err := s.store.Retrieve(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *securityFundamental
+
+	if payload.ReportedAt != nil {
+		securityFundamental.ReportedAt = payload.ReportedAt
+	}
+
+	if payload.SharesOutstanding != 0 {
+		securityFundamental.SharesOutstanding = payload.SharesOutstanding
+	}
+
+	if payload.Revenue != nil {
+		securityFundamental.Revenue = payload.Revenue
+	}
+
+	if payload.NetIncome != nil {
+		securityFundamental.NetIncome = payload.NetIncome
+	}
+
+	if payload.EPS != nil {
+		securityFundamental.EPS = payload.EPS
+	}
+
+	if payload.BookValue != nil {
+		securityFundamental.BookValue = payload.BookValue
+	}
+
+	if payload.DividendPerShare != nil {
+		securityFundamental.DividendPerShare = payload.DividendPerShare
+	}
+
+	err = validateFundamental(securityFundamental.PeriodEnd, securityFundamental.ReportedAt, securityFundamental.SharesOutstanding)
+	if err != nil {
+		return nil, err
+	}
+
+	securityFundamental.UpdatedAt = time.Now().UTC()
+
+	err = stores.InTx(ctx, func() error {
+		if securityFundamental, err = s.store.Update(ctx, id, securityFundamental); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "security-fundamentals", securityFundamental.ID, AuditActionUpdate, &before, securityFundamental)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(securityFundamental), nil
+}
+
+// Valuation prices the fundamentals reported by date. Without a date it uses the LTP, or the last close for plans with a delayed LTP.
+func (s *securityFundamentalService) Valuation(ctx *gofr.Context, securityID int, date time.Time) (*Valuation, error) {
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, err
+	}
+
+	entitlement, err := s.entitlementService.Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	security, err := s.securityStore.Retrieve(ctx, securityID)
+	if err != nil {
+		return nil, err
+	}
+
+	var price float64
+
+	switch {
+	case !date.IsZero():
+		if err := entitlement.CheckDate(date); err != nil {
+			return nil, err
+		}
+
+		securityStats, err := s.securityStatStore.Index(ctx, &stores.SecurityStatFilter{SecurityIDs: []int{securityID}, Dates: []time.Time{date}}, 1, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(securityStats) == 0 {
+			return nil, http.ErrorEntityNotFound{Name: "security-stats", Value: date.Format(time.DateOnly)}
+		}
+
+		price = securityStats[0].Close
+	case entitlement.LTPDelay > 0 || security.LTP == 0:
+		date = time.Now().UTC().Truncate(24 * time.Hour)
+
+		securityStats, err := s.securityStatStore.Index(ctx, &stores.SecurityStatFilter{SecurityIDs: []int{securityID}}, 1, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(securityStats) == 0 {
+			return nil, &ErrResp{Code: 404, Message: "no price available for " + security.Symbol}
+		}
+
+		price = securityStats[0].Close
+	default:
+		date = time.Now().UTC().Truncate(24 * time.Hour)
+		price = security.LTP
+	}
+
+	filter := &stores.SecurityFundamentalFilter{SecurityIDs: []int{securityID}, ReportedBy: date}
+
+	securityFundamentals, err := s.store.Index(ctx, filter, fundamentalTTMPeriods, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	valuation := computeValuation(securityFundamentals, price)
+	if valuation == nil {
+		return nil, &ErrResp{Code: 404, Message: "no fundamentals reported for " + security.Symbol + " by " + date.Format(time.DateOnly)}
+	}
+
+	valuation.SecurityID = securityID
+	valuation.Date = date
+
+	return valuation, nil
+}
+
+func (s *securityFundamentalService) buildResp(model *stores.SecurityFundamental) *SecurityFundamental {
+	resp := &SecurityFundamental{
+		ID:                model.ID,
+		SecurityID:        model.SecurityID,
+		PeriodEnd:         model.PeriodEnd,
+		ReportedAt:        model.ReportedAt,
+		SharesOutstanding: model.SharesOutstanding,
+		Revenue:           model.Revenue,
+		NetIncome:         model.NetIncome,
+		EPS:               model.EPS,
+		BookValue:         model.BookValue,
+		DividendPerShare:  model.DividendPerShare,
+		CreatedAt:         model.CreatedAt,
+		UpdatedAt:         model.UpdatedAt,
+	}
+
+	return resp
+}
+
+func validateFundamental(periodEnd time.Time, reportedAt *time.Time, sharesOutstanding int64) error {
+	if sharesOutstanding <= 0 {
+		return http.ErrorInvalidParam{Params: []string{"sharesOutstanding"}}
+	}
+
+	if reportedAt != nil && reportedAt.Before(periodEnd) {
+		return &ErrResp{Code: 400, Message: "reportedAt cannot be before periodEnd"}
+	}
+
+	return nil
+}
+
+// computeValuation derives market cap and valuation ratios from quarterly results ordered latest first. Trailing figures are summed over
+// the quarters of the last year, and P/E is only reported when all four quarters carry a positive total EPS.
+func computeValuation(securityFundamentals []*stores.SecurityFundamental, price float64) *Valuation {
+	if len(securityFundamentals) == 0 {
+		return nil
+	}
+
+	latest := securityFundamentals[0]
+	yearStart := latest.PeriodEnd.AddDate(-1, 0, 0)
+
+	valuation := &Valuation{
+		Price:             price,
+		PeriodEnd:         latest.PeriodEnd,
+		SharesOutstanding: latest.SharesOutstanding,
+		MarketCap:         price * float64(latest.SharesOutstanding),
+	}
+
+	var (
+		epsTTM, dividendTTM       float64
+		epsPeriods, dividendCount int
+	)
+
+	for _, sf := range securityFundamentals {
+		if !sf.PeriodEnd.After(yearStart) {
+			break
+		}
+
+		if sf.EPS != nil {
+			epsTTM += *sf.EPS
+			epsPeriods++
+		}
+
+		if sf.DividendPerShare != nil {
+			dividendTTM += *sf.DividendPerShare
+			dividendCount++
+		}
+	}
+
+	if epsPeriods == fundamentalTTMPeriods {
+		valuation.EPSTTM = float64Ptr(epsTTM)
+
+		if epsTTM > 0 && price > 0 {
+			valuation.PE = float64Ptr(price / epsTTM)
+		}
+	}
+
+	if latest.BookValue != nil && latest.SharesOutstanding > 0 {
+		bookValuePerShare := *latest.BookValue / float64(latest.SharesOutstanding)
+		valuation.BookValuePerShare = float64Ptr(bookValuePerShare)
+
+		if bookValuePerShare > 0 && price > 0 {
+			valuation.PB = float64Ptr(price / bookValuePerShare)
+		}
+	}
+
+	if dividendCount > 0 && price > 0 {
+		valuation.DividendYield = float64Ptr(dividendTTM / price * 100)
+	}
+
+	return valuation
+}
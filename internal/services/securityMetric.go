@@ -3,10 +3,12 @@ package services
 import (
 	"fmt"
 	"math"
+	"slices"
 	"time"
 
 	"gofr.dev/pkg/gofr"
 
+	"github.com/stratifyr/security-service/internal/auth"
 	"github.com/stratifyr/security-service/internal/stores"
 )
 
@@ -15,6 +17,7 @@ type SecurityMetricService interface {
 	Read(ctx *gofr.Context, id int) (*SecurityMetric, error)
 	Create(ctx *gofr.Context, payload *SecurityMetricCreate) (*SecurityMetric, error)
 	Patch(ctx *gofr.Context, id int, payload *SecurityMetricUpdate) (*SecurityMetric, error)
+	BulkUpsert(ctx *gofr.Context, payload []*SecurityMetricCreate) ([]*BulkUpsertResult, error)
 }
 
 type SecurityMetricFilter struct {
@@ -34,7 +37,6 @@ type SecurityMetric struct {
 }
 
 type SecurityMetricCreate struct {
-	UserID     int
 	SecurityID int
 	MetricID   int
 	Date       time.Time
@@ -42,29 +44,61 @@ type SecurityMetricCreate struct {
 }
 
 type SecurityMetricUpdate struct {
-	UserID         int
 	Value          float64
 	RecomputeValue bool
 }
 
+// metricInputs holds what the values of a bulk upsert are computed from: the market days of the batch with their look back, the stats
+// of its securities on them, and the fundamentals of its securities newest period first.
+type metricInputs struct {
+	marketDays   []time.Time
+	dayIndex     map[string]int
+	stats        map[string]*stores.SecurityStat
+	fundamentals map[int][]*stores.SecurityFundamental
+}
+
 type securityMetricService struct {
-	marketDayService  MarketDayService
-	metricStore       stores.MetricStore
-	securityStatStore stores.SecurityStatStore
-	store             stores.SecurityMetricStore
+	marketDayService         MarketDayService
+	entitlementService       EntitlementService
+	auditEventService        AuditEventService
+	domainEventService       DomainEventService
+	alertService             AlertService
+	metricStore              stores.MetricStore
+	securityStore            stores.SecurityStore
+	securityStatStore        stores.SecurityStatStore
+	securityFundamentalStore stores.SecurityFundamentalStore
+	store                    stores.SecurityMetricStore
+	priceHub                 PriceHub
 }
 
-func NewSecurityMetricService(marketDayService MarketDayService, metricStore stores.MetricStore,
-	securityStatStore stores.SecurityStatStore, store stores.SecurityMetricStore) *securityMetricService {
+func NewSecurityMetricService(marketDayService MarketDayService, entitlementService EntitlementService, auditEventService AuditEventService,
+	domainEventService DomainEventService, alertService AlertService, metricStore stores.MetricStore, securityStore stores.SecurityStore,
+	securityStatStore stores.SecurityStatStore, securityFundamentalStore stores.SecurityFundamentalStore, store stores.SecurityMetricStore, priceHub PriceHub) *securityMetricService {
 	return &securityMetricService{
-		marketDayService:  marketDayService,
-		metricStore:       metricStore,
-		securityStatStore: securityStatStore,
-		store:             store,
+		marketDayService:         marketDayService,
+		entitlementService:       entitlementService,
+		auditEventService:        auditEventService,
+		domainEventService:       domainEventService,
+		alertService:             alertService,
+		metricStore:              metricStore,
+		securityStore:            securityStore,
+		securityStatStore:        securityStatStore,
+		securityFundamentalStore: securityFundamentalStore,
+		store:                    store,
+		priceHub:                 priceHub,
 	}
 }
 
 func (s *securityMetricService) Index(ctx *gofr.Context, f *SecurityMetricFilter, page, perPage int) ([]*SecurityMetric, int, error) {
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, 0, err
+	}
+
+	entitlement, err := s.entitlementService.Resolve(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
 	limit := perPage
 	offset := limit * (page - 1)
 
@@ -74,6 +108,12 @@ func (s *securityMetricService) Index(ctx *gofr.Context, f *SecurityMetricFilter
 		Date:       f.Date,
 	}
 
+	if f.Date.IsZero() {
+		filter.MinDate = entitlement.HistoryStart()
+	} else if err := entitlement.CheckDate(f.Date); err != nil {
+		return nil, 0, err
+	}
+
 	securityMetrics, err := s.store.Index(ctx, filter, limit, offset)
 	if err != nil {
 		return nil, 0, err
@@ -98,17 +138,30 @@ func (s *securityMetricService) Index(ctx *gofr.Context, f *SecurityMetricFilter
 }
 
 func (s *securityMetricService) Read(ctx *gofr.Context, id int) (*SecurityMetric, error) {
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, err
+	}
+
+	entitlement, err := s.entitlementService.Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	securityMetric, err := s.store.Retrieve(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := entitlement.CheckDate(securityMetric.Date); err != nil {
+		return nil, err
+	}
+
 	return s.buildResp(securityMetric), nil
 }
 
 func (s *securityMetricService) Create(ctx *gofr.Context, payload *SecurityMetricCreate) (*SecurityMetric, error) {
-	if payload.UserID != 1 {
-		return nil, &ErrResp{Code: 403, Message: "Forbidden"}
+	if err := authorize(ctx, auth.WriteMetrics); err != nil {
+		return nil, err
 	}
 
 	marketDays, count, err := s.marketDayService.Index(ctx,
@@ -120,6 +173,10 @@ func (s *securityMetricService) Create(ctx *gofr.Context, payload *SecurityMetri
 		return nil, &ErrResp{Code: 400, Message: "cannot add metric for market holiday - " + payload.Date.Format(time.DateOnly)}
 	}
 
+	if err = s.checkApplicable(ctx, payload.SecurityID, payload.MetricID); err != nil {
+		return nil, err
+	}
+
 	if payload.Value == 0 {
 		payload.Value, err = s.computeMetricValue(ctx, payload.SecurityID, payload.MetricID, payload.Date)
 		if err != nil {
@@ -136,17 +193,33 @@ func (s *securityMetricService) Create(ctx *gofr.Context, payload *SecurityMetri
 		UpdatedAt:  time.Now().UTC(),
 	}
 
-	securityMetric, err := s.store.Create(ctx, model)
+	var securityMetric *stores.SecurityMetric
+
+	err = stores.InTx(ctx, func() error {
+		if securityMetric, err = s.store.Create(ctx, model); err != nil {
+			return err
+		}
+
+		if err := s.auditEventService.Record(ctx, "security-metrics", securityMetric.ID, AuditActionCreate, nil, securityMetric); err != nil {
+			return err
+		}
+
+		return s.emitUpserted(ctx, securityMetric)
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	s.alertService.EvaluateMetrics(ctx, []*stores.SecurityMetric{securityMetric})
+
+	s.publishSignal(ctx, securityMetric)
+
 	return s.buildResp(securityMetric), nil
 }
 
 func (s *securityMetricService) Patch(ctx *gofr.Context, id int, payload *SecurityMetricUpdate) (*SecurityMetric, error) {
-	if payload.UserID != 1 {
-		return nil, &ErrResp{Code: 403, Message: "Forbidden"}
+	if err := authorize(ctx, auth.WriteMetrics); err != nil {
+		return nil, err
 	}
 
 	securityMetric, err := s.store.Retrieve(ctx, id)
@@ -154,6 +227,8 @@ func (s *securityMetricService) Patch(ctx *gofr.Context, id int, payload *Securi
 		return nil, err
 	}
 
+	before := *securityMetric
+
 	if payload.Value != 0 {
 		securityMetric.Value = payload.Value
 	}
@@ -165,14 +240,349 @@ func (s *securityMetricService) Patch(ctx *gofr.Context, id int, payload *Securi
 		}
 	}
 
-	securityMetric, err = s.store.Update(ctx, id, securityMetric)
+	err = stores.InTx(ctx, func() error {
+		if securityMetric, err = s.store.Update(ctx, id, securityMetric); err != nil {
+			return err
+		}
+
+		if err := s.auditEventService.Record(ctx, "security-metrics", securityMetric.ID, AuditActionUpdate, &before, securityMetric); err != nil {
+			return err
+		}
+
+		return s.emitUpserted(ctx, securityMetric)
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	s.alertService.EvaluateMetrics(ctx, []*stores.SecurityMetric{securityMetric})
+
+	if before.Value != securityMetric.Value {
+		s.publishSignal(ctx, securityMetric)
+	}
+
 	return s.buildResp(securityMetric), nil
 }
 
+// BulkUpsert validates every row up front, computing values left at zero, and reports the ones that cannot be written as failed, the
+// rest are written together on their security, metric and date so a batch either lands whole or not at all.
+func (s *securityMetricService) BulkUpsert(ctx *gofr.Context, payload []*SecurityMetricCreate) ([]*BulkUpsertResult, error) {
+	if err := authorize(ctx, auth.WriteMetrics); err != nil {
+		return nil, err
+	}
+
+	if len(payload) > bulkUpsertLimit {
+		return nil, &ErrResp{Code: 400, Message: fmt.Sprintf("a bulk upsert takes at most %d rows", bulkUpsertLimit)}
+	}
+
+	if len(payload) == 0 {
+		return nil, nil
+	}
+
+	var securityIDs, metricIDs []int
+
+	startDate, endDate := payload[0].Date, payload[0].Date
+
+	for i := range payload {
+		if !slices.Contains(securityIDs, payload[i].SecurityID) {
+			securityIDs = append(securityIDs, payload[i].SecurityID)
+		}
+
+		if !slices.Contains(metricIDs, payload[i].MetricID) {
+			metricIDs = append(metricIDs, payload[i].MetricID)
+		}
+
+		if payload[i].Date.Before(startDate) {
+			startDate = payload[i].Date
+		}
+
+		if payload[i].Date.After(endDate) {
+			endDate = payload[i].Date
+		}
+	}
+
+	marketDaysSet, err := bulkMarketDays(ctx, s.marketDayService, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	securities, err := s.securityStore.Index(ctx, &stores.SecurityFilter{IDs: securityIDs}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	securitiesMap := make(map[int]*stores.Security)
+
+	for i := range securities {
+		securitiesMap[securities[i].ID] = securities[i]
+	}
+
+	metrics, err := s.metricStore.Index(ctx, &stores.MetricFilter{}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	metricsMap := make(map[int]*stores.Metric)
+
+	for i := range metrics {
+		metricsMap[metrics[i].ID] = metrics[i]
+	}
+
+	inputs, err := s.loadMetricInputs(ctx, payload, metricsMap)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &stores.SecurityMetricFilter{SecurityIDs: securityIDs, MetricIDs: metricIDs, MinDate: startDate, MaxDate: endDate}
+
+	existing, err := s.store.Index(ctx, filter, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	existingMap := make(map[string]*stores.SecurityMetric)
+
+	for i := range existing {
+		existingMap[securityMetricKey(existing[i].SecurityID, existing[i].MetricID, existing[i].Date)] = existing[i]
+	}
+
+	var (
+		results = make([]*BulkUpsertResult, len(payload))
+		models  []*stores.SecurityMetric
+		indices []int
+		seen    = make(map[string]bool)
+	)
+
+	for i := range payload {
+		key := securityMetricKey(payload[i].SecurityID, payload[i].MetricID, payload[i].Date)
+		security, metric := securitiesMap[payload[i].SecurityID], metricsMap[payload[i].MetricID]
+		results[i] = &BulkUpsertResult{Index: i, Status: BulkUpsertFailed}
+
+		switch {
+		case security == nil:
+			results[i].Error = fmt.Sprintf("security %d not found", payload[i].SecurityID)
+		case metric == nil:
+			results[i].Error = fmt.Sprintf("metric %d not found", payload[i].MetricID)
+		case !marketDaysSet[payload[i].Date.Format(time.DateOnly)]:
+			results[i].Error = "cannot add metric for market holiday - " + payload[i].Date.Format(time.DateOnly)
+		case slices.Contains(InstrumentTypeExcludedIndicators[security.InstrumentType], metric.Indicator):
+			results[i].Error = fmt.Sprintf("%s does not apply to instrument type %s", metric.Name, security.InstrumentType.String())
+		case seen[key]:
+			results[i].Error = "duplicate row for security, metric and date"
+		}
+
+		if results[i].Error != "" {
+			continue
+		}
+
+		value := payload[i].Value
+
+		if value == 0 {
+			securityID, date := payload[i].SecurityID, payload[i].Date
+
+			value, err = s.metricValue(metric, inputs.lastNStats(securityID, date, metric.Period),
+				func() ([]*stores.SecurityFundamental, error) {
+					return inputs.fundamentalsReportedBy(securityID, date), nil
+				})
+			if err != nil {
+				results[i].Error = err.Error()
+
+				continue
+			}
+		}
+
+		seen[key] = true
+
+		models = append(models, &stores.SecurityMetric{
+			SecurityID: payload[i].SecurityID,
+			MetricID:   payload[i].MetricID,
+			Date:       payload[i].Date,
+			Value:      value,
+			CreatedAt:  time.Now().UTC(),
+			UpdatedAt:  time.Now().UTC(),
+		})
+		indices = append(indices, i)
+	}
+
+	if len(models) == 0 {
+		return results, nil
+	}
+
+	err = stores.InTx(ctx, func() error {
+		ids, err := s.store.Upsert(ctx, models)
+		if err != nil {
+			return err
+		}
+
+		var (
+			auditRecords = make([]*AuditRecord, len(models))
+			events       = make([]any, len(models))
+		)
+
+		for j, model := range models {
+			model.ID = ids[j]
+			result := results[indices[j]]
+			result.ID = model.ID
+
+			if before, ok := existingMap[securityMetricKey(model.SecurityID, model.MetricID, model.Date)]; ok {
+				model.CreatedAt = before.CreatedAt
+				result.Status = BulkUpsertUpdated
+				auditRecords[j] = &AuditRecord{EntityID: model.ID, Action: AuditActionUpdate, Before: before, After: model}
+			} else {
+				result.Status = BulkUpsertCreated
+				auditRecords[j] = &AuditRecord{EntityID: model.ID, Action: AuditActionCreate, After: model}
+			}
+
+			events[j] = securityMetricEvent(model)
+		}
+
+		if err = s.auditEventService.RecordBatch(ctx, "security-metrics", auditRecords); err != nil {
+			return err
+		}
+
+		return s.domainEventService.EmitBatch(ctx, TopicSecurityMetricUpserted, events)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.alertService.EvaluateMetrics(ctx, models)
+
+	for _, model := range models {
+		if before, ok := existingMap[securityMetricKey(model.SecurityID, model.MetricID, model.Date)]; !ok || before.Value != model.Value {
+			s.publishMetricSignal(model, metricsMap[model.MetricID])
+		}
+	}
+
+	return results, nil
+}
+
+// loadMetricInputs reads the stats and fundamentals that the values payload leaves at zero are computed from, with a query each for
+// the whole batch rather than per row.
+func (s *securityMetricService) loadMetricInputs(ctx *gofr.Context, payload []*SecurityMetricCreate,
+	metricsMap map[int]*stores.Metric) (*metricInputs, error) {
+	var (
+		inputs = &metricInputs{
+			dayIndex:     make(map[string]int),
+			stats:        make(map[string]*stores.SecurityStat),
+			fundamentals: make(map[int][]*stores.SecurityFundamental),
+		}
+		securityIDs        []int
+		startDate, endDate time.Time
+		period             = 1
+		valuation          bool
+	)
+
+	for i := range payload {
+		metric := metricsMap[payload[i].MetricID]
+		if payload[i].Value != 0 || metric == nil {
+			continue
+		}
+
+		if !slices.Contains(securityIDs, payload[i].SecurityID) {
+			securityIDs = append(securityIDs, payload[i].SecurityID)
+		}
+
+		if startDate.IsZero() || payload[i].Date.Before(startDate) {
+			startDate = payload[i].Date
+		}
+
+		if payload[i].Date.After(endDate) {
+			endDate = payload[i].Date
+		}
+
+		period = max(period, metric.Period)
+		valuation = valuation || slices.Contains([]stores.MetricType{stores.PE, stores.PB, stores.DY, stores.MCAP}, metric.Type)
+	}
+
+	if len(securityIDs) == 0 {
+		return inputs, nil
+	}
+
+	marketDays, err := marketDaysWithLookback(ctx, s.marketDayService, period, startDate, endDate)
+	if err != nil || len(marketDays) == 0 {
+		return inputs, err
+	}
+
+	inputs.marketDays = marketDays
+
+	for i := range marketDays {
+		inputs.dayIndex[marketDays[i].Format(time.DateOnly)] = i
+	}
+
+	filter := &stores.SecurityStatFilter{SecurityIDs: securityIDs, MinDate: marketDays[0], MaxDate: endDate}
+
+	securityStats, err := s.securityStatStore.Index(ctx, filter, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range securityStats {
+		inputs.stats[securityStatKey(securityStats[i].SecurityID, securityStats[i].Date)] = securityStats[i]
+	}
+
+	if !valuation {
+		return inputs, nil
+	}
+
+	securityFundamentals, err := s.securityFundamentalStore.Index(ctx,
+		&stores.SecurityFundamentalFilter{SecurityIDs: securityIDs, ReportedBy: endDate}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range securityFundamentals {
+		inputs.fundamentals[securityFundamentals[i].SecurityID] = append(inputs.fundamentals[securityFundamentals[i].SecurityID],
+			securityFundamentals[i])
+	}
+
+	return inputs, nil
+}
+
+func (s *securityMetricService) checkApplicable(ctx *gofr.Context, securityID, metricID int) error {
+	security, err := s.securityStore.Retrieve(ctx, securityID)
+	if err != nil {
+		return err
+	}
+
+	metric, err := s.metricStore.Retrieve(ctx, metricID)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(InstrumentTypeExcludedIndicators[security.InstrumentType], metric.Indicator) {
+		return &ErrResp{Code: 400, Message: fmt.Sprintf("%s does not apply to instrument type %s", metric.Name, security.InstrumentType.String())}
+	}
+
+	return nil
+}
+
+func (s *securityMetricService) emitUpserted(ctx *gofr.Context, securityMetric *stores.SecurityMetric) error {
+	return s.domainEventService.Emit(ctx, TopicSecurityMetricUpserted, securityMetricEvent(securityMetric))
+}
+
+func (s *securityMetricService) publishSignal(ctx *gofr.Context, securityMetric *stores.SecurityMetric) {
+	metric, err := s.metricStore.Retrieve(ctx, securityMetric.MetricID)
+	if err != nil {
+		ctx.Logger.Warnf("failed to publish signal for security metric %d, err: %v", securityMetric.ID, err)
+
+		return
+	}
+
+	s.publishMetricSignal(securityMetric, metric)
+}
+
+func (s *securityMetricService) publishMetricSignal(securityMetric *stores.SecurityMetric, metric *stores.Metric) {
+	s.priceHub.PublishSignal(&SignalUpdate{
+		SecurityID: securityMetric.SecurityID,
+		MetricID:   metric.ID,
+		Metric:     metric.Name,
+		MetricTier: metric.Tier,
+		Date:       securityMetric.Date,
+		Value:      securityMetric.Value,
+	})
+}
+
 func (s *securityMetricService) buildResp(model *stores.SecurityMetric) *SecurityMetric {
 	resp := &SecurityMetric{
 		ID:         model.ID,
@@ -193,13 +603,11 @@ func (s *securityMetricService) computeMetricValue(ctx *gofr.Context, securityID
 		return 0, err
 	}
 
-	n := metric.Period
-
 	marketDays, _, err := s.marketDayService.Index(ctx,
 		&MarketDayFilter{LastNDaysFromReference: &struct {
 			N         int
 			Reference time.Time
-		}{N: n, Reference: date}})
+		}{N: metric.Period, Reference: date}})
 	if err != nil {
 		return 0, err
 	}
@@ -209,28 +617,74 @@ func (s *securityMetricService) computeMetricValue(ctx *gofr.Context, securityID
 		return 0, err
 	}
 
-	if len(securityStats) != n {
+	return s.metricValue(metric, securityStats, func() ([]*stores.SecurityFundamental, error) {
+		filter := &stores.SecurityFundamentalFilter{SecurityIDs: []int{securityID}, ReportedBy: date}
+
+		return s.securityFundamentalStore.Index(ctx, filter, fundamentalTTMPeriods, 0)
+	})
+}
+
+// metricValue computes metric from the stats of its last Period market days, newest first. fundamentals returns the results reported
+// by the newest of those days and is only called for valuation metrics.
+func (s *securityMetricService) metricValue(metric *stores.Metric, lastNStats []*stores.SecurityStat,
+	fundamentals func() ([]*stores.SecurityFundamental, error)) (float64, error) {
+	if len(lastNStats) == 0 || len(lastNStats) != metric.Period {
 		return 0, &ErrResp{Code: 400, Message: fmt.Sprintf("Cannot compute %s_%d, not enough data", metric.Type.String(), metric.Period)}
 	}
 
 	switch metric.Type {
 	case stores.SMA:
-		return s.computeSMA(metric.Period, securityStats), nil
+		return s.computeSMA(metric.Period, lastNStats), nil
 	case stores.EMA:
-		return s.computeEMA(metric.Period, securityStats), nil
+		return s.computeEMA(metric.Period, lastNStats), nil
 	case stores.RSI:
-		return s.computeRSI(metric.Period, securityStats), nil
+		return s.computeRSI(metric.Period, lastNStats), nil
 	case stores.ROC:
-		return s.computeROC(metric.Period, securityStats), nil
+		return s.computeROC(metric.Period, lastNStats), nil
 	case stores.ATR:
-		return s.computeATR(metric.Period, securityStats), nil
+		return s.computeATR(metric.Period, lastNStats), nil
 	case stores.VMA:
-		return s.computeVMA(metric.Period, securityStats), nil
+		return s.computeVMA(metric.Period, lastNStats), nil
+	case stores.PE, stores.PB, stores.DY, stores.MCAP:
+		securityFundamentals, err := fundamentals()
+		if err != nil {
+			return 0, err
+		}
+
+		return s.computeFundamental(metric, securityFundamentals, lastNStats[0].Close)
 	default:
 		return 0, nil
 	}
 }
 
+// computeFundamental values a security at close using the results reported by the day of close, so that history carries no look-ahead.
+func (s *securityMetricService) computeFundamental(metric *stores.Metric, securityFundamentals []*stores.SecurityFundamental, close float64) (float64, error) {
+	valuation := computeValuation(securityFundamentals, close)
+
+	if valuation == nil {
+		return 0, &ErrResp{Code: 400, Message: fmt.Sprintf("Cannot compute %s_%d, no fundamentals reported", metric.Type.String(), metric.Period)}
+	}
+
+	var value *float64
+
+	switch metric.Type {
+	case stores.PE:
+		value = valuation.PE
+	case stores.PB:
+		value = valuation.PB
+	case stores.DY:
+		value = valuation.DividendYield
+	case stores.MCAP:
+		value = &valuation.MarketCap
+	}
+
+	if value == nil {
+		return 0, &ErrResp{Code: 400, Message: fmt.Sprintf("Cannot compute %s_%d, not enough data", metric.Type.String(), metric.Period)}
+	}
+
+	return *value, nil
+}
+
 func (s *securityMetricService) computeSMA(n int, lastNStats []*stores.SecurityStat) float64 {
 	var sumPrice float64
 
@@ -317,3 +771,57 @@ func (s *securityMetricService) computeVMA(n int, lastNStats []*stores.SecurityS
 
 	return sumVolume / float64(n)
 }
+
+// lastNStats returns the stats of securityID on the n market days up to date, newest first, skipping days without a stat.
+func (in *metricInputs) lastNStats(securityID int, date time.Time, n int) []*stores.SecurityStat {
+	i, ok := in.dayIndex[date.Format(time.DateOnly)]
+	if !ok {
+		return nil
+	}
+
+	var securityStats []*stores.SecurityStat
+
+	for j := i; j >= 0 && j > i-n; j-- {
+		if ss, ok := in.stats[securityStatKey(securityID, in.marketDays[j])]; ok {
+			securityStats = append(securityStats, ss)
+		}
+	}
+
+	return securityStats
+}
+
+// fundamentalsReportedBy returns the latest fundamentalTTMPeriods results of securityID reported by date.
+func (in *metricInputs) fundamentalsReportedBy(securityID int, date time.Time) []*stores.SecurityFundamental {
+	var securityFundamentals []*stores.SecurityFundamental
+
+	for _, sf := range in.fundamentals[securityID] {
+		reportedAt := sf.PeriodEnd
+		if sf.ReportedAt != nil {
+			reportedAt = *sf.ReportedAt
+		}
+
+		if reportedAt.Format(time.DateOnly) > date.Format(time.DateOnly) {
+			continue
+		}
+
+		if securityFundamentals = append(securityFundamentals, sf); len(securityFundamentals) == fundamentalTTMPeriods {
+			break
+		}
+	}
+
+	return securityFundamentals
+}
+
+func securityMetricEvent(securityMetric *stores.SecurityMetric) *SecurityMetricEvent {
+	return &SecurityMetricEvent{
+		ID:         securityMetric.ID,
+		SecurityID: securityMetric.SecurityID,
+		MetricID:   securityMetric.MetricID,
+		Date:       securityMetric.Date.Format(time.DateOnly),
+		Value:      securityMetric.Value,
+	}
+}
+
+func securityMetricKey(securityID, metricID int, date time.Time) string {
+	return fmt.Sprintf("%d:%d:%s", securityID, metricID, date.Format(time.DateOnly))
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stratifyr/security-service/internal/stores"
+)
+
+func TestMetricInputs(t *testing.T) {
+	date := func(value string) time.Time {
+		d, _ := time.Parse(time.DateOnly, value)
+		return d
+	}
+
+	inputs := &metricInputs{
+		marketDays:   []time.Time{date("2024-01-01"), date("2024-01-02"), date("2024-01-03"), date("2024-01-05")},
+		dayIndex:     map[string]int{"2024-01-01": 0, "2024-01-02": 1, "2024-01-03": 2, "2024-01-05": 3},
+		stats:        make(map[string]*stores.SecurityStat),
+		fundamentals: make(map[int][]*stores.SecurityFundamental),
+	}
+
+	for _, d := range []string{"2024-01-01", "2024-01-02", "2024-01-05"} {
+		inputs.stats[securityStatKey(1, date(d))] = &stores.SecurityStat{SecurityID: 1, Date: date(d)}
+	}
+
+	reportedAt := date("2024-01-04")
+
+	inputs.fundamentals[1] = []*stores.SecurityFundamental{
+		{ID: 4, PeriodEnd: date("2023-12-31"), ReportedAt: &reportedAt},
+		{ID: 3, PeriodEnd: date("2023-09-30")},
+		{ID: 2, PeriodEnd: date("2023-06-30")},
+		{ID: 1, PeriodEnd: date("2023-03-31")},
+	}
+
+	lastNStats := []struct {
+		name      string
+		date      string
+		n         int
+		wantDates []string
+	}{
+		{name: "every day has a stat", date: "2024-01-02", n: 2, wantDates: []string{"2024-01-02", "2024-01-01"}},
+		{name: "missing stat is skipped", date: "2024-01-05", n: 3, wantDates: []string{"2024-01-05", "2024-01-02"}},
+		{name: "look back runs out", date: "2024-01-02", n: 5, wantDates: []string{"2024-01-02", "2024-01-01"}},
+		{name: "not a market day", date: "2024-01-04", n: 1},
+	}
+
+	for _, tc := range lastNStats {
+		t.Run(tc.name, func(t *testing.T) {
+			securityStats := inputs.lastNStats(1, date(tc.date), tc.n)
+
+			if len(securityStats) != len(tc.wantDates) {
+				t.Fatalf("got %d stats, want %d", len(securityStats), len(tc.wantDates))
+			}
+
+			for i := range securityStats {
+				if got := securityStats[i].Date.Format(time.DateOnly); got != tc.wantDates[i] {
+					t.Errorf("stat %d is on %s, want %s", i, got, tc.wantDates[i])
+				}
+			}
+		})
+	}
+
+	fundamentalsReportedBy := []struct {
+		date    string
+		wantIDs []int
+	}{
+		{date: "2024-01-03", wantIDs: []int{3, 2, 1}},
+		{date: "2024-01-04", wantIDs: []int{4, 3, 2, 1}},
+		{date: "2023-07-15", wantIDs: []int{2, 1}},
+		{date: "2023-01-01"},
+	}
+
+	for _, tc := range fundamentalsReportedBy {
+		securityFundamentals := inputs.fundamentalsReportedBy(1, date(tc.date))
+
+		var ids []int
+
+		for i := range securityFundamentals {
+			ids = append(ids, securityFundamentals[i].ID)
+		}
+
+		if len(ids) != len(tc.wantIDs) {
+			t.Errorf("fundamentals reported by %s = %v, want %v", tc.date, ids, tc.wantIDs)
+
+			continue
+		}
+
+		for i := range ids {
+			if ids[i] != tc.wantIDs[i] {
+				t.Errorf("fundamentals reported by %s = %v, want %v", tc.date, ids, tc.wantIDs)
+
+				break
+			}
+		}
+	}
+}
@@ -1,10 +1,13 @@
 package services
 
 import (
+	"fmt"
+	"slices"
 	"time"
 
 	"gofr.dev/pkg/gofr"
 
+	"github.com/stratifyr/security-service/internal/auth"
 	"github.com/stratifyr/security-service/internal/stores"
 )
 
@@ -13,6 +16,24 @@ type SecurityStatService interface {
 	Read(ctx *gofr.Context, id int) (*SecurityStat, error)
 	Create(ctx *gofr.Context, payload *SecurityStatCreate) (*SecurityStat, error)
 	Patch(ctx *gofr.Context, id int, payload *SecurityStatUpdate) (*SecurityStat, error)
+	BulkUpsert(ctx *gofr.Context, payload []*SecurityStatCreate) ([]*BulkUpsertResult, error)
+	Import(ctx *gofr.Context, payload []*SecurityStatImport) ([]*BulkUpsertResult, error)
+}
+
+const (
+	BulkUpsertCreated = "created"
+	BulkUpsertUpdated = "updated"
+	BulkUpsertFailed  = "failed"
+)
+
+const bulkUpsertLimit = 5000
+
+// BulkUpsertResult reports the outcome of the row at Index of a bulk upsert request.
+type BulkUpsertResult struct {
+	Index  int
+	ID     int
+	Status string
+	Error  string
 }
 
 type SecurityStatFilter struct {
@@ -34,7 +55,6 @@ type SecurityStat struct {
 }
 
 type SecurityStatCreate struct {
-	UserID     int
 	SecurityID int
 	Date       time.Time
 	Open       float64
@@ -44,8 +64,19 @@ type SecurityStatCreate struct {
 	Volume     int
 }
 
+// SecurityStatImport is a stat identified by the security's ISIN or symbol rather than its id, ISIN wins when both are set.
+type SecurityStatImport struct {
+	ISIN   string
+	Symbol string
+	Date   time.Time
+	Open   float64
+	Close  float64
+	High   float64
+	Low    float64
+	Volume int
+}
+
 type SecurityStatUpdate struct {
-	UserID int
 	Open   float64
 	Close  float64
 	High   float64
@@ -54,22 +85,48 @@ type SecurityStatUpdate struct {
 }
 
 type securityStatService struct {
-	marketDayService MarketDayService
-	store            stores.SecurityStatStore
+	marketDayService   MarketDayService
+	entitlementService EntitlementService
+	auditEventService  AuditEventService
+	domainEventService DomainEventService
+	alertService       AlertService
+	securityStore      stores.SecurityStore
+	store              stores.SecurityStatStore
 }
 
-func NewSecurityStatService(marketDayService MarketDayService, store stores.SecurityStatStore) *securityStatService {
+func NewSecurityStatService(marketDayService MarketDayService, entitlementService EntitlementService, auditEventService AuditEventService,
+	domainEventService DomainEventService, alertService AlertService, securityStore stores.SecurityStore, store stores.SecurityStatStore) *securityStatService {
 	return &securityStatService{
-		marketDayService: marketDayService,
-		store:            store,
+		marketDayService:   marketDayService,
+		entitlementService: entitlementService,
+		auditEventService:  auditEventService,
+		domainEventService: domainEventService,
+		alertService:       alertService,
+		securityStore:      securityStore,
+		store:              store,
 	}
 }
 
 func (s *securityStatService) Index(ctx *gofr.Context, f *SecurityStatFilter, page, perPage int) ([]*SecurityStat, int, error) {
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, 0, err
+	}
+
+	entitlement, err := s.entitlementService.Resolve(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if f.Date != (time.Time{}) {
+		if err := entitlement.CheckDate(f.Date); err != nil {
+			return nil, 0, err
+		}
+	}
+
 	limit := perPage
 	offset := limit * (page - 1)
 
-	var filter stores.SecurityStatFilter
+	filter := stores.SecurityStatFilter{MinDate: entitlement.HistoryStart()}
 
 	if f.SecurityID != 0 {
 		filter.SecurityIDs = []int{f.SecurityID}
@@ -103,17 +160,30 @@ func (s *securityStatService) Index(ctx *gofr.Context, f *SecurityStatFilter, pa
 }
 
 func (s *securityStatService) Read(ctx *gofr.Context, id int) (*SecurityStat, error) {
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, err
+	}
+
+	entitlement, err := s.entitlementService.Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	securityStat, err := s.store.Retrieve(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := entitlement.CheckDate(securityStat.Date); err != nil {
+		return nil, err
+	}
+
 	return s.buildResp(securityStat), nil
 }
 
 func (s *securityStatService) Create(ctx *gofr.Context, payload *SecurityStatCreate) (*SecurityStat, error) {
-	if payload.UserID != 1 {
-		return nil, &ErrResp{Code: 403, Message: "Forbidden"}
+	if err := authorize(ctx, auth.WriteStats); err != nil {
+		return nil, err
 	}
 
 	marketDays, count, err := s.marketDayService.Index(ctx,
@@ -137,17 +207,31 @@ func (s *securityStatService) Create(ctx *gofr.Context, payload *SecurityStatCre
 		UpdatedAt:  time.Now().UTC(),
 	}
 
-	securityStat, err := s.store.Create(ctx, model)
+	var securityStat *stores.SecurityStat
+
+	err = stores.InTx(ctx, func() error {
+		if securityStat, err = s.store.Create(ctx, model); err != nil {
+			return err
+		}
+
+		if err := s.auditEventService.Record(ctx, "security-stats", securityStat.ID, AuditActionCreate, nil, securityStat); err != nil {
+			return err
+		}
+
+		return s.emitUpserted(ctx, securityStat)
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	s.alertService.EvaluateStats(ctx, []*stores.SecurityStat{securityStat})
+
 	return s.buildResp(securityStat), nil
 }
 
 func (s *securityStatService) Patch(ctx *gofr.Context, id int, payload *SecurityStatUpdate) (*SecurityStat, error) {
-	if payload.UserID != 1 {
-		return nil, &ErrResp{Code: 403, Message: "Forbidden"}
+	if err := authorize(ctx, auth.WriteStats); err != nil {
+		return nil, err
 	}
 
 	securityStat, err := s.store.Retrieve(ctx, id)
@@ -155,6 +239,8 @@ func (s *securityStatService) Patch(ctx *gofr.Context, id int, payload *Security
 		return nil, err
 	}
 
+	before := *securityStat
+
 	if payload.Open != 0 {
 		securityStat.Open = payload.Open
 	}
@@ -175,14 +261,282 @@ func (s *securityStatService) Patch(ctx *gofr.Context, id int, payload *Security
 		securityStat.Volume = payload.Volume
 	}
 
-	securityStat, err = s.store.Update(ctx, id, securityStat)
+	err = stores.InTx(ctx, func() error {
+		if securityStat, err = s.store.Update(ctx, id, securityStat); err != nil {
+			return err
+		}
+
+		if err := s.auditEventService.Record(ctx, "security-stats", securityStat.ID, AuditActionUpdate, &before, securityStat); err != nil {
+			return err
+		}
+
+		return s.emitUpserted(ctx, securityStat)
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	s.alertService.EvaluateStats(ctx, []*stores.SecurityStat{securityStat})
+
 	return s.buildResp(securityStat), nil
 }
 
+// BulkUpsert validates every row up front and reports the ones that cannot be written as failed, the rest are written together on
+// their security and date so a batch either lands whole or not at all.
+func (s *securityStatService) BulkUpsert(ctx *gofr.Context, payload []*SecurityStatCreate) ([]*BulkUpsertResult, error) {
+	if err := authorize(ctx, auth.WriteStats); err != nil {
+		return nil, err
+	}
+
+	if len(payload) > bulkUpsertLimit {
+		return nil, &ErrResp{Code: 400, Message: fmt.Sprintf("a bulk upsert takes at most %d rows", bulkUpsertLimit)}
+	}
+
+	if len(payload) == 0 {
+		return nil, nil
+	}
+
+	var securityIDs []int
+
+	startDate, endDate := payload[0].Date, payload[0].Date
+
+	for i := range payload {
+		if !slices.Contains(securityIDs, payload[i].SecurityID) {
+			securityIDs = append(securityIDs, payload[i].SecurityID)
+		}
+
+		if payload[i].Date.Before(startDate) {
+			startDate = payload[i].Date
+		}
+
+		if payload[i].Date.After(endDate) {
+			endDate = payload[i].Date
+		}
+	}
+
+	marketDaysSet, err := bulkMarketDays(ctx, s.marketDayService, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	securities, err := s.securityStore.Index(ctx, &stores.SecurityFilter{IDs: securityIDs}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	securitiesSet := make(map[int]bool)
+
+	for i := range securities {
+		securitiesSet[securities[i].ID] = true
+	}
+
+	existing, err := s.store.Index(ctx, &stores.SecurityStatFilter{SecurityIDs: securityIDs, MinDate: startDate, MaxDate: endDate}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	existingMap := make(map[string]*stores.SecurityStat)
+
+	for i := range existing {
+		existingMap[securityStatKey(existing[i].SecurityID, existing[i].Date)] = existing[i]
+	}
+
+	var (
+		results = make([]*BulkUpsertResult, len(payload))
+		models  []*stores.SecurityStat
+		indices []int
+		seen    = make(map[string]bool)
+	)
+
+	for i := range payload {
+		key := securityStatKey(payload[i].SecurityID, payload[i].Date)
+		results[i] = &BulkUpsertResult{Index: i}
+
+		switch {
+		case !securitiesSet[payload[i].SecurityID]:
+			results[i].Status, results[i].Error = BulkUpsertFailed, fmt.Sprintf("security %d not found", payload[i].SecurityID)
+		case !marketDaysSet[payload[i].Date.Format(time.DateOnly)]:
+			results[i].Status, results[i].Error = BulkUpsertFailed, "cannot add stat for market holiday - "+payload[i].Date.Format(time.DateOnly)
+		case seen[key]:
+			results[i].Status, results[i].Error = BulkUpsertFailed, "duplicate row for security and date"
+		}
+
+		if results[i].Status == BulkUpsertFailed {
+			continue
+		}
+
+		seen[key] = true
+
+		models = append(models, &stores.SecurityStat{
+			SecurityID: payload[i].SecurityID,
+			Date:       payload[i].Date,
+			Open:       payload[i].Open,
+			Close:      payload[i].Close,
+			High:       payload[i].High,
+			Low:        payload[i].Low,
+			Volume:     payload[i].Volume,
+			CreatedAt:  time.Now().UTC(),
+			UpdatedAt:  time.Now().UTC(),
+		})
+		indices = append(indices, i)
+	}
+
+	if len(models) == 0 {
+		return results, nil
+	}
+
+	err = stores.InTx(ctx, func() error {
+		ids, err := s.store.Upsert(ctx, models)
+		if err != nil {
+			return err
+		}
+
+		var (
+			auditRecords = make([]*AuditRecord, len(models))
+			events       = make([]any, len(models))
+		)
+
+		for j, model := range models {
+			model.ID = ids[j]
+			result := results[indices[j]]
+			result.ID = model.ID
+
+			if before, ok := existingMap[securityStatKey(model.SecurityID, model.Date)]; ok {
+				model.CreatedAt = before.CreatedAt
+				result.Status = BulkUpsertUpdated
+				auditRecords[j] = &AuditRecord{EntityID: model.ID, Action: AuditActionUpdate, Before: before, After: model}
+			} else {
+				result.Status = BulkUpsertCreated
+				auditRecords[j] = &AuditRecord{EntityID: model.ID, Action: AuditActionCreate, After: model}
+			}
+
+			events[j] = securityStatEvent(model)
+		}
+
+		if err = s.auditEventService.RecordBatch(ctx, "security-stats", auditRecords); err != nil {
+			return err
+		}
+
+		return s.domainEventService.EmitBatch(ctx, TopicSecurityStatUpserted, events)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.alertService.EvaluateStats(ctx, models)
+
+	return results, nil
+}
+
+// Import resolves the securities of the rows and upserts them like BulkUpsert, rows whose security is unknown fail without
+// failing the rest.
+func (s *securityStatService) Import(ctx *gofr.Context, payload []*SecurityStatImport) ([]*BulkUpsertResult, error) {
+	if err := authorize(ctx, auth.WriteStats); err != nil {
+		return nil, err
+	}
+
+	if len(payload) > bulkUpsertLimit {
+		return nil, &ErrResp{Code: 400, Message: fmt.Sprintf("an import takes at most %d rows", bulkUpsertLimit)}
+	}
+
+	var isins, symbols []string
+
+	for i := range payload {
+		if payload[i].ISIN != "" {
+			isins = append(isins, payload[i].ISIN)
+		} else if payload[i].Symbol != "" {
+			symbols = append(symbols, payload[i].Symbol)
+		}
+	}
+
+	var (
+		isinIDs   = make(map[string]int)
+		symbolIDs = make(map[string]int)
+	)
+
+	if len(isins) > 0 {
+		securities, err := s.securityStore.Index(ctx, &stores.SecurityFilter{ISINs: isins}, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range securities {
+			isinIDs[securities[i].ISIN] = securities[i].ID
+		}
+	}
+
+	if len(symbols) > 0 {
+		securities, err := s.securityStore.Index(ctx, &stores.SecurityFilter{Symbols: symbols}, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range securities {
+			symbolIDs[securities[i].Symbol] = securities[i].ID
+		}
+	}
+
+	var (
+		results = make([]*BulkUpsertResult, len(payload))
+		creates []*SecurityStatCreate
+		indices []int
+	)
+
+	for i := range payload {
+		results[i] = &BulkUpsertResult{Index: i}
+
+		var securityID int
+
+		switch {
+		case payload[i].ISIN != "":
+			if securityID = isinIDs[payload[i].ISIN]; securityID == 0 {
+				results[i].Status, results[i].Error = BulkUpsertFailed, "security not found with isin - "+payload[i].ISIN
+			}
+		case payload[i].Symbol != "":
+			if securityID = symbolIDs[payload[i].Symbol]; securityID == 0 {
+				results[i].Status, results[i].Error = BulkUpsertFailed, "security not found with symbol - "+payload[i].Symbol
+			}
+		default:
+			results[i].Status, results[i].Error = BulkUpsertFailed, "isin or symbol is required"
+		}
+
+		if results[i].Status == BulkUpsertFailed {
+			continue
+		}
+
+		creates = append(creates, &SecurityStatCreate{
+			SecurityID: securityID,
+			Date:       payload[i].Date,
+			Open:       payload[i].Open,
+			Close:      payload[i].Close,
+			High:       payload[i].High,
+			Low:        payload[i].Low,
+			Volume:     payload[i].Volume,
+		})
+		indices = append(indices, i)
+	}
+
+	if len(creates) == 0 {
+		return results, nil
+	}
+
+	upserted, err := s.BulkUpsert(ctx, creates)
+	if err != nil {
+		return nil, err
+	}
+
+	for j := range upserted {
+		upserted[j].Index = indices[j]
+		results[indices[j]] = upserted[j]
+	}
+
+	return results, nil
+}
+
+func (s *securityStatService) emitUpserted(ctx *gofr.Context, securityStat *stores.SecurityStat) error {
+	return s.domainEventService.Emit(ctx, TopicSecurityStatUpserted, securityStatEvent(securityStat))
+}
+
 func (s *securityStatService) buildResp(model *stores.SecurityStat) *SecurityStat {
 	resp := &SecurityStat{
 		ID:         model.ID,
@@ -199,3 +553,37 @@ func (s *securityStatService) buildResp(model *stores.SecurityStat) *SecuritySta
 
 	return resp
 }
+
+func securityStatEvent(securityStat *stores.SecurityStat) *SecurityStatEvent {
+	return &SecurityStatEvent{
+		ID:         securityStat.ID,
+		SecurityID: securityStat.SecurityID,
+		Date:       securityStat.Date.Format(time.DateOnly),
+		Open:       securityStat.Open,
+		Close:      securityStat.Close,
+		High:       securityStat.High,
+		Low:        securityStat.Low,
+		Volume:     securityStat.Volume,
+	}
+}
+
+func securityStatKey(securityID int, date time.Time) string {
+
+	return fmt.Sprintf("%d:%s", securityID, date.Format(time.DateOnly))
+}
+
+// bulkMarketDays returns the market days between startDate and endDate keyed by their date.
+func bulkMarketDays(ctx *gofr.Context, marketDayService MarketDayService, startDate, endDate time.Time) (map[string]bool, error) {
+	marketDays, err := marketDaysBetween(ctx, marketDayService, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	marketDaysSet := make(map[string]bool)
+
+	for i := range marketDays {
+		marketDaysSet[marketDays[i].Format(time.DateOnly)] = true
+	}
+
+	return marketDaysSet, nil
+}
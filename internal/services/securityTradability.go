@@ -0,0 +1,257 @@
+package services
+
+import (
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+
+	"github.com/stratifyr/security-service/internal/auth"
+	"github.com/stratifyr/security-service/internal/stores"
+)
+
+// SurveillanceFlagNone marks a security outside the ASM/GSM frameworks, any other flag is treated as under surveillance.
+const SurveillanceFlagNone = "N"
+
+const surveillanceFlagMaxLength = 5
+
+type SecurityTradabilityService interface {
+	History(ctx *gofr.Context, securityID int) ([]*Tradability, error)
+	Update(ctx *gofr.Context, securityID int, payload *TradabilityUpdate) (*Tradability, error)
+}
+
+type Tradability struct {
+	ID                   int
+	SecurityID           int
+	LotSize              int
+	TickSize             float64
+	SurveillanceFlag     string
+	SurveillanceCategory string
+	UnderSurveillance    bool
+	MTFLeverage          float64
+	EffectiveFrom        time.Time
+	EffectiveTo          *time.Time
+}
+
+type TradabilityUpdate struct {
+	LotSize              int
+	TickSize             float64
+	SurveillanceFlag     string
+	SurveillanceCategory string
+	MTFLeverage          float64
+	EffectiveFrom        time.Time
+}
+
+type securityTradabilityService struct {
+	auditEventService AuditEventService
+	securityStore     stores.SecurityStore
+	store             stores.SecurityTradabilityStore
+}
+
+func NewSecurityTradabilityService(auditEventService AuditEventService, securityStore stores.SecurityStore,
+	store stores.SecurityTradabilityStore) *securityTradabilityService {
+	return &securityTradabilityService{
+		auditEventService: auditEventService,
+		securityStore:     securityStore,
+		store:             store,
+	}
+}
+
+func (s *securityTradabilityService) History(ctx *gofr.Context, securityID int) ([]*Tradability, error) {
+	if err := authorize(ctx, auth.ReadMarketData); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.securityStore.Retrieve(ctx, securityID); err != nil {
+		return nil, err
+	}
+
+	securityTradabilities, err := s.store.Index(ctx, &stores.SecurityTradabilityFilter{SecurityID: securityID}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp = make([]*Tradability, len(securityTradabilities))
+
+	for i := range securityTradabilities {
+		resp[i] = s.buildResp(securityTradabilities[i])
+	}
+
+	return resp, nil
+}
+
+// Update records the tradability attributes of a security from EffectiveFrom onwards and mirrors them onto the security. Unchanged
+// attributes are a no-op, so a full refresh from the scrip master only adds history where something moved.
+func (s *securityTradabilityService) Update(ctx *gofr.Context, securityID int, payload *TradabilityUpdate) (*Tradability, error) {
+	if err := authorize(ctx, auth.WriteSecurities); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.securityStore.Retrieve(ctx, securityID); err != nil {
+		return nil, err
+	}
+
+	payload.SurveillanceFlag = strings.ToUpper(payload.SurveillanceFlag)
+	if payload.SurveillanceFlag == "" {
+		payload.SurveillanceFlag = SurveillanceFlagNone
+	}
+
+	if err := s.validate(payload); err != nil {
+		return nil, err
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	effectiveFrom := payload.EffectiveFrom
+	if effectiveFrom.IsZero() {
+		effectiveFrom = today
+	}
+
+	if effectiveFrom.After(today) {
+		return nil, &ErrResp{Code: 400, Message: "effectiveFrom cannot be in the future"}
+	}
+
+	model := &stores.SecurityTradability{
+		SecurityID:       securityID,
+		LotSize:          payload.LotSize,
+		TickSize:         payload.TickSize,
+		SurveillanceFlag: payload.SurveillanceFlag,
+		MTFLeverage:      payload.MTFLeverage,
+		EffectiveFrom:    effectiveFrom,
+		CreatedAt:        time.Now().UTC(),
+	}
+
+	if payload.SurveillanceCategory != "" {
+		model.SurveillanceCategory = &payload.SurveillanceCategory
+	}
+
+	current, err := s.store.Index(ctx, &stores.SecurityTradabilityFilter{SecurityID: securityID}, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(current) > 0 {
+		if sameTradability(current[0], model) {
+			return s.buildResp(current[0]), nil
+		}
+
+		if effectiveFrom.Before(current[0].EffectiveFrom) {
+			return nil, &ErrResp{Code: 409, Message: "effectiveFrom must not be before " + current[0].EffectiveFrom.Format(time.DateOnly) +
+				", the start of the current attributes"}
+		}
+	}
+
+	var securityTradability *stores.SecurityTradability
+
+	err = stores.InTx(ctx, func() error {
+		switch {
+		case len(current) == 0:
+			securityTradability, err = s.store.Create(ctx, model)
+		case effectiveFrom.Equal(current[0].EffectiveFrom):
+			model.CreatedAt = current[0].CreatedAt
+
+			securityTradability, err = s.store.Update(ctx, current[0].ID, model)
+		default:
+			before := *current[0]
+
+			current[0].EffectiveTo = &effectiveFrom
+
+			if _, err = s.store.Update(ctx, current[0].ID, current[0]); err != nil {
+				return err
+			}
+
+			if err = s.auditEventService.Record(ctx, "security-tradability", current[0].ID, AuditActionUpdate, &before, current[0]); err != nil {
+				return err
+			}
+
+			securityTradability, err = s.store.Create(ctx, model)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(current) > 0 && securityTradability.ID == current[0].ID {
+			err = s.auditEventService.Record(ctx, "security-tradability", securityTradability.ID, AuditActionUpdate, current[0], securityTradability)
+		} else {
+			err = s.auditEventService.Record(ctx, "security-tradability", securityTradability.ID, AuditActionCreate, nil, securityTradability)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		_, err = s.securityStore.UpdateTradability(ctx, securityID, &stores.Security{
+			LotSize:              &securityTradability.LotSize,
+			TickSize:             &securityTradability.TickSize,
+			SurveillanceFlag:     &securityTradability.SurveillanceFlag,
+			SurveillanceCategory: securityTradability.SurveillanceCategory,
+			MTFLeverage:          &securityTradability.MTFLeverage,
+			UpdatedAt:            time.Now().UTC(),
+		})
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(securityTradability), nil
+
+}
+
+func (s *securityTradabilityService) validate(payload *TradabilityUpdate) error {
+	if payload.LotSize <= 0 {
+		return http.ErrorInvalidParam{Params: []string{"lotSize"}}
+	}
+
+	if payload.TickSize <= 0 {
+		return http.ErrorInvalidParam{Params: []string{"tickSize"}}
+	}
+
+	if len(payload.SurveillanceFlag) > surveillanceFlagMaxLength {
+		return http.ErrorInvalidParam{Params: []string{"surveillanceFlag"}}
+	}
+
+	if payload.MTFLeverage < 0 {
+		return http.ErrorInvalidParam{Params: []string{"mtfLeverage"}}
+	}
+
+	return nil
+}
+
+func (s *securityTradabilityService) buildResp(model *stores.SecurityTradability) *Tradability {
+	resp := &Tradability{
+		ID:                model.ID,
+		SecurityID:        model.SecurityID,
+		LotSize:           model.LotSize,
+		TickSize:          model.TickSize,
+		SurveillanceFlag:  model.SurveillanceFlag,
+		UnderSurveillance: model.SurveillanceFlag != SurveillanceFlagNone,
+		MTFLeverage:       model.MTFLeverage,
+		EffectiveFrom:     model.EffectiveFrom,
+		EffectiveTo:       model.EffectiveTo,
+	}
+
+	if model.SurveillanceCategory != nil {
+		resp.SurveillanceCategory = *model.SurveillanceCategory
+	}
+
+	return resp
+}
+
+func sameTradability(a, b *stores.SecurityTradability) bool {
+	var categoryA, categoryB string
+
+	if a.SurveillanceCategory != nil {
+		categoryA = *a.SurveillanceCategory
+	}
+
+	if b.SurveillanceCategory != nil {
+		categoryB = *b.SurveillanceCategory
+	}
+
+	return a.LotSize == b.LotSize && a.TickSize == b.TickSize && a.SurveillanceFlag == b.SurveillanceFlag && categoryA == categoryB &&
+		a.MTFLeverage == b.MTFLeverage
+}
@@ -0,0 +1,352 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+
+	"github.com/stratifyr/security-service/internal/auth"
+	"github.com/stratifyr/security-service/internal/stores"
+)
+
+const (
+	serviceCredentialKeyPrefix        = "sk_"
+	serviceCredentialRotationGrace    = 24 * time.Hour
+	serviceCredentialLastUsedInterval = time.Minute
+)
+
+type ServiceCredentialService interface {
+	Index(ctx *gofr.Context, f *ServiceCredentialFilter, page, perPage int) ([]*ServiceCredential, int, error)
+	Read(ctx *gofr.Context, id int) (*ServiceCredential, error)
+	Create(ctx *gofr.Context, payload *ServiceCredentialCreate) (*ServiceCredential, error)
+	Patch(ctx *gofr.Context, id int, payload *ServiceCredentialUpdate) (*ServiceCredential, error)
+	Rotate(ctx *gofr.Context, id int) (*ServiceCredential, error)
+	Revoke(ctx *gofr.Context, id int) error
+	ResolveAPIKey(ctx *gofr.Context, apiKey string) (*auth.Principal, error)
+}
+
+type ServiceCredentialFilter struct {
+	Revoked *bool
+}
+
+type ServiceCredential struct {
+	ID                   int
+	Name                 string
+	Key                  string
+	KeyPrefix            string
+	Scopes               []string
+	PreviousKeyExpiresAt *time.Time
+	LastUsedAt           *time.Time
+	RevokedAt            *time.Time
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+type ServiceCredentialCreate struct {
+	Name   string
+	Scopes []string
+}
+
+type ServiceCredentialUpdate struct {
+	Name   string
+	Scopes []string
+}
+
+type serviceCredentialService struct {
+	auditEventService AuditEventService
+	store             stores.ServiceCredentialStore
+}
+
+func NewServiceCredentialService(auditEventService AuditEventService, store stores.ServiceCredentialStore) *serviceCredentialService {
+	return &serviceCredentialService{
+		auditEventService: auditEventService,
+		store:             store,
+	}
+}
+
+func (s *serviceCredentialService) Index(ctx *gofr.Context, f *ServiceCredentialFilter, page, perPage int) ([]*ServiceCredential, int, error) {
+	if err := authorize(ctx, auth.ManageServiceCredentials); err != nil {
+		return nil, 0, err
+	}
+
+	limit := perPage
+	offset := limit * (page - 1)
+
+	filter := &stores.ServiceCredentialFilter{Revoked: f.Revoked}
+
+	serviceCredentials, err := s.store.Index(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.store.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if count == 0 {
+		return nil, 0, nil
+	}
+
+	var resp = make([]*ServiceCredential, len(serviceCredentials))
+
+	for i := range serviceCredentials {
+		resp[i] = s.buildResp(serviceCredentials[i], "")
+	}
+
+	return resp, count, nil
+}
+
+func (s *serviceCredentialService) Read(ctx *gofr.Context, id int) (*ServiceCredential, error) {
+	if err := authorize(ctx, auth.ManageServiceCredentials); err != nil {
+		return nil, err
+	}
+
+	serviceCredential, err := s.store.Retrieve(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(serviceCredential, ""), nil
+}
+
+func (s *serviceCredentialService) Create(ctx *gofr.Context, payload *ServiceCredentialCreate) (*ServiceCredential, error) {
+	if err := authorize(ctx, auth.ManageServiceCredentials); err != nil {
+		return nil, err
+	}
+
+	if payload.Name == "" {
+		return nil, &ErrResp{Code: 400, Message: "name is required"}
+	}
+
+	if err := validateScopes(payload.Scopes); err != nil {
+		return nil, err
+	}
+
+	key, err := generateServiceCredentialKey()
+	if err != nil {
+		return nil, err
+	}
+
+	model := &stores.ServiceCredential{
+		Name:      payload.Name,
+		KeyPrefix: key[:len(serviceCredentialKeyPrefix)+8],
+		KeyHash:   hashServiceCredentialKey(key),
+		Scopes:    payload.Scopes,
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	var serviceCredential *stores.ServiceCredential
+
+	err = stores.InTx(ctx, func() error {
+		if serviceCredential, err = s.store.Create(ctx, model); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "service-credentials", serviceCredential.ID, AuditActionCreate, nil, s.buildResp(serviceCredential, ""))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(serviceCredential, key), nil
+}
+
+func (s *serviceCredentialService) Patch(ctx *gofr.Context, id int, payload *ServiceCredentialUpdate) (*ServiceCredential, error) {
+	if err := authorize(ctx, auth.ManageServiceCredentials); err != nil {
+		return nil, err
+	}
+
+	serviceCredential, err := s.store.Retrieve(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := s.buildResp(serviceCredential, "")
+
+	if payload.Name != "" {
+		serviceCredential.Name = payload.Name
+	}
+
+	if payload.Scopes != nil {
+		if err = validateScopes(payload.Scopes); err != nil {
+			return nil, err
+		}
+
+		serviceCredential.Scopes = payload.Scopes
+	}
+
+	serviceCredential.UpdatedAt = time.Now().UTC()
+
+	err = stores.InTx(ctx, func() error {
+		if serviceCredential, err = s.store.Update(ctx, id, serviceCredential); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "service-credentials", serviceCredential.ID, AuditActionUpdate, before, s.buildResp(serviceCredential, ""))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(serviceCredential, ""), nil
+}
+
+func (s *serviceCredentialService) Rotate(ctx *gofr.Context, id int) (*ServiceCredential, error) {
+	if err := authorize(ctx, auth.ManageServiceCredentials); err != nil {
+		return nil, err
+	}
+
+	serviceCredential, err := s.store.Retrieve(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := s.buildResp(serviceCredential, "")
+
+	if serviceCredential.RevokedAt != nil {
+		return nil, &ErrResp{Code: 400, Message: "cannot rotate a revoked service credential"}
+	}
+
+	key, err := generateServiceCredentialKey()
+	if err != nil {
+		return nil, err
+	}
+
+	previousKeyExpiresAt := time.Now().UTC().Add(serviceCredentialRotationGrace)
+
+	serviceCredential.PreviousKeyHash = serviceCredential.KeyHash
+	serviceCredential.PreviousKeyExpiresAt = &previousKeyExpiresAt
+	serviceCredential.KeyPrefix = key[:len(serviceCredentialKeyPrefix)+8]
+	serviceCredential.KeyHash = hashServiceCredentialKey(key)
+	serviceCredential.UpdatedAt = time.Now().UTC()
+
+	err = stores.InTx(ctx, func() error {
+		if serviceCredential, err = s.store.Update(ctx, id, serviceCredential); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "service-credentials", serviceCredential.ID, AuditActionUpdate, before, s.buildResp(serviceCredential, ""))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(serviceCredential, key), nil
+}
+
+func (s *serviceCredentialService) Revoke(ctx *gofr.Context, id int) error {
+	if err := authorize(ctx, auth.ManageServiceCredentials); err != nil {
+		return err
+	}
+
+	serviceCredential, err := s.store.Retrieve(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if serviceCredential.RevokedAt != nil {
+		return nil
+	}
+
+	before := s.buildResp(serviceCredential, "")
+
+	revokedAt := time.Now().UTC()
+
+	serviceCredential.RevokedAt = &revokedAt
+	serviceCredential.PreviousKeyHash = ""
+	serviceCredential.PreviousKeyExpiresAt = nil
+	serviceCredential.UpdatedAt = revokedAt
+
+	return stores.InTx(ctx, func() error {
+		if serviceCredential, err = s.store.Update(ctx, id, serviceCredential); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "service-credentials", serviceCredential.ID, AuditActionUpdate, before, s.buildResp(serviceCredential, ""))
+	})
+}
+
+func (s *serviceCredentialService) ResolveAPIKey(ctx *gofr.Context, apiKey string) (*auth.Principal, error) {
+	serviceCredential, err := s.store.RetrieveByKeyHash(ctx, hashServiceCredentialKey(apiKey), time.Now().UTC())
+	if err != nil {
+		if errors.As(err, &http.ErrorEntityNotFound{}) {
+			return nil, auth.ErrUnauthenticated
+		}
+
+		return nil, err
+	}
+
+	if serviceCredential.LastUsedAt == nil || time.Since(*serviceCredential.LastUsedAt) > serviceCredentialLastUsedInterval {
+		if err = s.store.UpdateLastUsedAt(ctx, serviceCredential.ID, time.Now().UTC()); err != nil {
+			ctx.Logger.Warnf("failed to update last used at for service credential %d, err: %v", serviceCredential.ID, err)
+		}
+	}
+
+	principal := &auth.Principal{
+		Name:                serviceCredential.Name,
+		Scopes:              make([]auth.Permission, 0, len(serviceCredential.Scopes)),
+		ServiceCredentialID: serviceCredential.ID,
+	}
+
+	for _, scope := range serviceCredential.Scopes {
+		if permission, ok := auth.PermissionFromString(scope); ok {
+			principal.Scopes = append(principal.Scopes, permission)
+		}
+	}
+
+	return principal, nil
+}
+
+func (s *serviceCredentialService) buildResp(model *stores.ServiceCredential, key string) *ServiceCredential {
+	resp := &ServiceCredential{
+		ID:                   model.ID,
+		Name:                 model.Name,
+		Key:                  key,
+		KeyPrefix:            model.KeyPrefix,
+		Scopes:               model.Scopes,
+		PreviousKeyExpiresAt: model.PreviousKeyExpiresAt,
+		LastUsedAt:           model.LastUsedAt,
+		RevokedAt:            model.RevokedAt,
+		CreatedAt:            model.CreatedAt,
+		UpdatedAt:            model.UpdatedAt,
+	}
+
+	return resp
+}
+
+func validateScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return &ErrResp{Code: 400, Message: "at least one scope is required"}
+	}
+
+	for _, scope := range scopes {
+		if _, ok := auth.PermissionFromString(scope); !ok {
+			return &ErrResp{Code: 400, Message: "invalid scope - " + scope}
+		}
+	}
+
+	return nil
+}
+
+func generateServiceCredentialKey() (string, error) {
+	secret := make([]byte, 32)
+
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return serviceCredentialKeyPrefix + hex.EncodeToString(secret), nil
+}
+
+func hashServiceCredentialKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(hash[:])
+}
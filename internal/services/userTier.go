@@ -0,0 +1,191 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+)
+
+const (
+	userTierCacheExpiry = 5 * time.Minute
+	userTierStaleExpiry = 24 * time.Hour
+)
+
+type UserTierService interface {
+	Read(ctx *gofr.Context, userID int) (int, error)
+}
+
+type userTier struct {
+	Tier      int       `json:"tier"`
+	FetchedAt time.Time `json:"fetchedAt"`
+}
+
+type userTierService struct {
+	useRedis bool
+
+	mu      sync.RWMutex
+	cache   map[int]*userTier
+	sweptAt time.Time
+}
+
+func NewUserTierService(useRedis bool) *userTierService {
+	return &userTierService{
+		useRedis: useRedis,
+		cache:    make(map[int]*userTier),
+	}
+}
+
+func (s *userTierService) Read(ctx *gofr.Context, userID int) (int, error) {
+	cached := s.getCache(ctx, userID)
+
+	if cached != nil && time.Since(cached.FetchedAt) <= userTierCacheExpiry {
+		ctx.Metrics().IncrementCounter(ctx, "app_user_tier_cache_lookups", "result", "hit")
+
+		return cached.Tier, nil
+	}
+
+	tier, err := s.fetchUserTier(ctx, userID)
+	if err != nil {
+		if cached == nil {
+			ctx.Metrics().IncrementCounter(ctx, "app_user_tier_cache_lookups", "result", "error")
+
+			return 0, err
+		}
+
+		ctx.Logger.Warnf("serving stale tier for userId %d fetched at %s", userID, cached.FetchedAt.Format(time.RFC3339))
+		ctx.Metrics().IncrementCounter(ctx, "app_user_tier_cache_lookups", "result", "stale")
+
+		return cached.Tier, nil
+	}
+
+	ctx.Metrics().IncrementCounter(ctx, "app_user_tier_cache_lookups", "result", "miss")
+
+	s.setCache(ctx, userID, &userTier{Tier: tier, FetchedAt: time.Now()})
+
+	return tier, nil
+}
+
+func (s *userTierService) getCache(ctx *gofr.Context, userID int) *userTier {
+	if !s.useRedis {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+
+		if cached, ok := s.cache[userID]; ok && time.Since(cached.FetchedAt) <= userTierStaleExpiry {
+			return cached
+		}
+
+		return nil
+	}
+
+	cache, err := ctx.Redis.Get(ctx, userTierCacheKey(userID)).Bytes()
+	if err != nil {
+		return nil
+	}
+
+	var cached userTier
+
+	if json.Unmarshal(cache, &cached) != nil {
+		return nil
+	}
+
+	return &cached
+}
+
+func (s *userTierService) setCache(ctx *gofr.Context, userID int, cached *userTier) {
+	if !s.useRedis {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		// tiers past userTierStaleExpiry are never served, sweeping them keeps the map to the users seen within that window as
+		// redis does with its key expiry
+		if now := time.Now(); now.Sub(s.sweptAt) > userTierCacheExpiry {
+			for id, c := range s.cache {
+				if now.Sub(c.FetchedAt) > userTierStaleExpiry {
+					delete(s.cache, id)
+				}
+			}
+
+			s.sweptAt = now
+		}
+
+		s.cache[userID] = cached
+
+		return
+	}
+
+	serialized, _ := json.Marshal(cached)
+
+	if err := ctx.Redis.Set(ctx, userTierCacheKey(userID), serialized, userTierStaleExpiry).Err(); err != nil {
+		ctx.Warnf("failed to set cache, key: %s, err: %v", userTierCacheKey(userID), err)
+	}
+}
+
+func (s *userTierService) fetchUserTier(ctx *gofr.Context, userID int) (int, error) {
+	start := time.Now()
+
+	tier, err := s.getUserTier(ctx, userID)
+
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
+
+	ctx.Metrics().RecordHistogram(ctx, "app_user_tier_fetch_duration", time.Since(start).Seconds(), "status", status)
+
+	return tier, err
+}
+
+func (s *userTierService) getUserTier(ctx *gofr.Context, userID int) (int, error) {
+	httpService := ctx.GetHTTPService("account-service")
+
+	resp, err := httpService.Get(ctx, fmt.Sprintf("users/%d", userID), nil)
+	if err != nil {
+		ctx.Logger.Errorf("failed GET /account-service/users/{id}, %v", map[string]interface{}{
+			"err":    err.Error(),
+			"userId": userID,
+		})
+
+		return 0, &ErrResp{Code: 503, Message: "something went wrong!"}
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		body, _ := io.ReadAll(resp.Body)
+
+		ctx.Logger.Errorf("non 200 resp GET /account-service/users/{id}, %v", map[string]interface{}{
+			"code": resp.StatusCode,
+			"resp": string(body),
+		})
+
+		return 0, &ErrResp{Code: 503, Message: "something went wrong!"}
+	}
+
+	var res struct {
+		Data *struct {
+			Plan *struct {
+				Tier int `json:"tier"`
+			} `json:"plan"`
+		} `json:"data"`
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	if err != nil || res.Data == nil || res.Data.Plan == nil {
+		ctx.Logger.Errorf("unexpected response from GET /account-service/users/{id}, %v", map[string]interface{}{
+			"userId":       userID,
+			"unmarshalErr": err,
+		})
+
+		return 0, &ErrResp{Code: 503, Message: "something went wrong!"}
+	}
+
+	return res.Data.Plan.Tier, nil
+}
+
+func userTierCacheKey(userID int) string {
+	return fmt.Sprintf("user_tiers:user_id:%d", userID)
+}
@@ -0,0 +1,464 @@
+package services
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+
+	"github.com/stratifyr/security-service/internal/auth"
+	"github.com/stratifyr/security-service/internal/stores"
+)
+
+const (
+	watchlistMaxPerUser    = 50
+	watchlistMaxSecurities = 250
+	watchlistNameMaxLength = 100
+)
+
+type WatchlistService interface {
+	Index(ctx *gofr.Context, page, perPage int) ([]*Watchlist, int, error)
+	Read(ctx *gofr.Context, id int) (*Watchlist, error)
+	Create(ctx *gofr.Context, payload *WatchlistCreate) (*Watchlist, error)
+	Patch(ctx *gofr.Context, id int, payload *WatchlistUpdate) (*Watchlist, error)
+	Delete(ctx *gofr.Context, id int) error
+	AddSecurity(ctx *gofr.Context, id, securityID int) (*Watchlist, error)
+	RemoveSecurity(ctx *gofr.Context, id, securityID int) (*Watchlist, error)
+	Reorder(ctx *gofr.Context, id int, securityIDs []int) (*Watchlist, error)
+}
+
+type Watchlist struct {
+	ID          int
+	UserID      int
+	Name        string
+	SecurityIDs []int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type WatchlistCreate struct {
+	Name        string
+	SecurityIDs []int
+}
+
+type WatchlistUpdate struct {
+	Name string
+}
+
+type watchlistService struct {
+	entitlementService     EntitlementService
+	auditEventService      AuditEventService
+	securityStore          stores.SecurityStore
+	watchlistSecurityStore stores.WatchlistSecurityStore
+	store                  stores.WatchlistStore
+}
+
+func NewWatchlistService(entitlementService EntitlementService, auditEventService AuditEventService, securityStore stores.SecurityStore,
+	watchlistSecurityStore stores.WatchlistSecurityStore, store stores.WatchlistStore) *watchlistService {
+	return &watchlistService{
+		entitlementService:     entitlementService,
+		auditEventService:      auditEventService,
+		securityStore:          securityStore,
+		watchlistSecurityStore: watchlistSecurityStore,
+		store:                  store,
+	}
+}
+
+func (s *watchlistService) Index(ctx *gofr.Context, page, perPage int) ([]*Watchlist, int, error) {
+	if err := authorize(ctx, auth.WriteWatchlists); err != nil {
+		return nil, 0, err
+	}
+
+	limit := perPage
+	offset := limit * (page - 1)
+
+	filter := &stores.WatchlistFilter{UserID: auth.PrincipalFromContext(ctx).UserID}
+
+	if filter.UserID == 0 {
+		return nil, 0, nil
+	}
+
+	watchlists, err := s.store.Index(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.store.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if count == 0 {
+		return nil, 0, nil
+	}
+
+	securityIDsMap, err := s.getSecurityIDsMap(ctx, watchlists)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var resp = make([]*Watchlist, len(watchlists))
+
+	for i := range watchlists {
+		resp[i] = s.buildResp(watchlists[i], securityIDsMap[watchlists[i].ID])
+	}
+
+	return resp, count, nil
+}
+
+func (s *watchlistService) Read(ctx *gofr.Context, id int) (*Watchlist, error) {
+	watchlist, err := s.retrieveOwned(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.read(ctx, watchlist)
+}
+
+func (s *watchlistService) Create(ctx *gofr.Context, payload *WatchlistCreate) (*Watchlist, error) {
+	if err := authorize(ctx, auth.WriteWatchlists); err != nil {
+		return nil, err
+	}
+
+	principal := auth.PrincipalFromContext(ctx)
+	if principal.UserID == 0 {
+		return nil, &ErrResp{Code: 403, Message: "watchlists can only be created by user accounts"}
+	}
+
+	if err := s.validateName(ctx, principal.UserID, 0, payload.Name); err != nil {
+		return nil, err
+	}
+
+	count, err := s.store.Count(ctx, &stores.WatchlistFilter{UserID: principal.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	if count >= watchlistMaxPerUser {
+		return nil, &ErrResp{Code: 400, Message: fmt.Sprintf("at most %d watchlists are allowed per user", watchlistMaxPerUser)}
+	}
+
+	if len(payload.SecurityIDs) > watchlistMaxSecurities {
+		return nil, &ErrResp{Code: 400, Message: fmt.Sprintf("a watchlist can hold at most %d securities", watchlistMaxSecurities)}
+	}
+
+	for i, securityID := range payload.SecurityIDs {
+		if slices.Contains(payload.SecurityIDs[:i], securityID) {
+			return nil, &ErrResp{Code: 400, Message: "duplicate securityId - " + strconv.Itoa(securityID)}
+		}
+
+		if err = s.validateSecurity(ctx, securityID); err != nil {
+			return nil, err
+		}
+	}
+
+	model := &stores.Watchlist{
+		UserID:    principal.UserID,
+		Name:      payload.Name,
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	var resp *Watchlist
+
+	err = stores.InTx(ctx, func() error {
+		watchlist, err := s.store.Create(ctx, model)
+		if err != nil {
+			return err
+		}
+
+		for i, securityID := range payload.SecurityIDs {
+			err = s.watchlistSecurityStore.Create(ctx, &stores.WatchlistSecurity{
+				WatchlistID: watchlist.ID,
+				SecurityID:  securityID,
+				Position:    i + 1,
+				CreatedAt:   time.Now().UTC(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		resp = s.buildResp(watchlist, payload.SecurityIDs)
+
+		return s.auditEventService.Record(ctx, "watchlists", watchlist.ID, AuditActionCreate, nil, resp)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *watchlistService) Patch(ctx *gofr.Context, id int, payload *WatchlistUpdate) (*Watchlist, error) {
+	watchlist, err := s.retrieveOwned(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *watchlist
+
+	if payload.Name != "" && payload.Name != watchlist.Name {
+		if err = s.validateName(ctx, watchlist.UserID, watchlist.ID, payload.Name); err != nil {
+			return nil, err
+		}
+
+		watchlist.Name = payload.Name
+	}
+
+	watchlist.UpdatedAt = time.Now().UTC()
+
+	err = stores.InTx(ctx, func() error {
+		if watchlist, err = s.store.Update(ctx, id, watchlist); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "watchlists", watchlist.ID, AuditActionUpdate, &before, watchlist)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.read(ctx, watchlist)
+}
+
+func (s *watchlistService) Delete(ctx *gofr.Context, id int) error {
+	watchlist, err := s.retrieveOwned(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return stores.InTx(ctx, func() error {
+		if err := s.store.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return s.auditEventService.Record(ctx, "watchlists", id, AuditActionDelete, watchlist, nil)
+	})
+}
+
+func (s *watchlistService) AddSecurity(ctx *gofr.Context, id, securityID int) (*Watchlist, error) {
+	watchlist, err := s.retrieveOwned(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	securityIDs, err := s.getSecurityIDs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(securityIDs, securityID) {
+		return s.buildResp(watchlist, securityIDs), nil
+	}
+
+	if len(securityIDs) >= watchlistMaxSecurities {
+		return nil, &ErrResp{Code: 400, Message: fmt.Sprintf("a watchlist can hold at most %d securities", watchlistMaxSecurities)}
+	}
+
+	if err = s.validateSecurity(ctx, securityID); err != nil {
+		return nil, err
+	}
+
+	return s.touch(ctx, watchlist, securityIDs, append(slices.Clone(securityIDs), securityID), func() error {
+		return s.watchlistSecurityStore.Create(ctx, &stores.WatchlistSecurity{
+			WatchlistID: id,
+			SecurityID:  securityID,
+			Position:    len(securityIDs) + 1,
+			CreatedAt:   time.Now().UTC(),
+		})
+	})
+}
+
+func (s *watchlistService) RemoveSecurity(ctx *gofr.Context, id, securityID int) (*Watchlist, error) {
+	watchlist, err := s.retrieveOwned(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	securityIDs, err := s.getSecurityIDs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.Index(securityIDs, securityID)
+	if i == -1 {
+		return nil, http.ErrorEntityNotFound{Name: "watchlist-securities", Value: strconv.Itoa(securityID)}
+	}
+
+	return s.touch(ctx, watchlist, securityIDs, slices.Delete(slices.Clone(securityIDs), i, i+1), func() error {
+		return s.watchlistSecurityStore.Delete(ctx, id, securityID)
+	})
+}
+
+func (s *watchlistService) Reorder(ctx *gofr.Context, id int, securityIDs []int) (*Watchlist, error) {
+	watchlist, err := s.retrieveOwned(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.getSecurityIDs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	sorted, sortedCurrent := slices.Clone(securityIDs), slices.Clone(current)
+
+	slices.Sort(sorted)
+	slices.Sort(sortedCurrent)
+
+	if !slices.Equal(sorted, sortedCurrent) {
+		return nil, &ErrResp{Code: 400, Message: "securityIds must list every security in the watchlist exactly once"}
+	}
+
+	return s.touch(ctx, watchlist, current, securityIDs, func() error {
+		return s.watchlistSecurityStore.Reorder(ctx, id, securityIDs)
+	})
+}
+
+// touch runs write, which changes the securities of the watchlist from before to after, in one transaction with bumping the watchlist
+// and recording the change.
+func (s *watchlistService) touch(ctx *gofr.Context, watchlist *stores.Watchlist, before, after []int, write func() error) (*Watchlist, error) {
+	beforeResp := s.buildResp(watchlist, before)
+
+	watchlist.UpdatedAt = time.Now().UTC()
+
+	var resp *Watchlist
+
+	err := stores.InTx(ctx, func() error {
+		if err := write(); err != nil {
+			return err
+		}
+
+		watchlist, err := s.store.Update(ctx, watchlist.ID, watchlist)
+		if err != nil {
+			return err
+		}
+
+		resp = s.buildResp(watchlist, after)
+
+		return s.auditEventService.Record(ctx, "watchlists", watchlist.ID, AuditActionUpdate, beforeResp, resp)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *watchlistService) read(ctx *gofr.Context, watchlist *stores.Watchlist) (*Watchlist, error) {
+	securityIDs, err := s.getSecurityIDs(ctx, watchlist.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResp(watchlist, securityIDs), nil
+}
+
+func (s *watchlistService) retrieveOwned(ctx *gofr.Context, id int) (*stores.Watchlist, error) {
+	if err := authorize(ctx, auth.WriteWatchlists); err != nil {
+		return nil, err
+	}
+
+	watchlist, err := s.store.Retrieve(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if watchlist.UserID != auth.PrincipalFromContext(ctx).UserID {
+		return nil, http.ErrorEntityNotFound{Name: "watchlists", Value: strconv.Itoa(id)}
+	}
+
+	return watchlist, nil
+}
+
+func (s *watchlistService) validateName(ctx *gofr.Context, userID, id int, name string) error {
+	if name == "" {
+		return &ErrResp{Code: 400, Message: "name is required"}
+	}
+
+	if len(name) > watchlistNameMaxLength {
+		return &ErrResp{Code: 400, Message: fmt.Sprintf("name must be at most %d characters", watchlistNameMaxLength)}
+	}
+
+	watchlists, err := s.store.Index(ctx, &stores.WatchlistFilter{UserID: userID}, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, w := range watchlists {
+		if w.ID != id && w.Name == name {
+			return &ErrResp{Code: 409, Message: "a watchlist named " + name + " already exists"}
+		}
+	}
+
+	return nil
+}
+
+func (s *watchlistService) validateSecurity(ctx *gofr.Context, securityID int) error {
+	security, err := s.securityStore.Retrieve(ctx, securityID)
+	if err != nil {
+		return err
+	}
+
+	entitlement, err := s.entitlementService.Resolve(ctx)
+	if err != nil {
+		return err
+	}
+
+	if entitlement.Tier != nil && security.Tier > *entitlement.Tier {
+		return &ErrResp{Code: 403, Message: "security is not available on your plan"}
+	}
+
+	return nil
+}
+
+func (s *watchlistService) getSecurityIDs(ctx *gofr.Context, id int) ([]int, error) {
+	securityIDsMap, err := s.getSecurityIDsMap(ctx, []*stores.Watchlist{{ID: id}})
+	if err != nil {
+		return nil, err
+	}
+
+	return securityIDsMap[id], nil
+}
+
+func (s *watchlistService) getSecurityIDsMap(ctx *gofr.Context, watchlists []*stores.Watchlist) (map[int][]int, error) {
+	var watchlistIDs = make([]int, len(watchlists))
+
+	for i := range watchlists {
+		watchlistIDs[i] = watchlists[i].ID
+	}
+
+	watchlistSecurities, err := s.watchlistSecurityStore.Index(ctx, &stores.WatchlistSecurityFilter{WatchlistIDs: watchlistIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	var securityIDsMap = make(map[int][]int)
+
+	for _, ws := range watchlistSecurities {
+		securityIDsMap[ws.WatchlistID] = append(securityIDsMap[ws.WatchlistID], ws.SecurityID)
+	}
+
+	return securityIDsMap, nil
+}
+
+func (s *watchlistService) buildResp(model *stores.Watchlist, securityIDs []int) *Watchlist {
+	resp := &Watchlist{
+		ID:          model.ID,
+		UserID:      model.UserID,
+		Name:        model.Name,
+		SecurityIDs: securityIDs,
+		CreatedAt:   model.CreatedAt,
+		UpdatedAt:   model.UpdatedAt,
+	}
+
+	if resp.SecurityIDs == nil {
+		resp.SecurityIDs = []int{}
+	}
+
+	return resp
+}
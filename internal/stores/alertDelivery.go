@@ -0,0 +1,224 @@
+package stores
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+	"gofr.dev/pkg/gofr/http"
+)
+
+type AlertDeliveryStore interface {
+	Index(ctx *gofr.Context, filter *AlertDeliveryFilter, limit, offset int) ([]*AlertDelivery, error)
+	Count(ctx *gofr.Context, filter *AlertDeliveryFilter) (int, error)
+	Retrieve(ctx *gofr.Context, id int) (*AlertDelivery, error)
+	// Create skips deliveries already queued for the same rule, security and date, returning false.
+	Create(ctx *gofr.Context, alertDelivery *AlertDelivery) (bool, error)
+	Update(ctx *gofr.Context, id int, alertDelivery *AlertDelivery) error
+	// Claim counts an attempt on a pending delivery due by now and pushes its next attempt to leaseUntil, returning false when
+	// another worker claimed it first.
+	Claim(ctx *gofr.Context, id int, now, leaseUntil time.Time) (bool, error)
+}
+
+type AlertDeliveryFilter struct {
+	AlertRuleID int
+	Status      string
+	DueBefore   time.Time
+}
+
+type AlertDelivery struct {
+	ID            int
+	AlertRuleID   int
+	SecurityID    int
+	Date          time.Time
+	Value         float64
+	Payload       string
+	Status        string
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+	DeliveredAt   *time.Time
+	CreatedAt     time.Time
+}
+
+type alertDeliveryStore struct{}
+
+const alertDeliveryColumns = `id, alert_rule_id, security_id, date, value, payload, status, attempts, last_error, next_attempt_at,
+              delivered_at, created_at`
+
+func NewAlertDeliveryStore() *alertDeliveryStore {
+	return &alertDeliveryStore{}
+}
+
+func (s *alertDeliveryStore) Index(ctx *gofr.Context, filter *AlertDeliveryFilter, limit, offset int) ([]*AlertDelivery, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT ` + alertDeliveryColumns + `
+              FROM alert_deliveries %s ORDER BY id`
+
+	if limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+
+		values = append(values, limit, offset)
+	}
+
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	defer rows.Close()
+
+	var alertDeliveries []*AlertDelivery
+
+	for rows.Next() {
+		ad, err := scanAlertDelivery(rows)
+		if err != nil {
+			return nil, datasource.ErrorDB{Err: err}
+		}
+
+		alertDeliveries = append(alertDeliveries, ad)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return alertDeliveries, nil
+}
+
+func (s *alertDeliveryStore) Count(ctx *gofr.Context, filter *AlertDeliveryFilter) (int, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT COUNT(*) FROM alert_deliveries %s`
+
+	var count int
+
+	err := db(ctx).QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
+	if err != nil {
+		return 0, datasource.ErrorDB{Err: err}
+	}
+
+	return count, nil
+}
+
+func (s *alertDeliveryStore) Retrieve(ctx *gofr.Context, id int) (*AlertDelivery, error) {
+	query := `SELECT ` + alertDeliveryColumns + `
+              FROM alert_deliveries WHERE id = ?`
+
+	ad, err := scanAlertDelivery(db(ctx).QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, http.ErrorEntityNotFound{Name: "alert-deliveries", Value: strconv.Itoa(id)}
+		}
+
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return ad, nil
+}
+
+func (s *alertDeliveryStore) Create(ctx *gofr.Context, ad *AlertDelivery) (bool, error) {
+	query := `INSERT IGNORE INTO alert_deliveries (alert_rule_id, security_id, date, value, payload, status, next_attempt_at, created_at)
+              VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := db(ctx).ExecContext(ctx, query, ad.AlertRuleID, ad.SecurityID, ad.Date.Format(time.DateOnly), ad.Value, ad.Payload,
+		ad.Status, ad.NextAttemptAt, ad.CreatedAt)
+	if err != nil {
+		return false, datasource.ErrorDB{Err: err}
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, datasource.ErrorDB{Err: err}
+	}
+
+	return affected > 0, nil
+}
+
+func (s *alertDeliveryStore) Update(ctx *gofr.Context, id int, ad *AlertDelivery) error {
+	query := `UPDATE alert_deliveries SET status = ?, attempts = ?, last_error = ?, next_attempt_at = ?, delivered_at = ?
+              WHERE id = ?`
+
+	var lastError sql.NullString
+
+	if ad.LastError != "" {
+		lastError = sql.NullString{String: ad.LastError, Valid: true}
+	}
+
+	_, err := db(ctx).ExecContext(ctx, query, ad.Status, ad.Attempts, lastError, ad.NextAttemptAt, ad.DeliveredAt, id)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+func (s *alertDeliveryStore) Claim(ctx *gofr.Context, id int, now, leaseUntil time.Time) (bool, error) {
+	query := `UPDATE alert_deliveries SET attempts = attempts + 1, next_attempt_at = ?
+              WHERE id = ? AND status = 'pending' AND next_attempt_at <= ?`
+
+	result, err := db(ctx).ExecContext(ctx, query, leaseUntil, id, now)
+	if err != nil {
+		return false, datasource.ErrorDB{Err: err}
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, datasource.ErrorDB{Err: err}
+	}
+
+	return affected == 1, nil
+}
+
+func scanAlertDelivery(row interface{ Scan(dest ...any) error }) (*AlertDelivery, error) {
+	var (
+		ad          AlertDelivery
+		lastError   sql.NullString
+		deliveredAt sql.NullTime
+	)
+
+	err := row.Scan(&ad.ID, &ad.AlertRuleID, &ad.SecurityID, &ad.Date, &ad.Value, &ad.Payload, &ad.Status, &ad.Attempts, &lastError,
+		&ad.NextAttemptAt, &deliveredAt, &ad.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	ad.LastError = lastError.String
+
+	if deliveredAt.Valid {
+		ad.DeliveredAt = &deliveredAt.Time
+	}
+
+	return &ad, nil
+}
+
+func (f *AlertDeliveryFilter) buildWhereClause() (clause string, values []interface{}) {
+	if f.AlertRuleID != 0 {
+		clause += " AND alert_rule_id = ?"
+
+		values = append(values, f.AlertRuleID)
+	}
+
+	if f.Status != "" {
+		clause += " AND status = ?"
+
+		values = append(values, f.Status)
+	}
+
+	if !f.DueBefore.IsZero() {
+		clause += " AND next_attempt_at <= ?"
+
+		values = append(values, f.DueBefore)
+	}
+
+	if clause != "" {
+		clause = "WHERE" + strings.TrimPrefix(clause, " AND")
+	}
+
+	return clause, values
+}
@@ -0,0 +1,278 @@
+package stores
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+	"gofr.dev/pkg/gofr/http"
+)
+
+type AlertRuleStore interface {
+	Index(ctx *gofr.Context, filter *AlertRuleFilter, limit, offset int) ([]*AlertRule, error)
+	Count(ctx *gofr.Context, filter *AlertRuleFilter) (int, error)
+	Retrieve(ctx *gofr.Context, id int) (*AlertRule, error)
+	Create(ctx *gofr.Context, alertRule *AlertRule) (*AlertRule, error)
+	Update(ctx *gofr.Context, id int, alertRule *AlertRule) (*AlertRule, error)
+	UpdateLastTriggeredAt(ctx *gofr.Context, id int, lastTriggeredAt time.Time) error
+	Delete(ctx *gofr.Context, id int) error
+}
+
+type AlertRuleFilter struct {
+	UserID    int
+	Fields    []string
+	MetricIDs []int
+	// MatchSecurityIDs matches rules on those securities as well as rules on any security, and rules on any of MatchIndexIDs which
+	// should be the indices the securities belong to.
+	MatchSecurityIDs []int
+	MatchIndexIDs    []int
+	Active           *bool
+}
+
+type AlertRule struct {
+	ID              int
+	UserID          int
+	Name            string
+	SecurityID      *int
+	IndexID         *int
+	Field           string
+	MetricID        *int
+	Operator        string
+	Threshold       float64
+	WebhookURL      string
+	WebhookSecret   string
+	Active          bool
+	LastTriggeredAt *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type alertRuleStore struct{}
+
+const alertRuleColumns = `id, user_id, name, security_id, index_id, field, metric_id, operator, threshold, webhook_url, webhook_secret,
+              active, last_triggered_at, created_at, updated_at`
+
+func NewAlertRuleStore() *alertRuleStore {
+	return &alertRuleStore{}
+}
+
+func (s *alertRuleStore) Index(ctx *gofr.Context, filter *AlertRuleFilter, limit, offset int) ([]*AlertRule, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT ` + alertRuleColumns + `
+              FROM alert_rules %s ORDER BY id`
+
+	if limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+
+		values = append(values, limit, offset)
+	}
+
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	defer rows.Close()
+
+	var alertRules []*AlertRule
+
+	for rows.Next() {
+		ar, err := scanAlertRule(rows)
+		if err != nil {
+			return nil, datasource.ErrorDB{Err: err}
+		}
+
+		alertRules = append(alertRules, ar)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return alertRules, nil
+}
+
+func (s *alertRuleStore) Count(ctx *gofr.Context, filter *AlertRuleFilter) (int, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT COUNT(*) FROM alert_rules %s`
+
+	var count int
+
+	err := db(ctx).QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
+	if err != nil {
+		return 0, datasource.ErrorDB{Err: err}
+	}
+
+	return count, nil
+}
+
+func (s *alertRuleStore) Retrieve(ctx *gofr.Context, id int) (*AlertRule, error) {
+	query := `SELECT ` + alertRuleColumns + `
+              FROM alert_rules WHERE id = ?`
+
+	ar, err := scanAlertRule(db(ctx).QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, http.ErrorEntityNotFound{Name: "alerts", Value: strconv.Itoa(id)}
+		}
+
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return ar, nil
+}
+
+func (s *alertRuleStore) Create(ctx *gofr.Context, ar *AlertRule) (*AlertRule, error) {
+	query := `INSERT INTO alert_rules (user_id, name, security_id, index_id, field, metric_id, operator, threshold, webhook_url,
+              webhook_secret, active, created_at, updated_at)
+              VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := db(ctx).ExecContext(ctx, query, ar.UserID, ar.Name, ar.SecurityID, ar.IndexID, ar.Field, ar.MetricID, ar.Operator, ar.Threshold,
+		ar.WebhookURL, ar.WebhookSecret, ar.Active, ar.CreatedAt, ar.UpdatedAt)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, int(id))
+}
+
+func (s *alertRuleStore) Update(ctx *gofr.Context, id int, ar *AlertRule) (*AlertRule, error) {
+	query := `UPDATE alert_rules SET name = ?, operator = ?, threshold = ?, webhook_url = ?, active = ?, created_at = ?, updated_at = ?
+              WHERE id = ?`
+
+	_, err := db(ctx).ExecContext(ctx, query, ar.Name, ar.Operator, ar.Threshold, ar.WebhookURL, ar.Active, ar.CreatedAt, ar.UpdatedAt, id)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, id)
+}
+
+func (s *alertRuleStore) UpdateLastTriggeredAt(ctx *gofr.Context, id int, lastTriggeredAt time.Time) error {
+	_, err := db(ctx).ExecContext(ctx, `UPDATE alert_rules SET last_triggered_at = ? WHERE id = ?`, lastTriggeredAt, id)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+func (s *alertRuleStore) Delete(ctx *gofr.Context, id int) error {
+	_, err := db(ctx).ExecContext(ctx, `DELETE FROM alert_rules WHERE id = ?`, id)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+func scanAlertRule(row interface{ Scan(dest ...any) error }) (*AlertRule, error) {
+	var (
+		ar              AlertRule
+		securityID      sql.NullInt64
+		indexID         sql.NullInt64
+		metricID        sql.NullInt64
+		lastTriggeredAt sql.NullTime
+	)
+
+	err := row.Scan(&ar.ID, &ar.UserID, &ar.Name, &securityID, &indexID, &ar.Field, &metricID, &ar.Operator, &ar.Threshold, &ar.WebhookURL,
+		&ar.WebhookSecret, &ar.Active, &lastTriggeredAt, &ar.CreatedAt, &ar.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if securityID.Valid {
+		id := int(securityID.Int64)
+		ar.SecurityID = &id
+	}
+
+	if indexID.Valid {
+		id := int(indexID.Int64)
+		ar.IndexID = &id
+	}
+
+	if metricID.Valid {
+		id := int(metricID.Int64)
+		ar.MetricID = &id
+	}
+
+	if lastTriggeredAt.Valid {
+		ar.LastTriggeredAt = &lastTriggeredAt.Time
+	}
+
+	return &ar, nil
+}
+
+func (f *AlertRuleFilter) buildWhereClause() (clause string, values []interface{}) {
+	if f.UserID != 0 {
+		clause += " AND user_id = ?"
+
+		values = append(values, f.UserID)
+	}
+
+	if len(f.Fields) > 0 {
+		var placeHolders []string
+
+		for i := range f.Fields {
+			placeHolders = append(placeHolders, "?")
+			values = append(values, f.Fields[i])
+		}
+
+		clause += " AND field IN (" + strings.Join(placeHolders, ", ") + ")"
+	}
+
+	if len(f.MetricIDs) > 0 {
+		var placeHolders []string
+
+		for i := range f.MetricIDs {
+			placeHolders = append(placeHolders, "?")
+			values = append(values, f.MetricIDs[i])
+		}
+
+		clause += " AND metric_id IN (" + strings.Join(placeHolders, ", ") + ")"
+	}
+
+	if len(f.MatchSecurityIDs) > 0 {
+		var securityPlaceHolders, indexPlaceHolders []string
+
+		for i := range f.MatchSecurityIDs {
+			securityPlaceHolders = append(securityPlaceHolders, "?")
+			values = append(values, f.MatchSecurityIDs[i])
+		}
+
+		for i := range f.MatchIndexIDs {
+			indexPlaceHolders = append(indexPlaceHolders, "?")
+			values = append(values, f.MatchIndexIDs[i])
+		}
+
+		if len(indexPlaceHolders) > 0 {
+			clause += " AND (security_id IN (" + strings.Join(securityPlaceHolders, ", ") + ") OR (security_id IS NULL AND " +
+				"(index_id IS NULL OR index_id IN (" + strings.Join(indexPlaceHolders, ", ") + "))))"
+		} else {
+			clause += " AND (security_id IN (" + strings.Join(securityPlaceHolders, ", ") + ") OR (security_id IS NULL AND index_id IS NULL))"
+		}
+	}
+
+	if f.Active != nil {
+		clause += " AND active = ?"
+
+		values = append(values, *f.Active)
+	}
+
+	if clause != "" {
+		clause = "WHERE" + strings.TrimPrefix(clause, " AND")
+	}
+
+	return clause, values
+}
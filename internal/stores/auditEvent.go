@@ -0,0 +1,189 @@
+package stores
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+)
+
+const auditEventBatchSize = 1000
+
+type AuditEventStore interface {
+	Index(ctx *gofr.Context, filter *AuditEventFilter, limit, offset int) ([]*AuditEvent, error)
+	Count(ctx *gofr.Context, filter *AuditEventFilter) (int, error)
+	Create(ctx *gofr.Context, auditEvent *AuditEvent) error
+	CreateBatch(ctx *gofr.Context, auditEvents []*AuditEvent) error
+}
+
+type AuditEventFilter struct {
+	Actor         string
+	Entity        string
+	EntityID      int
+	Action        string
+	Source        string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+}
+
+type AuditEvent struct {
+	ID        int
+	Actor     string
+	ActorType string
+	Entity    string
+	EntityID  int
+	Action    string
+	Source    string
+	Changes   string
+	CreatedAt time.Time
+}
+
+type auditEventStore struct{}
+
+func NewAuditEventStore() *auditEventStore {
+	return &auditEventStore{}
+}
+
+func (s *auditEventStore) Index(ctx *gofr.Context, filter *AuditEventFilter, limit, offset int) ([]*AuditEvent, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT id, actor, actor_type, entity, entity_id, action, source, changes, created_at
+              FROM audit_events %s ORDER BY id DESC`
+
+	if limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+
+		values = append(values, limit, offset)
+	}
+
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	defer rows.Close()
+
+	var auditEvents []*AuditEvent
+
+	for rows.Next() {
+		var ae AuditEvent
+
+		err = rows.Scan(&ae.ID, &ae.Actor, &ae.ActorType, &ae.Entity, &ae.EntityID, &ae.Action, &ae.Source, &ae.Changes, &ae.CreatedAt)
+		if err != nil {
+			return nil, datasource.ErrorDB{Err: err}
+		}
+
+		auditEvents = append(auditEvents, &ae)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return auditEvents, nil
+}
+
+func (s *auditEventStore) Count(ctx *gofr.Context, filter *AuditEventFilter) (int, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT COUNT(*) FROM audit_events %s`
+
+	var count int
+
+	err := db(ctx).QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
+	if err != nil {
+		return 0, datasource.ErrorDB{Err: err}
+	}
+
+	return count, nil
+}
+
+func (s *auditEventStore) Create(ctx *gofr.Context, ae *AuditEvent) error {
+	query := `INSERT INTO audit_events (actor, actor_type, entity, entity_id, action, source, changes, created_at)
+              VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+	_, err := db(ctx).ExecContext(ctx, query, ae.Actor, ae.ActorType, ae.Entity, ae.EntityID, ae.Action, ae.Source, ae.Changes, ae.CreatedAt)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+// CreateBatch writes auditEvents with one multi-row insert per auditEventBatchSize of them.
+func (s *auditEventStore) CreateBatch(ctx *gofr.Context, auditEvents []*AuditEvent) error {
+	for batch := range slices.Chunk(auditEvents, auditEventBatchSize) {
+		var (
+			placeHolders []string
+			values       []interface{}
+		)
+
+		for _, ae := range batch {
+			placeHolders = append(placeHolders, "(?, ?, ?, ?, ?, ?, ?, ?)")
+			values = append(values, ae.Actor, ae.ActorType, ae.Entity, ae.EntityID, ae.Action, ae.Source, ae.Changes, ae.CreatedAt)
+		}
+
+		query := `INSERT INTO audit_events (actor, actor_type, entity, entity_id, action, source, changes, created_at) VALUES ` +
+			strings.Join(placeHolders, ", ")
+
+		if _, err := db(ctx).ExecContext(ctx, query, values...); err != nil {
+			return datasource.ErrorDB{Err: err}
+		}
+	}
+
+	return nil
+}
+
+func (f *AuditEventFilter) buildWhereClause() (clause string, values []interface{}) {
+	if f.Actor != "" {
+		clause += " AND actor = ?"
+
+		values = append(values, f.Actor)
+	}
+
+	if f.Entity != "" {
+		clause += " AND entity = ?"
+
+		values = append(values, f.Entity)
+	}
+
+	if f.EntityID != 0 {
+		clause += " AND entity_id = ?"
+
+		values = append(values, f.EntityID)
+	}
+
+	if f.Action != "" {
+		clause += " AND action = ?"
+
+		values = append(values, f.Action)
+	}
+
+	if f.Source != "" {
+		clause += " AND source = ?"
+
+		values = append(values, f.Source)
+	}
+
+	if f.CreatedAfter != (time.Time{}) {
+		clause += " AND created_at >= ?"
+
+		values = append(values, f.CreatedAfter)
+	}
+
+	if f.CreatedBefore != (time.Time{}) {
+		clause += " AND created_at < ?"
+
+		values = append(values, f.CreatedBefore)
+	}
+
+	if clause != "" {
+		clause = "WHERE" + strings.TrimPrefix(clause, " AND")
+	}
+
+	return clause, values
+}
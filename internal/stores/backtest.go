@@ -0,0 +1,236 @@
+package stores
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+	"gofr.dev/pkg/gofr/http"
+)
+
+type BacktestStore interface {
+	Index(ctx *gofr.Context, filter *BacktestFilter, limit, offset int) ([]*Backtest, error)
+	Count(ctx *gofr.Context, filter *BacktestFilter) (int, error)
+	Retrieve(ctx *gofr.Context, id int) (*Backtest, error)
+	Create(ctx *gofr.Context, backtest *Backtest) (*Backtest, error)
+	Claim(ctx *gofr.Context, id int, startedAt time.Time) (bool, error)
+	Complete(ctx *gofr.Context, id int, backtest *Backtest) error
+	Delete(ctx *gofr.Context, id int) error
+}
+
+type BacktestFilter struct {
+	UserID        int
+	Statuses      []string
+	StartedBefore time.Time
+}
+
+type Backtest struct {
+	ID          int
+	UserID      int
+	Name        string
+	Strategy    string
+	MaxTier     *int
+	Status      string
+	Result      string
+	Error       string
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	CreatedAt   time.Time
+}
+
+type backtestStore struct{}
+
+func NewBacktestStore() *backtestStore {
+	return &backtestStore{}
+}
+
+// Index leaves out the result, which can run to megabytes, use Retrieve to read it.
+func (s *backtestStore) Index(ctx *gofr.Context, filter *BacktestFilter, limit, offset int) ([]*Backtest, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT id, user_id, name, strategy, max_tier, status, '', error, started_at, completed_at, created_at
+              FROM backtests %s ORDER BY id`
+
+	if limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+
+		values = append(values, limit, offset)
+	}
+
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	defer rows.Close()
+
+	var backtests []*Backtest
+
+	for rows.Next() {
+		bt, err := scanBacktest(rows)
+		if err != nil {
+			return nil, datasource.ErrorDB{Err: err}
+		}
+
+		backtests = append(backtests, bt)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return backtests, nil
+}
+
+func (s *backtestStore) Count(ctx *gofr.Context, filter *BacktestFilter) (int, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT COUNT(*) FROM backtests %s`
+
+	var count int
+
+	err := db(ctx).QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
+	if err != nil {
+		return 0, datasource.ErrorDB{Err: err}
+	}
+
+	return count, nil
+}
+
+func (s *backtestStore) Retrieve(ctx *gofr.Context, id int) (*Backtest, error) {
+	query := `SELECT id, user_id, name, strategy, max_tier, status, result, error, started_at, completed_at, created_at
+              FROM backtests WHERE id = ?`
+
+	bt, err := scanBacktest(db(ctx).QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, http.ErrorEntityNotFound{Name: "backtests", Value: strconv.Itoa(id)}
+		}
+
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return bt, nil
+}
+
+func (s *backtestStore) Create(ctx *gofr.Context, bt *Backtest) (*Backtest, error) {
+	query := "INSERT INTO backtests (user_id, name, strategy, max_tier, status, created_at) VALUES (?, ?, ?, ?, ?, ?)"
+
+	result, err := db(ctx).ExecContext(ctx, query, bt.UserID, bt.Name, bt.Strategy, bt.MaxTier, bt.Status, bt.CreatedAt)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, int(id))
+}
+
+// Claim moves a queued backtest to running, it reports false when another runner got there first.
+func (s *backtestStore) Claim(ctx *gofr.Context, id int, startedAt time.Time) (bool, error) {
+	result, err := db(ctx).ExecContext(ctx, `UPDATE backtests SET status = 'running', started_at = ? WHERE id = ? AND status = 'queued'`,
+		startedAt, id)
+	if err != nil {
+		return false, datasource.ErrorDB{Err: err}
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, datasource.ErrorDB{Err: err}
+	}
+
+	return affected == 1, nil
+}
+
+func (s *backtestStore) Complete(ctx *gofr.Context, id int, bt *Backtest) error {
+	query := `UPDATE backtests SET status = ?, result = ?, error = ?, completed_at = ? WHERE id = ?`
+
+	_, err := db(ctx).ExecContext(ctx, query, bt.Status, bt.Result, bt.Error, bt.CompletedAt, id)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+func (s *backtestStore) Delete(ctx *gofr.Context, id int) error {
+	_, err := db(ctx).ExecContext(ctx, `DELETE FROM backtests WHERE id = ?`, id)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+func scanBacktest(row interface{ Scan(dest ...any) error }) (*Backtest, error) {
+	var (
+		bt          Backtest
+		maxTier     sql.NullInt64
+		result      sql.NullString
+		errMessage  sql.NullString
+		startedAt   sql.NullTime
+		completedAt sql.NullTime
+	)
+
+	err := row.Scan(&bt.ID, &bt.UserID, &bt.Name, &bt.Strategy, &maxTier, &bt.Status, &result, &errMessage, &startedAt, &completedAt,
+		&bt.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxTier.Valid {
+		tier := int(maxTier.Int64)
+		bt.MaxTier = &tier
+	}
+
+	bt.Result = result.String
+	bt.Error = errMessage.String
+
+	if startedAt.Valid {
+		bt.StartedAt = &startedAt.Time
+	}
+
+	if completedAt.Valid {
+		bt.CompletedAt = &completedAt.Time
+	}
+
+	return &bt, nil
+}
+
+func (f *BacktestFilter) buildWhereClause() (clause string, values []interface{}) {
+	if f.UserID != 0 {
+		clause += " AND user_id = ?"
+
+		values = append(values, f.UserID)
+	}
+
+	if len(f.Statuses) > 0 {
+		var placeHolders []string
+
+		for i := range f.Statuses {
+			placeHolders = append(placeHolders, "?")
+			values = append(values, f.Statuses[i])
+		}
+
+		clause += " AND status IN (" + strings.Join(placeHolders, ", ") + ")"
+	}
+
+	if !f.StartedBefore.IsZero() {
+		clause += " AND started_at < ?"
+
+		values = append(values, f.StartedBefore)
+	}
+
+	if clause != "" {
+		clause = "WHERE" + strings.TrimPrefix(clause, " AND")
+	}
+
+	return clause, values
+}
@@ -0,0 +1,179 @@
+package stores
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+)
+
+type IndexConstituentStore interface {
+	Index(ctx *gofr.Context, filter *IndexConstituentFilter, limit, offset int) ([]*IndexConstituent, error)
+	LatestEffectiveFrom(ctx *gofr.Context, indexID int) (*time.Time, error)
+	Create(ctx *gofr.Context, ic *IndexConstituent) error
+	Close(ctx *gofr.Context, indexID int, effectiveTo time.Time) error
+	DeleteSnapshot(ctx *gofr.Context, indexID int, effectiveFrom time.Time) error
+}
+
+type IndexConstituentFilter struct {
+	IndexID     int
+	SecurityIDs []int
+	Date        time.Time
+	// MinDate and MaxDate match memberships in effect on any day between them.
+	MinDate time.Time
+	MaxDate time.Time
+}
+
+type IndexConstituent struct {
+	ID            int
+	IndexID       int
+	SecurityID    int
+	Weight        *float64
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time
+	CreatedAt     time.Time
+}
+
+type indexConstituentStore struct{}
+
+func NewIndexConstituentStore() *indexConstituentStore {
+	return &indexConstituentStore{}
+}
+
+func (s *indexConstituentStore) Index(ctx *gofr.Context, filter *IndexConstituentFilter, limit, offset int) ([]*IndexConstituent, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT id, index_id, security_id, weight, effective_from, effective_to, created_at
+              FROM index_constituents %s
+              ORDER BY index_id, weight DESC, security_id`
+
+	if limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+
+		values = append(values, limit, offset)
+	}
+
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	defer rows.Close()
+
+	var indexConstituents []*IndexConstituent
+
+	for rows.Next() {
+		var ic IndexConstituent
+
+		err = rows.Scan(&ic.ID, &ic.IndexID, &ic.SecurityID, &ic.Weight, &ic.EffectiveFrom, &ic.EffectiveTo, &ic.CreatedAt)
+		if err != nil {
+			return nil, datasource.ErrorDB{Err: err}
+		}
+
+		indexConstituents = append(indexConstituents, &ic)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return indexConstituents, nil
+}
+
+func (s *indexConstituentStore) LatestEffectiveFrom(ctx *gofr.Context, indexID int) (*time.Time, error) {
+	var effectiveFrom sql.NullTime
+
+	query := `SELECT MAX(effective_from) FROM index_constituents WHERE index_id = ?`
+
+	err := db(ctx).QueryRowContext(ctx, query, indexID).Scan(&effectiveFrom)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	if !effectiveFrom.Valid {
+		return nil, nil
+	}
+
+	return &effectiveFrom.Time, nil
+}
+
+func (s *indexConstituentStore) Create(ctx *gofr.Context, ic *IndexConstituent) error {
+	query := `INSERT INTO index_constituents (index_id, security_id, weight, effective_from, effective_to, created_at)
+              VALUES (?, ?, ?, ?, ?, ?)`
+
+	_, err := db(ctx).ExecContext(ctx, query, ic.IndexID, ic.SecurityID, ic.Weight, ic.EffectiveFrom, ic.EffectiveTo, ic.CreatedAt)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+// Close ends every open constituent of the index at effectiveTo.
+func (s *indexConstituentStore) Close(ctx *gofr.Context, indexID int, effectiveTo time.Time) error {
+	query := `UPDATE index_constituents SET effective_to = ? WHERE index_id = ? AND effective_to IS NULL`
+
+	_, err := db(ctx).ExecContext(ctx, query, effectiveTo, indexID)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+func (s *indexConstituentStore) DeleteSnapshot(ctx *gofr.Context, indexID int, effectiveFrom time.Time) error {
+	query := `DELETE FROM index_constituents WHERE index_id = ? AND effective_from = ?`
+
+	_, err := db(ctx).ExecContext(ctx, query, indexID, effectiveFrom)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+func (f *IndexConstituentFilter) buildWhereClause() (clause string, values []interface{}) {
+	if f.IndexID != 0 {
+		clause += " AND index_id = ?"
+
+		values = append(values, f.IndexID)
+	}
+
+	if len(f.SecurityIDs) > 0 {
+		var placeHolders []string
+
+		for i := range f.SecurityIDs {
+			placeHolders = append(placeHolders, "?")
+			values = append(values, f.SecurityIDs[i])
+		}
+
+		clause += " AND security_id IN (" + strings.Join(placeHolders, ", ") + ")"
+	}
+
+	if f.Date != (time.Time{}) {
+		clause += " AND effective_from <= ? AND (effective_to IS NULL OR effective_to > ?)"
+
+		values = append(values, f.Date.Format(time.DateOnly), f.Date.Format(time.DateOnly))
+	}
+
+	if f.MaxDate != (time.Time{}) {
+		clause += " AND effective_from <= ?"
+
+		values = append(values, f.MaxDate.Format(time.DateOnly))
+	}
+
+	if f.MinDate != (time.Time{}) {
+		clause += " AND (effective_to IS NULL OR effective_to > ?)"
+
+		values = append(values, f.MinDate.Format(time.DateOnly))
+	}
+
+	if clause != "" {
+		clause = "WHERE" + strings.TrimPrefix(clause, " AND")
+	}
+
+	return clause, values
+}
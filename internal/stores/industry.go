@@ -1,149 +1,176 @@
 package stores
 
 import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
 	"gofr.dev/pkg/gofr/http"
 )
 
 type IndustryStore interface {
-	Index(ctx *gofr.Context) []Industry
+	Index(ctx *gofr.Context, filter *IndustryFilter, limit, offset int) ([]*Industry, error)
+	Retrieve(ctx *gofr.Context, id int) (*Industry, error)
+	Create(ctx *gofr.Context, industry *Industry) (*Industry, error)
+	Update(ctx *gofr.Context, id int, industry *Industry) (*Industry, error)
+	Delete(ctx *gofr.Context, id int) error
 }
 
-const (
-	AutomobileAndAutoComponents Industry = iota
-	CapitalGoods
-	Chemicals
-	Construction
-	ConstructionMaterials
-	ConsumerDurables
-	ConsumerServices
-	Diversified
-	FastMovingConsumerGoods
-	FinancialServices
-	ForestMaterials
-	Healthcare
-	InformationTechnology
-	MediaEntertainmentAndPublication
-	MetalsAndMining
-	OilGasAndConsumableFuels
-	Power
-	Realty
-	Services
-	Telecommunication
-	Textiles
-	Index
-	Bond
-	Gold
-	Silver
-	Utilities
-)
+type IndustryFilter struct {
+	Level    string
+	ParentID int
+}
 
-type Industry int
+type Industry struct {
+	ID        int
+	Name      string
+	Level     string
+	ParentID  *int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
 
 type industryStore struct{}
 
-func NewIndustryStore() IndustryStore {
+func NewIndustryStore() *industryStore {
 	return &industryStore{}
 }
 
-func (s *industryStore) Index(ctx *gofr.Context) []Industry {
-	return []Industry{
-		AutomobileAndAutoComponents,
-		CapitalGoods,
-		Chemicals,
-		Construction,
-		ConstructionMaterials,
-		ConsumerDurables,
-		ConsumerServices,
-		Diversified,
-		FastMovingConsumerGoods,
-		FinancialServices,
-		ForestMaterials,
-		Healthcare,
-		InformationTechnology,
-		MediaEntertainmentAndPublication,
-		MetalsAndMining,
-		OilGasAndConsumableFuels,
-		Power,
-		Realty,
-		Services,
-		Telecommunication,
-		Textiles,
-		Index,
-		Bond,
-		Gold,
-		Silver,
-		Utilities,
+func (s *industryStore) Index(ctx *gofr.Context, filter *IndustryFilter, limit, offset int) ([]*Industry, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT id, name, level, parent_id, created_at, updated_at
+              FROM industries %s ORDER BY id`
+
+	if limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+
+		values = append(values, limit, offset)
 	}
+
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	defer rows.Close()
+
+	var industries []*Industry
+
+	for rows.Next() {
+		var i Industry
+
+		err = rows.Scan(&i.ID, &i.Name, &i.Level, &i.ParentID, &i.CreatedAt, &i.UpdatedAt)
+		if err != nil {
+			return nil, datasource.ErrorDB{Err: err}
+		}
+
+		industries = append(industries, &i)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return industries, nil
 }
 
-func (ex Industry) String() string {
-	var conversionMap = map[Industry]string{
-		AutomobileAndAutoComponents:      "Automobile and Auto Components",
-		CapitalGoods:                     "Capital Goods",
-		Chemicals:                        "Chemicals",
-		Construction:                     "Construction",
-		ConstructionMaterials:            "Construction Materials",
-		ConsumerDurables:                 "Consumer Durables",
-		ConsumerServices:                 "Consumer Services",
-		Diversified:                      "Diversified",
-		FastMovingConsumerGoods:          "Fast Moving Consumer Goods",
-		FinancialServices:                "Financial Services",
-		ForestMaterials:                  "Forest Materials",
-		Healthcare:                       "Healthcare",
-		InformationTechnology:            "Information Technology",
-		MediaEntertainmentAndPublication: "Media Entertainment & Publication",
-		MetalsAndMining:                  "Metals & Mining",
-		OilGasAndConsumableFuels:         "Oil Gas & Consumable Fuels",
-		Power:                            "Power",
-		Realty:                           "Realty",
-		Services:                         "Services",
-		Telecommunication:                "Telecommunication",
-		Textiles:                         "Textiles",
-		Index:                            "Index",
-		Bond:                             "Bond",
-		Gold:                             "Gold",
-		Silver:                           "Silver",
-		Utilities:                        "Utilities",
+func (s *industryStore) Retrieve(ctx *gofr.Context, id int) (*Industry, error) {
+	var i Industry
+
+	query := `SELECT id, name, level, parent_id, created_at, updated_at
+              FROM industries WHERE id = ?`
+
+	err := db(ctx).QueryRowContext(ctx, query, id).Scan(&i.ID, &i.Name, &i.Level, &i.ParentID, &i.CreatedAt, &i.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, http.ErrorEntityNotFound{Name: "industries", Value: strconv.Itoa(id)}
+		}
+
+		return nil, datasource.ErrorDB{Err: err}
 	}
 
-	return conversionMap[ex]
+	return &i, nil
 }
 
-func IndustryFromString(str string) (Industry, error) {
-	var conversionMap = map[string]Industry{
-		"Automobile and Auto Components":    AutomobileAndAutoComponents,
-		"Capital Goods":                     CapitalGoods,
-		"Chemicals":                         Chemicals,
-		"Construction":                      Construction,
-		"Construction Materials":            ConstructionMaterials,
-		"Consumer Durables":                 ConsumerDurables,
-		"Consumer Services":                 ConsumerServices,
-		"Diversified":                       Diversified,
-		"Fast Moving Consumer Goods":        FastMovingConsumerGoods,
-		"Financial Services":                FinancialServices,
-		"Forest Materials":                  ForestMaterials,
-		"Healthcare":                        Healthcare,
-		"Information Technology":            InformationTechnology,
-		"Media Entertainment & Publication": MediaEntertainmentAndPublication,
-		"Metals & Mining":                   MetalsAndMining,
-		"Oil Gas & Consumable Fuels":        OilGasAndConsumableFuels,
-		"Power":                             Power,
-		"Realty":                            Realty,
-		"Services":                          Services,
-		"Telecommunication":                 Telecommunication,
-		"Textiles":                          Textiles,
-		"Index":                             Index,
-		"Bond":                              Bond,
-		"Gold":                              Gold,
-		"Silver":                            Silver,
-		"Utilities":                         Utilities,
+func (s *industryStore) Create(ctx *gofr.Context, industry *Industry) (*Industry, error) {
+	query := "INSERT INTO industries (name, level, parent_id, created_at, updated_at) VALUES (?, ?, ?, ?, ?)"
+
+	result, err := db(ctx).ExecContext(ctx, query, industry.Name, industry.Level, industry.ParentID, industry.CreatedAt, industry.UpdatedAt)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, int(id))
+}
+
+func (s *industryStore) Update(ctx *gofr.Context, id int, industry *Industry) (*Industry, error) {
+	query := `UPDATE industries SET name = ?, level = ?, parent_id = ?, created_at = ?, updated_at = ?
+              WHERE id = ?`
+
+	_, err := db(ctx).ExecContext(ctx, query, industry.Name, industry.Level, industry.ParentID, industry.CreatedAt, industry.UpdatedAt, id)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, id)
+}
+
+func (s *industryStore) Delete(ctx *gofr.Context, id int) error {
+	query := "DELETE FROM industries WHERE id = ?"
+
+	_, err := db(ctx).ExecContext(ctx, query, id)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+// IndustryFromString resolves an industry by name, which keeps the names of the former fixed enum usable by clients.
+func IndustryFromString(ctx *gofr.Context, str string) (*Industry, error) {
+	var i Industry
+
+	query := `SELECT id, name, level, parent_id, created_at, updated_at
+              FROM industries WHERE name = ?`
+
+	err := db(ctx).QueryRowContext(ctx, query, str).Scan(&i.ID, &i.Name, &i.Level, &i.ParentID, &i.CreatedAt, &i.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, http.ErrorEntityNotFound{Name: "industries", Value: str}
+		}
+
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return &i, nil
+}
+
+func (f *IndustryFilter) buildWhereClause() (clause string, values []interface{}) {
+	if f.Level != "" {
+		clause += " AND level = ?"
+
+		values = append(values, f.Level)
+	}
+
+	if f.ParentID != 0 {
+		clause += " AND parent_id = ?"
+
+		values = append(values, f.ParentID)
 	}
 
-	industry, ok := conversionMap[str]
-	if !ok {
-		return 0, http.ErrorEntityNotFound{Name: "industries", Value: str}
+	if clause != "" {
+		clause = "WHERE" + strings.TrimPrefix(clause, " AND")
 	}
 
-	return industry, nil
+	return clause, values
 }
@@ -0,0 +1,47 @@
+package stores
+
+import (
+	"gofr.dev/pkg/gofr/http"
+)
+
+const (
+	Equity InstrumentType = iota
+	ETF
+	Index
+	Bond
+	Gold
+	Silver
+)
+
+type InstrumentType int
+
+func (i InstrumentType) String() string {
+	var conversionMap = map[InstrumentType]string{
+		Equity: "Equity",
+		ETF:    "ETF",
+		Index:  "Index",
+		Bond:   "Bond",
+		Gold:   "Gold",
+		Silver: "Silver",
+	}
+
+	return conversionMap[i]
+}
+
+func InstrumentTypeFromString(str string) (InstrumentType, error) {
+	var conversionMap = map[string]InstrumentType{
+		"Equity": Equity,
+		"ETF":    ETF,
+		"Index":  Index,
+		"Bond":   Bond,
+		"Gold":   Gold,
+		"Silver": Silver,
+	}
+
+	instrumentType, ok := conversionMap[str]
+	if !ok {
+		return 0, http.ErrorEntityNotFound{Name: "instrument-type", Value: str}
+	}
+
+	return instrumentType, nil
+}
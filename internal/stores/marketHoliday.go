@@ -55,7 +55,7 @@ func (s *marketHolidayStore) Index(ctx *gofr.Context, filter *MarketHolidayFilte
 		values = append(values, limit, offset)
 	}
 
-	rows, err := ctx.SQL.QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
 	if err != nil {
 		return nil, datasource.ErrorDB{Err: err}
 	}
@@ -89,7 +89,7 @@ func (s *marketHolidayStore) Count(ctx *gofr.Context, filter *MarketHolidayFilte
 
 	var count int
 
-	err := ctx.SQL.QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
+	err := db(ctx).QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
 	if err != nil {
 		return 0, datasource.ErrorDB{Err: err}
 	}
@@ -103,7 +103,7 @@ func (s *marketHolidayStore) Retrieve(ctx *gofr.Context, id int) (*MarketHoliday
 	query := `SELECT id, date, description, created_at, updated_at
               FROM market_holidays WHERE id = ?`
 
-	err := ctx.SQL.QueryRowContext(ctx, query, id).Scan(&mh.ID, &mh.Date, &mh.Description, &mh.CreatedAt, &mh.UpdatedAt)
+	err := db(ctx).QueryRowContext(ctx, query, id).Scan(&mh.ID, &mh.Date, &mh.Description, &mh.CreatedAt, &mh.UpdatedAt)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, http.ErrorEntityNotFound{Name: "market-holidays", Value: strconv.Itoa(id)}
@@ -118,7 +118,7 @@ func (s *marketHolidayStore) Retrieve(ctx *gofr.Context, id int) (*MarketHoliday
 func (s *marketHolidayStore) Create(ctx *gofr.Context, mh *MarketHoliday) (*MarketHoliday, error) {
 	query := "INSERT INTO market_holidays (date, description, created_at, updated_at) VALUES (?, ?, ?, ?)"
 
-	result, err := ctx.SQL.ExecContext(ctx, query, mh.Date, mh.Description, mh.CreatedAt, mh.UpdatedAt)
+	result, err := db(ctx).ExecContext(ctx, query, mh.Date, mh.Description, mh.CreatedAt, mh.UpdatedAt)
 	if err != nil {
 		return nil, datasource.ErrorDB{Err: err}
 	}
@@ -135,7 +135,7 @@ func (s *marketHolidayStore) Update(ctx *gofr.Context, id int, mh *MarketHoliday
 	query := `UPDATE market_holidays SET date = ?, description = ?, created_at = ?, updated_at = ?
               WHERE id = ?`
 
-	_, err := ctx.SQL.ExecContext(ctx, query, mh.Date, mh.Description, mh.CreatedAt, mh.UpdatedAt, id)
+	_, err := db(ctx).ExecContext(ctx, query, mh.Date, mh.Description, mh.CreatedAt, mh.UpdatedAt, id)
 	if err != nil {
 		return nil, datasource.ErrorDB{Err: err}
 	}
@@ -144,7 +144,7 @@ func (s *marketHolidayStore) Update(ctx *gofr.Context, id int, mh *MarketHoliday
 }
 
 func (s *marketHolidayStore) Delete(ctx *gofr.Context, id int) error {
-	_, err := ctx.SQL.ExecContext(ctx, `DELETE FROM market_holidays WHERE id = ?`, id)
+	_, err := db(ctx).ExecContext(ctx, `DELETE FROM market_holidays WHERE id = ?`, id)
 	if err != nil {
 		return datasource.ErrorDB{Err: err}
 	}
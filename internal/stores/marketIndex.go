@@ -0,0 +1,172 @@
+package stores
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+	"gofr.dev/pkg/gofr/http"
+)
+
+type MarketIndexStore interface {
+	Index(ctx *gofr.Context, filter *MarketIndexFilter, limit, offset int) ([]*MarketIndex, error)
+	Count(ctx *gofr.Context, filter *MarketIndexFilter) (int, error)
+	Retrieve(ctx *gofr.Context, id int) (*MarketIndex, error)
+	Create(ctx *gofr.Context, marketIndex *MarketIndex) (*MarketIndex, error)
+	Update(ctx *gofr.Context, id int, marketIndex *MarketIndex) (*MarketIndex, error)
+	Delete(ctx *gofr.Context, id int) error
+}
+
+type MarketIndexFilter struct {
+	Name   string
+	Symbol string
+}
+
+type MarketIndex struct {
+	ID        int
+	Name      string
+	Symbol    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type marketIndexStore struct{}
+
+func NewMarketIndexStore() *marketIndexStore {
+	return &marketIndexStore{}
+}
+
+func (s *marketIndexStore) Index(ctx *gofr.Context, filter *MarketIndexFilter, limit, offset int) ([]*MarketIndex, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT id, name, symbol, created_at, updated_at
+              FROM market_indices %s ORDER BY id`
+
+	if limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+
+		values = append(values, limit, offset)
+	}
+
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	defer rows.Close()
+
+	var marketIndices []*MarketIndex
+
+	for rows.Next() {
+		var mi MarketIndex
+
+		err = rows.Scan(&mi.ID, &mi.Name, &mi.Symbol, &mi.CreatedAt, &mi.UpdatedAt)
+		if err != nil {
+			return nil, datasource.ErrorDB{Err: err}
+		}
+
+		marketIndices = append(marketIndices, &mi)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return marketIndices, nil
+}
+
+func (s *marketIndexStore) Count(ctx *gofr.Context, filter *MarketIndexFilter) (int, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT COUNT(*) FROM market_indices %s`
+
+	var count int
+
+	err := db(ctx).QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
+	if err != nil {
+		return 0, datasource.ErrorDB{Err: err}
+	}
+
+	return count, nil
+}
+
+func (s *marketIndexStore) Retrieve(ctx *gofr.Context, id int) (*MarketIndex, error) {
+	var mi MarketIndex
+
+	query := `SELECT id, name, symbol, created_at, updated_at
+              FROM market_indices WHERE id = ?`
+
+	err := db(ctx).QueryRowContext(ctx, query, id).Scan(&mi.ID, &mi.Name, &mi.Symbol, &mi.CreatedAt, &mi.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, http.ErrorEntityNotFound{Name: "indices", Value: strconv.Itoa(id)}
+		}
+
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return &mi, nil
+}
+
+func (s *marketIndexStore) Create(ctx *gofr.Context, marketIndex *MarketIndex) (*MarketIndex, error) {
+	query := "INSERT INTO market_indices (name, symbol, created_at, updated_at) VALUES (?, ?, ?, ?)"
+
+	result, err := db(ctx).ExecContext(ctx, query, marketIndex.Name, marketIndex.Symbol, marketIndex.CreatedAt, marketIndex.UpdatedAt)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, int(id))
+}
+
+func (s *marketIndexStore) Update(ctx *gofr.Context, id int, marketIndex *MarketIndex) (*MarketIndex, error) {
+	query := `UPDATE market_indices SET name = ?, symbol = ?, created_at = ?, updated_at = ?
+              WHERE id = ?`
+
+	_, err := db(ctx).ExecContext(ctx, query, marketIndex.Name, marketIndex.Symbol, marketIndex.CreatedAt, marketIndex.UpdatedAt, id)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, id)
+}
+
+func (s *marketIndexStore) Delete(ctx *gofr.Context, id int) error {
+	query := "DELETE FROM market_indices WHERE id = ?"
+
+	_, err := db(ctx).ExecContext(ctx, query, id)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+func (f *MarketIndexFilter) buildWhereClause() (clause string, values []interface{}) {
+	if f.Name != "" {
+		clause += " AND name = ?"
+
+		values = append(values, f.Name)
+	}
+
+	if f.Symbol != "" {
+		clause += " AND symbol = ?"
+
+		values = append(values, f.Symbol)
+	}
+
+	if clause != "" {
+		clause = "WHERE" + strings.TrimPrefix(clause, " AND")
+	}
+
+	return clause, values
+}
@@ -21,9 +21,10 @@ type MetricStore interface {
 }
 
 type MetricFilter struct {
-	Type    *MetricType
-	Period  int
-	MaxTier *int
+	Type               *MetricType
+	Period             int
+	ExcludedIndicators []MetricIndicator
+	MaxTier            *int
 }
 
 type Metric struct {
@@ -55,7 +56,7 @@ func (s *metricStore) Index(ctx *gofr.Context, filter *MetricFilter, limit, offs
 		values = append(values, limit, offset)
 	}
 
-	rows, err := ctx.SQL.QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
 	if err != nil {
 		return nil, datasource.ErrorDB{Err: err}
 	}
@@ -89,7 +90,7 @@ func (s *metricStore) Count(ctx *gofr.Context, filter *MetricFilter) (int, error
 
 	var count int
 
-	err := ctx.SQL.QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
+	err := db(ctx).QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
 	if err != nil {
 		return 0, datasource.ErrorDB{Err: err}
 	}
@@ -103,7 +104,7 @@ func (s *metricStore) Retrieve(ctx *gofr.Context, id int) (*Metric, error) {
 	query := `SELECT id, name, type, period, indicator, tier, created_at, updated_at
               FROM metrics WHERE id = ?`
 
-	err := ctx.SQL.QueryRowContext(ctx, query, id).Scan(&m.ID, &m.Name, &m.Type, &m.Period, &m.Indicator, &m.Tier, &m.CreatedAt, &m.UpdatedAt)
+	err := db(ctx).QueryRowContext(ctx, query, id).Scan(&m.ID, &m.Name, &m.Type, &m.Period, &m.Indicator, &m.Tier, &m.CreatedAt, &m.UpdatedAt)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, http.ErrorEntityNotFound{Name: "metrics", Value: strconv.Itoa(id)}
@@ -118,7 +119,7 @@ func (s *metricStore) Retrieve(ctx *gofr.Context, id int) (*Metric, error) {
 func (s *metricStore) Create(ctx *gofr.Context, m *Metric) (*Metric, error) {
 	query := "INSERT INTO metrics (name, type, period, indicator, tier, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)"
 
-	result, err := ctx.SQL.ExecContext(ctx, query, m.Name, m.Type, m.Period, m.Indicator, m.Tier, m.CreatedAt, m.UpdatedAt)
+	result, err := db(ctx).ExecContext(ctx, query, m.Name, m.Type, m.Period, m.Indicator, m.Tier, m.CreatedAt, m.UpdatedAt)
 	if err != nil {
 		return nil, datasource.ErrorDB{Err: err}
 	}
@@ -135,7 +136,7 @@ func (s *metricStore) Update(ctx *gofr.Context, id int, m *Metric) (*Metric, err
 	query := `UPDATE metrics SET name = ?, type = ?, period = ?, indicator = ?, tier = ?, created_at = ?, updated_at = ?
               WHERE id = ?`
 
-	_, err := ctx.SQL.ExecContext(ctx, query, m.Name, m.Type, m.Period, m.Indicator, m.Tier, m.CreatedAt, m.UpdatedAt, id)
+	_, err := db(ctx).ExecContext(ctx, query, m.Name, m.Type, m.Period, m.Indicator, m.Tier, m.CreatedAt, m.UpdatedAt, id)
 	if err != nil {
 		return nil, datasource.ErrorDB{Err: err}
 	}
@@ -156,6 +157,17 @@ func (f *MetricFilter) buildWhereClause() (clause string, values []interface{})
 		values = append(values, f.Period)
 	}
 
+	if len(f.ExcludedIndicators) > 0 {
+		var placeHolders []string
+
+		for i := range f.ExcludedIndicators {
+			placeHolders = append(placeHolders, "?")
+			values = append(values, f.ExcludedIndicators[i])
+		}
+
+		clause += " AND indicator NOT IN (" + strings.Join(placeHolders, ", ") + ")"
+	}
+
 	if f.MaxTier != nil {
 		clause += " AND tier <= ?"
 
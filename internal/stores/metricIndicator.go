@@ -14,6 +14,7 @@ const (
 	Momentum
 	Volatility
 	Volume
+	Fundamental
 )
 
 type MetricIndicator int
@@ -30,15 +31,17 @@ func (s *metricIndicatorStore) Index(ctx *gofr.Context) []MetricIndicator {
 		Momentum,
 		Volatility,
 		Volume,
+		Fundamental,
 	}
 }
 
 func (m MetricIndicator) String() string {
 	var conversionMap = map[MetricIndicator]string{
-		Trend:      "Trend",
-		Momentum:   "Momentum",
-		Volatility: "Volatility",
-		Volume:     "Volume",
+		Trend:       "Trend",
+		Momentum:    "Momentum",
+		Volatility:  "Volatility",
+		Volume:      "Volume",
+		Fundamental: "Fundamental",
 	}
 
 	return conversionMap[m]
@@ -46,10 +49,11 @@ func (m MetricIndicator) String() string {
 
 func MetricIndicatorFromString(str string) (MetricIndicator, error) {
 	var conversionMap = map[string]MetricIndicator{
-		"Trend":      Trend,
-		"Momentum":   Momentum,
-		"Volatility": Volatility,
-		"Volume":     Volume,
+		"Trend":       Trend,
+		"Momentum":    Momentum,
+		"Volatility":  Volatility,
+		"Volume":      Volume,
+		"Fundamental": Fundamental,
 	}
 
 	metricIndicator, ok := conversionMap[str]
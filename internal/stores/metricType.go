@@ -16,6 +16,10 @@ const (
 	ROC
 	ATR
 	VMA
+	PE
+	PB
+	DY
+	MCAP
 )
 
 type MetricType int
@@ -34,17 +38,25 @@ func (s *metricTypeStore) Index(ctx *gofr.Context) []MetricType {
 		ROC,
 		ATR,
 		VMA,
+		PE,
+		PB,
+		DY,
+		MCAP,
 	}
 }
 
 func (m MetricType) String() string {
 	var conversionMap = map[MetricType]string{
-		SMA: "SMA",
-		EMA: "EMA",
-		RSI: "RSI",
-		ROC: "ROC",
-		ATR: "ATR",
-		VMA: "VMA",
+		SMA:  "SMA",
+		EMA:  "EMA",
+		RSI:  "RSI",
+		ROC:  "ROC",
+		ATR:  "ATR",
+		VMA:  "VMA",
+		PE:   "PE",
+		PB:   "PB",
+		DY:   "DY",
+		MCAP: "MCAP",
 	}
 
 	return conversionMap[m]
@@ -52,12 +64,16 @@ func (m MetricType) String() string {
 
 func MetricTypeFromString(str string) (MetricType, error) {
 	var conversionMap = map[string]MetricType{
-		"SMA": SMA,
-		"EMA": EMA,
-		"RSI": RSI,
-		"ROC": ROC,
-		"ATR": ATR,
-		"VMA": VMA,
+		"SMA":  SMA,
+		"EMA":  EMA,
+		"RSI":  RSI,
+		"ROC":  ROC,
+		"ATR":  ATR,
+		"VMA":  VMA,
+		"PE":   PE,
+		"PB":   PB,
+		"DY":   DY,
+		"MCAP": MCAP,
 	}
 
 	metricType, ok := conversionMap[str]
@@ -0,0 +1,135 @@
+package stores
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+)
+
+const outboxEventBatchSize = 1000
+
+type OutboxEventStore interface {
+	IndexPending(ctx *gofr.Context, limit int) ([]*OutboxEvent, error)
+	Create(ctx *gofr.Context, outboxEvent *OutboxEvent) error
+	CreateBatch(ctx *gofr.Context, outboxEvents []*OutboxEvent) error
+	MarkPublished(ctx *gofr.Context, id int, publishedAt time.Time) error
+	MarkFailed(ctx *gofr.Context, id int, lastError string) error
+	DeletePublishedBefore(ctx *gofr.Context, before time.Time) error
+}
+
+type OutboxEvent struct {
+	ID          int
+	EventID     string
+	Topic       string
+	Payload     string
+	Attempts    int
+	PublishedAt *time.Time
+	CreatedAt   time.Time
+}
+
+type outboxEventStore struct{}
+
+func NewOutboxEventStore() *outboxEventStore {
+	return &outboxEventStore{}
+}
+
+func (s *outboxEventStore) IndexPending(ctx *gofr.Context, limit int) ([]*OutboxEvent, error) {
+	query := `SELECT id, event_id, topic, payload, attempts, published_at, created_at
+              FROM outbox_events WHERE published_at IS NULL ORDER BY id LIMIT ?`
+
+	rows, err := db(ctx).QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	defer rows.Close()
+
+	var outboxEvents []*OutboxEvent
+
+	for rows.Next() {
+		var oe OutboxEvent
+
+		err = rows.Scan(&oe.ID, &oe.EventID, &oe.Topic, &oe.Payload, &oe.Attempts, &oe.PublishedAt, &oe.CreatedAt)
+		if err != nil {
+			return nil, datasource.ErrorDB{Err: err}
+		}
+
+		outboxEvents = append(outboxEvents, &oe)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return outboxEvents, nil
+}
+
+func (s *outboxEventStore) Create(ctx *gofr.Context, outboxEvent *OutboxEvent) error {
+	query := `INSERT INTO outbox_events (event_id, topic, payload, created_at) VALUES (?, ?, ?, ?)`
+
+	_, err := db(ctx).ExecContext(ctx, query, outboxEvent.EventID, outboxEvent.Topic, outboxEvent.Payload, outboxEvent.CreatedAt)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+// CreateBatch writes outboxEvents in order with one multi-row insert per outboxEventBatchSize of them.
+func (s *outboxEventStore) CreateBatch(ctx *gofr.Context, outboxEvents []*OutboxEvent) error {
+	for batch := range slices.Chunk(outboxEvents, outboxEventBatchSize) {
+		var (
+			placeHolders []string
+			values       []interface{}
+		)
+
+		for _, oe := range batch {
+			placeHolders = append(placeHolders, "(?, ?, ?, ?)")
+			values = append(values, oe.EventID, oe.Topic, oe.Payload, oe.CreatedAt)
+		}
+
+		query := `INSERT INTO outbox_events (event_id, topic, payload, created_at) VALUES ` + strings.Join(placeHolders, ", ")
+
+		if _, err := db(ctx).ExecContext(ctx, query, values...); err != nil {
+			return datasource.ErrorDB{Err: err}
+		}
+	}
+
+	return nil
+}
+
+func (s *outboxEventStore) MarkPublished(ctx *gofr.Context, id int, publishedAt time.Time) error {
+	query := `UPDATE outbox_events SET published_at = ?, attempts = attempts + 1, last_error = NULL WHERE id = ?`
+
+	_, err := db(ctx).ExecContext(ctx, query, publishedAt, id)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+func (s *outboxEventStore) MarkFailed(ctx *gofr.Context, id int, lastError string) error {
+	query := `UPDATE outbox_events SET attempts = attempts + 1, last_error = ? WHERE id = ?`
+
+	_, err := db(ctx).ExecContext(ctx, query, lastError, id)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+func (s *outboxEventStore) DeletePublishedBefore(ctx *gofr.Context, before time.Time) error {
+	query := `DELETE FROM outbox_events WHERE published_at IS NOT NULL AND published_at < ?`
+
+	_, err := db(ctx).ExecContext(ctx, query, before)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
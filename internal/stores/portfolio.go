@@ -0,0 +1,162 @@
+package stores
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+	"gofr.dev/pkg/gofr/http"
+)
+
+type PortfolioStore interface {
+	Index(ctx *gofr.Context, filter *PortfolioFilter, limit, offset int) ([]*Portfolio, error)
+	Count(ctx *gofr.Context, filter *PortfolioFilter) (int, error)
+	Retrieve(ctx *gofr.Context, id int) (*Portfolio, error)
+	Create(ctx *gofr.Context, portfolio *Portfolio) (*Portfolio, error)
+	Update(ctx *gofr.Context, id int, portfolio *Portfolio) (*Portfolio, error)
+	Delete(ctx *gofr.Context, id int) error
+}
+
+type PortfolioFilter struct {
+	UserID int
+}
+
+type Portfolio struct {
+	ID        int
+	UserID    int
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type portfolioStore struct{}
+
+func NewPortfolioStore() *portfolioStore {
+	return &portfolioStore{}
+}
+
+func (s *portfolioStore) Index(ctx *gofr.Context, filter *PortfolioFilter, limit, offset int) ([]*Portfolio, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT id, user_id, name, created_at, updated_at
+              FROM portfolios %s ORDER BY id`
+
+	if limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+
+		values = append(values, limit, offset)
+	}
+
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	defer rows.Close()
+
+	var portfolios []*Portfolio
+
+	for rows.Next() {
+		var w Portfolio
+
+		err = rows.Scan(&w.ID, &w.UserID, &w.Name, &w.CreatedAt, &w.UpdatedAt)
+		if err != nil {
+			return nil, datasource.ErrorDB{Err: err}
+		}
+
+		portfolios = append(portfolios, &w)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return portfolios, nil
+}
+
+func (s *portfolioStore) Count(ctx *gofr.Context, filter *PortfolioFilter) (int, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT COUNT(*) FROM portfolios %s`
+
+	var count int
+
+	err := db(ctx).QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
+	if err != nil {
+		return 0, datasource.ErrorDB{Err: err}
+	}
+
+	return count, nil
+}
+
+func (s *portfolioStore) Retrieve(ctx *gofr.Context, id int) (*Portfolio, error) {
+	var w Portfolio
+
+	query := `SELECT id, user_id, name, created_at, updated_at
+              FROM portfolios WHERE id = ?`
+
+	err := db(ctx).QueryRowContext(ctx, query, id).Scan(&w.ID, &w.UserID, &w.Name, &w.CreatedAt, &w.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, http.ErrorEntityNotFound{Name: "portfolios", Value: strconv.Itoa(id)}
+		}
+
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return &w, nil
+}
+
+func (s *portfolioStore) Create(ctx *gofr.Context, w *Portfolio) (*Portfolio, error) {
+	query := "INSERT INTO portfolios (user_id, name, created_at, updated_at) VALUES (?, ?, ?, ?)"
+
+	result, err := db(ctx).ExecContext(ctx, query, w.UserID, w.Name, w.CreatedAt, w.UpdatedAt)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, int(id))
+}
+
+func (s *portfolioStore) Update(ctx *gofr.Context, id int, w *Portfolio) (*Portfolio, error) {
+	query := `UPDATE portfolios SET name = ?, created_at = ?, updated_at = ? WHERE id = ?`
+
+	_, err := db(ctx).ExecContext(ctx, query, w.Name, w.CreatedAt, w.UpdatedAt, id)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, id)
+}
+
+func (s *portfolioStore) Delete(ctx *gofr.Context, id int) error {
+	_, err := db(ctx).ExecContext(ctx, `DELETE FROM portfolios WHERE id = ?`, id)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+func (f *PortfolioFilter) buildWhereClause() (clause string, values []interface{}) {
+	if f.UserID != 0 {
+		clause += " AND user_id = ?"
+
+		values = append(values, f.UserID)
+	}
+
+	if clause != "" {
+		clause = "WHERE" + strings.TrimPrefix(clause, " AND")
+	}
+
+	return clause, values
+}
@@ -0,0 +1,182 @@
+package stores
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+	"gofr.dev/pkg/gofr/http"
+)
+
+type PortfolioTransactionStore interface {
+	Index(ctx *gofr.Context, filter *PortfolioTransactionFilter, limit, offset int) ([]*PortfolioTransaction, error)
+	Count(ctx *gofr.Context, filter *PortfolioTransactionFilter) (int, error)
+	Retrieve(ctx *gofr.Context, id int) (*PortfolioTransaction, error)
+	Create(ctx *gofr.Context, portfolioTransaction *PortfolioTransaction) (*PortfolioTransaction, error)
+	Delete(ctx *gofr.Context, id int) error
+}
+
+type PortfolioTransactionFilter struct {
+	PortfolioID int
+	SecurityID  int
+	Type        string
+	MinDate     time.Time
+	MaxDate     time.Time
+}
+
+type PortfolioTransaction struct {
+	ID          int
+	PortfolioID int
+	SecurityID  int
+	Type        string
+	Quantity    float64
+	Price       float64
+	Date        time.Time
+	CreatedAt   time.Time
+}
+
+type portfolioTransactionStore struct{}
+
+func NewPortfolioTransactionStore() *portfolioTransactionStore {
+	return &portfolioTransactionStore{}
+}
+
+func (s *portfolioTransactionStore) Index(ctx *gofr.Context, filter *PortfolioTransactionFilter, limit, offset int) ([]*PortfolioTransaction, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT id, portfolio_id, security_id, type, quantity, price, date, created_at
+              FROM portfolio_transactions %s ORDER BY date, id`
+
+	if limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+
+		values = append(values, limit, offset)
+	}
+
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	defer rows.Close()
+
+	var portfolioTransactions []*PortfolioTransaction
+
+	for rows.Next() {
+		var pt PortfolioTransaction
+
+		err = rows.Scan(&pt.ID, &pt.PortfolioID, &pt.SecurityID, &pt.Type, &pt.Quantity, &pt.Price, &pt.Date, &pt.CreatedAt)
+		if err != nil {
+			return nil, datasource.ErrorDB{Err: err}
+		}
+
+		portfolioTransactions = append(portfolioTransactions, &pt)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return portfolioTransactions, nil
+}
+
+func (s *portfolioTransactionStore) Count(ctx *gofr.Context, filter *PortfolioTransactionFilter) (int, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT COUNT(*) FROM portfolio_transactions %s`
+
+	var count int
+
+	err := db(ctx).QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
+	if err != nil {
+		return 0, datasource.ErrorDB{Err: err}
+	}
+
+	return count, nil
+}
+
+func (s *portfolioTransactionStore) Retrieve(ctx *gofr.Context, id int) (*PortfolioTransaction, error) {
+	var pt PortfolioTransaction
+
+	query := `SELECT id, portfolio_id, security_id, type, quantity, price, date, created_at
+              FROM portfolio_transactions WHERE id = ?`
+
+	err := db(ctx).QueryRowContext(ctx, query, id).Scan(&pt.ID, &pt.PortfolioID, &pt.SecurityID, &pt.Type, &pt.Quantity, &pt.Price, &pt.Date, &pt.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, http.ErrorEntityNotFound{Name: "portfolio-transactions", Value: strconv.Itoa(id)}
+		}
+
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return &pt, nil
+}
+
+func (s *portfolioTransactionStore) Create(ctx *gofr.Context, pt *PortfolioTransaction) (*PortfolioTransaction, error) {
+	query := `INSERT INTO portfolio_transactions (portfolio_id, security_id, type, quantity, price, date, created_at)
+              VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := db(ctx).ExecContext(ctx, query, pt.PortfolioID, pt.SecurityID, pt.Type, pt.Quantity, pt.Price, pt.Date, pt.CreatedAt)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, int(id))
+}
+
+func (s *portfolioTransactionStore) Delete(ctx *gofr.Context, id int) error {
+	_, err := db(ctx).ExecContext(ctx, `DELETE FROM portfolio_transactions WHERE id = ?`, id)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+func (f *PortfolioTransactionFilter) buildWhereClause() (clause string, values []interface{}) {
+	if f.PortfolioID != 0 {
+		clause += " AND portfolio_id = ?"
+
+		values = append(values, f.PortfolioID)
+	}
+
+	if f.SecurityID != 0 {
+		clause += " AND security_id = ?"
+
+		values = append(values, f.SecurityID)
+	}
+
+	if f.Type != "" {
+		clause += " AND type = ?"
+
+		values = append(values, f.Type)
+	}
+
+	if !f.MinDate.IsZero() {
+		clause += " AND date >= ?"
+
+		values = append(values, f.MinDate)
+	}
+
+	if !f.MaxDate.IsZero() {
+		clause += " AND date <= ?"
+
+		values = append(values, f.MaxDate)
+	}
+
+	if clause != "" {
+		clause = "WHERE" + strings.TrimPrefix(clause, " AND")
+	}
+
+	return clause, values
+}
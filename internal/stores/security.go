@@ -18,26 +18,49 @@ type SecurityStore interface {
 	Retrieve(ctx *gofr.Context, id int) (*Security, error)
 	Create(ctx *gofr.Context, security *Security) (*Security, error)
 	Update(ctx *gofr.Context, id int, security *Security) (*Security, error)
+	UpdateTradability(ctx *gofr.Context, id int, security *Security) (*Security, error)
 }
 
+// currentIndustryColumn selects the industry from the security's assignment in effect today.
+const currentIndustryColumn = `(SELECT industry_id FROM security_industries
+              WHERE security_id = securities.id AND effective_from <= CURRENT_DATE
+              ORDER BY effective_from DESC LIMIT 1)`
+
 type SecurityFilter struct {
-	IDs     []int
-	ISIN    string
-	Symbol  string
-	MaxTier *int
+	IDs             []int
+	ISIN            string
+	ISINs           []string
+	InstrumentTypes []InstrumentType
+	Symbol          string
+	Symbols         []string
+	IndustryIDs     []int
+	IndustryDate    time.Time
+	WatchlistID     int
+	IndexID         int
+	LotSize         int
+	Surveillance    *bool
+	MTFEligible     *bool
+	MaxTier         *int
 }
 
 type Security struct {
-	ID        int
-	ISIN      string
-	Symbol    string
-	Industry  Industry
-	Name      string
-	Image     string
-	LTP       float64
-	Tier      int
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID             int
+	ISIN           string
+	Symbol         string
+	InstrumentType InstrumentType
+	IndustryID     *int
+	Name           string
+	Image          string
+	LTP            float64
+	Tier           int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+
+	LotSize              *int
+	TickSize             *float64
+	SurveillanceFlag     *string
+	SurveillanceCategory *string
+	MTFLeverage          *float64
 }
 
 type securityStore struct{}
@@ -49,16 +72,23 @@ func NewSecurityStore() *securityStore {
 func (s *securityStore) Index(ctx *gofr.Context, filter *SecurityFilter, limit, offset int) ([]*Security, error) {
 	whereClause, values := filter.buildWhereClause()
 
-	query := `SELECT id, isin, symbol, industry, name, image, ltp, tier, created_at, updated_at
+	query := `SELECT id, COALESCE(isin, ''), symbol, instrument_type, ` + currentIndustryColumn + `, name, image, ltp, tier, created_at, updated_at,
+                     lot_size, tick_size, surveillance_flag, surveillance_category, mtf_leverage
               FROM securities %s`
 
+	if filter.WatchlistID != 0 {
+		query += " ORDER BY (SELECT position FROM watchlist_securities WHERE watchlist_id = ? AND security_id = securities.id)"
+
+		values = append(values, filter.WatchlistID)
+	}
+
 	if limit > 0 {
 		query += " LIMIT ? OFFSET ?"
 
 		values = append(values, limit, offset)
 	}
 
-	rows, err := ctx.SQL.QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
 	if err != nil {
 		return nil, datasource.ErrorDB{Err: err}
 	}
@@ -70,7 +100,8 @@ func (s *securityStore) Index(ctx *gofr.Context, filter *SecurityFilter, limit,
 	for rows.Next() {
 		var st Security
 
-		err = rows.Scan(&st.ID, &st.ISIN, &st.Symbol, &st.Industry, &st.Name, &st.Image, &st.LTP, &st.Tier, &st.CreatedAt, &st.UpdatedAt)
+		err = rows.Scan(&st.ID, &st.ISIN, &st.Symbol, &st.InstrumentType, &st.IndustryID, &st.Name, &st.Image, &st.LTP, &st.Tier, &st.CreatedAt, &st.UpdatedAt,
+			&st.LotSize, &st.TickSize, &st.SurveillanceFlag, &st.SurveillanceCategory, &st.MTFLeverage)
 		if err != nil {
 			return nil, datasource.ErrorDB{Err: err}
 		}
@@ -92,7 +123,7 @@ func (s *securityStore) Count(ctx *gofr.Context, filter *SecurityFilter) (int, e
 
 	var count int
 
-	err := ctx.SQL.QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
+	err := db(ctx).QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
 	if err != nil {
 		return 0, datasource.ErrorDB{Err: err}
 	}
@@ -103,10 +134,12 @@ func (s *securityStore) Count(ctx *gofr.Context, filter *SecurityFilter) (int, e
 func (s *securityStore) Retrieve(ctx *gofr.Context, id int) (*Security, error) {
 	var st Security
 
-	query := `SELECT id, isin, symbol, industry, name, image, ltp, tier, created_at, updated_at
+	query := `SELECT id, COALESCE(isin, ''), symbol, instrument_type, ` + currentIndustryColumn + `, name, image, ltp, tier, created_at, updated_at,
+                     lot_size, tick_size, surveillance_flag, surveillance_category, mtf_leverage
               FROM securities WHERE id = ?`
 
-	err := ctx.SQL.QueryRowContext(ctx, query, id).Scan(&st.ID, &st.ISIN, &st.Symbol, &st.Industry, &st.Name, &st.Image, &st.LTP, &st.Tier, &st.CreatedAt, &st.UpdatedAt)
+	err := db(ctx).QueryRowContext(ctx, query, id).Scan(&st.ID, &st.ISIN, &st.Symbol, &st.InstrumentType, &st.IndustryID, &st.Name, &st.Image, &st.LTP, &st.Tier,
+		&st.CreatedAt, &st.UpdatedAt, &st.LotSize, &st.TickSize, &st.SurveillanceFlag, &st.SurveillanceCategory, &st.MTFLeverage)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, http.ErrorEntityNotFound{Name: "securities", Value: strconv.Itoa(id)}
@@ -119,9 +152,10 @@ func (s *securityStore) Retrieve(ctx *gofr.Context, id int) (*Security, error) {
 }
 
 func (s *securityStore) Create(ctx *gofr.Context, st *Security) (*Security, error) {
-	query := "INSERT INTO securities (isin, symbol, industry, name, image, ltp, tier, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)"
+	query := `INSERT INTO securities (isin, symbol, instrument_type, name, image, ltp, tier, created_at, updated_at)
+              VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
-	result, err := ctx.SQL.ExecContext(ctx, query, st.ISIN, st.Symbol, st.Industry, st.Name, st.Image, st.LTP, st.Tier, st.CreatedAt, st.UpdatedAt)
+	result, err := db(ctx).ExecContext(ctx, query, nullableISIN(st.ISIN), st.Symbol, st.InstrumentType, st.Name, st.Image, st.LTP, st.Tier, st.CreatedAt, st.UpdatedAt)
 	if err != nil {
 		return nil, datasource.ErrorDB{Err: err}
 	}
@@ -135,10 +169,23 @@ func (s *securityStore) Create(ctx *gofr.Context, st *Security) (*Security, erro
 }
 
 func (s *securityStore) Update(ctx *gofr.Context, id int, st *Security) (*Security, error) {
-	query := `UPDATE securities SET isin = ?, symbol = ?, industry = ?, name = ?, image = ?, ltp = ?, tier = ?, created_at = ?, updated_at = ?
+	query := `UPDATE securities SET isin = ?, symbol = ?, instrument_type = ?, name = ?, image = ?, ltp = ?, tier = ?, created_at = ?,
+                     updated_at = ?
+              WHERE id = ?`
+
+	_, err := db(ctx).ExecContext(ctx, query, nullableISIN(st.ISIN), st.Symbol, st.InstrumentType, st.Name, st.Image, st.LTP, st.Tier, st.CreatedAt, st.UpdatedAt, id)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, id)
+}
+
+func (s *securityStore) UpdateTradability(ctx *gofr.Context, id int, st *Security) (*Security, error) {
+	query := `UPDATE securities SET lot_size = ?, tick_size = ?, surveillance_flag = ?, surveillance_category = ?, mtf_leverage = ?, updated_at = ?
               WHERE id = ?`
 
-	_, err := ctx.SQL.ExecContext(ctx, query, st.ISIN, st.Symbol, st.Industry, st.Name, st.Image, st.LTP, st.Tier, st.CreatedAt, st.UpdatedAt, id)
+	_, err := db(ctx).ExecContext(ctx, query, st.LotSize, st.TickSize, st.SurveillanceFlag, st.SurveillanceCategory, st.MTFLeverage, st.UpdatedAt, id)
 	if err != nil {
 		return nil, datasource.ErrorDB{Err: err}
 	}
@@ -146,6 +193,11 @@ func (s *securityStore) Update(ctx *gofr.Context, id int, st *Security) (*Securi
 	return s.Retrieve(ctx, id)
 }
 
+// nullableISIN stores a missing ISIN as NULL, indices have none and the column is unique.
+func nullableISIN(isin string) sql.NullString {
+	return sql.NullString{String: isin, Valid: isin != ""}
+}
+
 func (f *SecurityFilter) buildWhereClause() (clause string, values []interface{}) {
 	if len(f.IDs) > 0 {
 		var placeHolders []string
@@ -158,18 +210,107 @@ func (f *SecurityFilter) buildWhereClause() (clause string, values []interface{}
 		clause += " AND id IN (" + strings.Join(placeHolders, ", ") + ")"
 	}
 
+	if len(f.InstrumentTypes) > 0 {
+		var placeHolders []string
+
+		for i := range f.InstrumentTypes {
+			placeHolders = append(placeHolders, "?")
+			values = append(values, f.InstrumentTypes[i])
+		}
+
+		clause += " AND instrument_type IN (" + strings.Join(placeHolders, ", ") + ")"
+	}
+
 	if f.ISIN != "" {
 		clause += " AND isin = ?"
 
 		values = append(values, f.ISIN)
 	}
 
+	if len(f.ISINs) > 0 {
+		var placeHolders []string
+
+		for i := range f.ISINs {
+			placeHolders = append(placeHolders, "?")
+			values = append(values, f.ISINs[i])
+		}
+
+		clause += " AND isin IN (" + strings.Join(placeHolders, ", ") + ")"
+	}
+
 	if f.Symbol != "" {
 		clause += " AND symbol = ?"
 
 		values = append(values, f.Symbol)
 	}
 
+	if len(f.Symbols) > 0 {
+		var placeHolders []string
+
+		for i := range f.Symbols {
+			placeHolders = append(placeHolders, "?")
+			values = append(values, f.Symbols[i])
+		}
+
+		clause += " AND symbol IN (" + strings.Join(placeHolders, ", ") + ")"
+	}
+
+	if len(f.IndustryIDs) > 0 {
+		var placeHolders []string
+
+		for i := range f.IndustryIDs {
+			placeHolders = append(placeHolders, "?")
+			values = append(values, f.IndustryIDs[i])
+		}
+
+		date := f.IndustryDate
+		if date.IsZero() {
+			date = time.Now().UTC()
+		}
+
+		clause += ` AND id IN (SELECT security_id FROM security_industries WHERE industry_id IN (` + strings.Join(placeHolders, ", ") + `)
+                  AND effective_from <= ? AND (effective_to IS NULL OR effective_to > ?))`
+
+		values = append(values, date.Format(time.DateOnly), date.Format(time.DateOnly))
+	}
+
+	if f.WatchlistID != 0 {
+		clause += " AND id IN (SELECT security_id FROM watchlist_securities WHERE watchlist_id = ?)"
+
+		values = append(values, f.WatchlistID)
+	}
+
+	if f.IndexID != 0 {
+		date := time.Now().UTC().Format(time.DateOnly)
+
+		clause += ` AND id IN (SELECT security_id FROM index_constituents WHERE index_id = ?
+                  AND effective_from <= ? AND (effective_to IS NULL OR effective_to > ?))`
+
+		values = append(values, f.IndexID, date, date)
+	}
+
+	if f.LotSize != 0 {
+		clause += " AND lot_size = ?"
+
+		values = append(values, f.LotSize)
+	}
+
+	if f.Surveillance != nil && *f.Surveillance {
+		clause += " AND surveillance_flag IS NOT NULL AND surveillance_flag <> 'N'"
+	}
+
+	if f.Surveillance != nil && !*f.Surveillance {
+		clause += " AND (surveillance_flag IS NULL OR surveillance_flag = 'N')"
+	}
+
+	if f.MTFEligible != nil && *f.MTFEligible {
+		clause += " AND mtf_leverage > 0"
+	}
+
+	if f.MTFEligible != nil && !*f.MTFEligible {
+		clause += " AND (mtf_leverage IS NULL OR mtf_leverage = 0)"
+	}
+
 	if f.MaxTier != nil {
 		clause += " AND tier <= ?"
 
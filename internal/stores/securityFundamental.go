@@ -0,0 +1,204 @@
+package stores
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+	"gofr.dev/pkg/gofr/http"
+)
+
+type SecurityFundamentalStore interface {
+	Index(ctx *gofr.Context, filter *SecurityFundamentalFilter, limit, offset int) ([]*SecurityFundamental, error)
+	Count(ctx *gofr.Context, filter *SecurityFundamentalFilter) (int, error)
+	Retrieve(ctx *gofr.Context, id int) (*SecurityFundamental, error)
+	Create(ctx *gofr.Context, sf *SecurityFundamental) (*SecurityFundamental, error)
+	Update(ctx *gofr.Context, id int, sf *SecurityFundamental) (*SecurityFundamental, error)
+}
+
+type SecurityFundamentalFilter struct {
+	SecurityIDs  []int
+	PeriodEnd    time.Time
+	MinPeriodEnd time.Time
+	MaxPeriodEnd time.Time
+	ReportedBy   time.Time
+}
+
+type SecurityFundamental struct {
+	ID                int
+	SecurityID        int
+	PeriodEnd         time.Time
+	ReportedAt        *time.Time
+	SharesOutstanding int64
+	Revenue           *float64
+	NetIncome         *float64
+	EPS               *float64
+	BookValue         *float64
+	DividendPerShare  *float64
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+type securityFundamentalStore struct{}
+
+func NewSecurityFundamentalStore() *securityFundamentalStore {
+	return &securityFundamentalStore{}
+}
+
+func (s *securityFundamentalStore) Index(ctx *gofr.Context, filter *SecurityFundamentalFilter, limit, offset int) ([]*SecurityFundamental, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT id, security_id, period_end, reported_at, shares_outstanding, revenue, net_income, eps, book_value,
+                     dividend_per_share, created_at, updated_at
+              FROM security_fundamentals %s
+              ORDER BY security_id, period_end DESC`
+
+	if limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+
+		values = append(values, limit, offset)
+	}
+
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	defer rows.Close()
+
+	var securityFundamentals []*SecurityFundamental
+
+	for rows.Next() {
+		var sf SecurityFundamental
+
+		err = rows.Scan(&sf.ID, &sf.SecurityID, &sf.PeriodEnd, &sf.ReportedAt, &sf.SharesOutstanding, &sf.Revenue, &sf.NetIncome, &sf.EPS,
+			&sf.BookValue, &sf.DividendPerShare, &sf.CreatedAt, &sf.UpdatedAt)
+		if err != nil {
+			return nil, datasource.ErrorDB{Err: err}
+		}
+
+		securityFundamentals = append(securityFundamentals, &sf)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return securityFundamentals, nil
+}
+
+func (s *securityFundamentalStore) Count(ctx *gofr.Context, filter *SecurityFundamentalFilter) (int, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT COUNT(*) FROM security_fundamentals %s`
+
+	var count int
+
+	err := db(ctx).QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
+	if err != nil {
+		return 0, datasource.ErrorDB{Err: err}
+	}
+
+	return count, nil
+}
+
+func (s *securityFundamentalStore) Retrieve(ctx *gofr.Context, id int) (*SecurityFundamental, error) {
+	var sf SecurityFundamental
+
+	query := `SELECT id, security_id, period_end, reported_at, shares_outstanding, revenue, net_income, eps, book_value,
+                     dividend_per_share, created_at, updated_at
+              FROM security_fundamentals WHERE id = ?`
+
+	err := db(ctx).QueryRowContext(ctx, query, id).Scan(&sf.ID, &sf.SecurityID, &sf.PeriodEnd, &sf.ReportedAt, &sf.SharesOutstanding,
+		&sf.Revenue, &sf.NetIncome, &sf.EPS, &sf.BookValue, &sf.DividendPerShare, &sf.CreatedAt, &sf.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, http.ErrorEntityNotFound{Name: "security-fundamentals", Value: strconv.Itoa(id)}
+		}
+
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return &sf, nil
+}
+
+func (s *securityFundamentalStore) Create(ctx *gofr.Context, sf *SecurityFundamental) (*SecurityFundamental, error) {
+	query := `INSERT INTO security_fundamentals (security_id, period_end, reported_at, shares_outstanding, revenue, net_income, eps,
+                                                 book_value, dividend_per_share, created_at, updated_at)
+              VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := db(ctx).ExecContext(ctx, query, sf.SecurityID, sf.PeriodEnd, sf.ReportedAt, sf.SharesOutstanding, sf.Revenue, sf.NetIncome,
+		sf.EPS, sf.BookValue, sf.DividendPerShare, sf.CreatedAt, sf.UpdatedAt)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, int(id))
+}
+
+func (s *securityFundamentalStore) Update(ctx *gofr.Context, id int, sf *SecurityFundamental) (*SecurityFundamental, error) {
+	query := `UPDATE security_fundamentals SET security_id = ?, period_end = ?, reported_at = ?, shares_outstanding = ?, revenue = ?,
+                     net_income = ?, eps = ?, book_value = ?, dividend_per_share = ?, created_at = ?, updated_at = ?
+              WHERE id = ?`
+
+	_, err := db(ctx).ExecContext(ctx, query, sf.SecurityID, sf.PeriodEnd, sf.ReportedAt, sf.SharesOutstanding, sf.Revenue, sf.NetIncome,
+		sf.EPS, sf.BookValue, sf.DividendPerShare, sf.CreatedAt, sf.UpdatedAt, id)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, id)
+}
+
+func (f *SecurityFundamentalFilter) buildWhereClause() (clause string, values []interface{}) {
+	if len(f.SecurityIDs) > 0 {
+		var placeHolders []string
+
+		for i := range f.SecurityIDs {
+			placeHolders = append(placeHolders, "?")
+			values = append(values, f.SecurityIDs[i])
+		}
+
+		clause += " AND security_id IN (" + strings.Join(placeHolders, ", ") + ")"
+	}
+
+	if f.PeriodEnd != (time.Time{}) {
+		clause += " AND period_end = ?"
+
+		values = append(values, f.PeriodEnd.Format(time.DateOnly))
+	}
+
+	if f.MinPeriodEnd != (time.Time{}) {
+		clause += " AND period_end >= ?"
+
+		values = append(values, f.MinPeriodEnd.Format(time.DateOnly))
+	}
+
+	if f.MaxPeriodEnd != (time.Time{}) {
+		clause += " AND period_end <= ?"
+
+		values = append(values, f.MaxPeriodEnd.Format(time.DateOnly))
+	}
+
+	// results only become public once reported, falling back to the period end when the report date is unknown
+	if f.ReportedBy != (time.Time{}) {
+		clause += " AND COALESCE(reported_at, period_end) <= ?"
+
+		values = append(values, f.ReportedBy.Format(time.DateOnly))
+	}
+
+	if clause != "" {
+		clause = "WHERE" + strings.TrimPrefix(clause, " AND")
+	}
+
+	return clause, values
+}
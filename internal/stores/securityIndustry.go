@@ -0,0 +1,182 @@
+package stores
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+	"gofr.dev/pkg/gofr/http"
+)
+
+type SecurityIndustryStore interface {
+	Index(ctx *gofr.Context, filter *SecurityIndustryFilter, limit, offset int) ([]*SecurityIndustry, error)
+	Count(ctx *gofr.Context, filter *SecurityIndustryFilter) (int, error)
+	Retrieve(ctx *gofr.Context, id int) (*SecurityIndustry, error)
+	Create(ctx *gofr.Context, si *SecurityIndustry) (*SecurityIndustry, error)
+	Update(ctx *gofr.Context, id int, si *SecurityIndustry) (*SecurityIndustry, error)
+	Delete(ctx *gofr.Context, id int) error
+}
+
+type SecurityIndustryFilter struct {
+	SecurityID  int
+	IndustryIDs []int
+}
+
+type SecurityIndustry struct {
+	ID            int
+	SecurityID    int
+	IndustryID    int
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type securityIndustryStore struct{}
+
+func NewSecurityIndustryStore() *securityIndustryStore {
+	return &securityIndustryStore{}
+}
+
+func (s *securityIndustryStore) Index(ctx *gofr.Context, filter *SecurityIndustryFilter, limit, offset int) ([]*SecurityIndustry, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT id, security_id, industry_id, effective_from, effective_to, created_at, updated_at
+              FROM security_industries %s
+              ORDER BY security_id, effective_from DESC`
+
+	if limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+
+		values = append(values, limit, offset)
+	}
+
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	defer rows.Close()
+
+	var securityIndustries []*SecurityIndustry
+
+	for rows.Next() {
+		var si SecurityIndustry
+
+		err = rows.Scan(&si.ID, &si.SecurityID, &si.IndustryID, &si.EffectiveFrom, &si.EffectiveTo, &si.CreatedAt, &si.UpdatedAt)
+		if err != nil {
+			return nil, datasource.ErrorDB{Err: err}
+		}
+
+		securityIndustries = append(securityIndustries, &si)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return securityIndustries, nil
+}
+
+func (s *securityIndustryStore) Count(ctx *gofr.Context, filter *SecurityIndustryFilter) (int, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT COUNT(*) FROM security_industries %s`
+
+	var count int
+
+	err := db(ctx).QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
+	if err != nil {
+		return 0, datasource.ErrorDB{Err: err}
+	}
+
+	return count, nil
+}
+
+func (s *securityIndustryStore) Retrieve(ctx *gofr.Context, id int) (*SecurityIndustry, error) {
+	var si SecurityIndustry
+
+	query := `SELECT id, security_id, industry_id, effective_from, effective_to, created_at, updated_at
+              FROM security_industries WHERE id = ?`
+
+	err := db(ctx).QueryRowContext(ctx, query, id).Scan(&si.ID, &si.SecurityID, &si.IndustryID, &si.EffectiveFrom, &si.EffectiveTo, &si.CreatedAt, &si.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, http.ErrorEntityNotFound{Name: "security-industries", Value: strconv.Itoa(id)}
+		}
+
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return &si, nil
+}
+
+func (s *securityIndustryStore) Create(ctx *gofr.Context, si *SecurityIndustry) (*SecurityIndustry, error) {
+	query := `INSERT INTO security_industries (security_id, industry_id, effective_from, effective_to, created_at, updated_at)
+              VALUES (?, ?, ?, ?, ?, ?)`
+
+	result, err := db(ctx).ExecContext(ctx, query, si.SecurityID, si.IndustryID, si.EffectiveFrom, si.EffectiveTo, si.CreatedAt, si.UpdatedAt)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, int(id))
+}
+
+func (s *securityIndustryStore) Update(ctx *gofr.Context, id int, si *SecurityIndustry) (*SecurityIndustry, error) {
+	query := `UPDATE security_industries SET security_id = ?, industry_id = ?, effective_from = ?, effective_to = ?, created_at = ?, updated_at = ?
+              WHERE id = ?`
+
+	_, err := db(ctx).ExecContext(ctx, query, si.SecurityID, si.IndustryID, si.EffectiveFrom, si.EffectiveTo, si.CreatedAt,
+		si.UpdatedAt, id)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, id)
+}
+
+func (s *securityIndustryStore) Delete(ctx *gofr.Context, id int) error {
+	query := "DELETE FROM security_industries WHERE id = ?"
+
+	_, err := db(ctx).ExecContext(ctx, query, id)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+func (f *SecurityIndustryFilter) buildWhereClause() (clause string, values []interface{}) {
+	if f.SecurityID != 0 {
+		clause += " AND security_id = ?"
+
+		values = append(values, f.SecurityID)
+	}
+
+	if len(f.IndustryIDs) > 0 {
+		var placeHolders []string
+
+		for i := range f.IndustryIDs {
+			placeHolders = append(placeHolders, "?")
+			values = append(values, f.IndustryIDs[i])
+		}
+
+		clause += " AND industry_id IN (" + strings.Join(placeHolders, ", ") + ")"
+	}
+
+	if clause != "" {
+		clause = "WHERE" + strings.TrimPrefix(clause, " AND")
+	}
+
+	return clause, values
+}
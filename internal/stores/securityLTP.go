@@ -0,0 +1,98 @@
+package stores
+
+import (
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+)
+
+type SecurityLTPStore interface {
+	IndexAsOf(ctx *gofr.Context, securityIDs []int, asOf time.Time) ([]*SecurityLTP, error)
+	Create(ctx *gofr.Context, securityLTP *SecurityLTP) error
+	DeleteBefore(ctx *gofr.Context, securityID int, before time.Time) error
+}
+
+type SecurityLTP struct {
+	SecurityID int
+	LTP        float64
+	RecordedAt time.Time
+}
+
+type securityLTPStore struct{}
+
+func NewSecurityLTPStore() *securityLTPStore {
+	return &securityLTPStore{}
+}
+
+func (s *securityLTPStore) IndexAsOf(ctx *gofr.Context, securityIDs []int, asOf time.Time) ([]*SecurityLTP, error) {
+	if len(securityIDs) == 0 {
+		return nil, nil
+	}
+
+	var (
+		placeHolders []string
+		values       []interface{}
+	)
+
+	for i := range securityIDs {
+		placeHolders = append(placeHolders, "?")
+		values = append(values, securityIDs[i])
+	}
+
+	values = append(values, asOf)
+
+	query := `SELECT h.security_id, h.ltp, h.recorded_at
+              FROM security_ltp_history h
+              JOIN (SELECT security_id, MAX(recorded_at) AS recorded_at FROM security_ltp_history
+                    WHERE security_id IN (` + strings.Join(placeHolders, ", ") + `) AND recorded_at <= ?
+                    GROUP BY security_id) l
+              ON h.security_id = l.security_id AND h.recorded_at = l.recorded_at`
+
+	rows, err := db(ctx).QueryContext(ctx, query, values...)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	defer rows.Close()
+
+	var securityLTPs []*SecurityLTP
+
+	for rows.Next() {
+		var sl SecurityLTP
+
+		err = rows.Scan(&sl.SecurityID, &sl.LTP, &sl.RecordedAt)
+		if err != nil {
+			return nil, datasource.ErrorDB{Err: err}
+		}
+
+		securityLTPs = append(securityLTPs, &sl)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return securityLTPs, nil
+}
+
+func (s *securityLTPStore) Create(ctx *gofr.Context, sl *SecurityLTP) error {
+	query := "INSERT INTO security_ltp_history (security_id, ltp, recorded_at) VALUES (?, ?, ?)"
+
+	_, err := db(ctx).ExecContext(ctx, query, sl.SecurityID, sl.LTP, sl.RecordedAt)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+func (s *securityLTPStore) DeleteBefore(ctx *gofr.Context, securityID int, before time.Time) error {
+	_, err := db(ctx).ExecContext(ctx, `DELETE FROM security_ltp_history WHERE security_id = ? AND recorded_at < ?`, securityID, before)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
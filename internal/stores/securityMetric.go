@@ -17,16 +17,23 @@ const cacheExpiry = 3 * 324 * time.Hour
 
 type SecurityMetricStore interface {
 	Index(ctx *gofr.Context, filter *SecurityMetricFilter, limit, offset int) ([]*SecurityMetric, error)
+	IndexBySecurityIDs(ctx *gofr.Context, securityIDs []int, date time.Time) ([]*SecurityMetric, error)
 	Count(ctx *gofr.Context, filter *SecurityMetricFilter) (int, error)
+	Stream(ctx *gofr.Context, filter *SecurityMetricFilter, fn func(sm *SecurityMetric) error) error
 	Retrieve(ctx *gofr.Context, id int) (*SecurityMetric, error)
 	Create(ctx *gofr.Context, sm *SecurityMetric) (*SecurityMetric, error)
 	Update(ctx *gofr.Context, id int, sm *SecurityMetric) (*SecurityMetric, error)
+	Upsert(ctx *gofr.Context, securityMetrics []*SecurityMetric) ([]int, error)
 }
 
 type SecurityMetricFilter struct {
-	SecurityID int
-	MetricID   int
-	Date       time.Time
+	SecurityID  int
+	SecurityIDs []int
+	MetricID    int
+	MetricIDs   []int
+	Date        time.Time
+	MinDate     time.Time
+	MaxDate     time.Time
 }
 
 type SecurityMetric struct {
@@ -52,7 +59,7 @@ func (s *securityMetricStore) Index(ctx *gofr.Context, filter *SecurityMetricFil
 	)
 
 	if isCacheable {
-		cacheKey = fmt.Sprintf("security_metrics:security_id:%d:date:%s", filter.SecurityID, filter.Date.Format(time.DateOnly))
+		cacheKey = securityMetricsCacheKey(filter.SecurityID, filter.Date)
 		cache, err := ctx.Redis.Get(ctx, cacheKey).Bytes()
 		if err == nil {
 			var securityMetrics []*SecurityMetric
@@ -73,7 +80,7 @@ func (s *securityMetricStore) Index(ctx *gofr.Context, filter *SecurityMetricFil
 		values = append(values, limit, offset)
 	}
 
-	rows, err := ctx.SQL.QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
 	if err != nil {
 		return nil, datasource.ErrorDB{Err: err}
 	}
@@ -108,6 +115,110 @@ func (s *securityMetricStore) Index(ctx *gofr.Context, filter *SecurityMetricFil
 	return securityMetrics, nil
 }
 
+func (s *securityMetricStore) IndexBySecurityIDs(ctx *gofr.Context, securityIDs []int, date time.Time) ([]*SecurityMetric, error) {
+	var (
+		securityMetrics []*SecurityMetric
+		missedIDs       = securityIDs
+		isCacheable     = time.Since(date) <= cacheExpiry
+	)
+
+	if len(securityIDs) == 0 {
+		return nil, nil
+	}
+
+	if isCacheable {
+		cacheKeys := make([]string, len(securityIDs))
+
+		for i := range securityIDs {
+			cacheKeys[i] = securityMetricsCacheKey(securityIDs[i], date)
+		}
+
+		caches, err := ctx.Redis.MGet(ctx, cacheKeys...).Result()
+		if err != nil {
+			ctx.Warnf("failed to get cache, keys: %d, err: %v", len(cacheKeys), err)
+		}
+
+		if err == nil {
+			missedIDs = nil
+
+			for i := range securityIDs {
+				var cached []*SecurityMetric
+
+				cache, ok := caches[i].(string)
+				if ok && json.Unmarshal([]byte(cache), &cached) == nil {
+					securityMetrics = append(securityMetrics, cached...)
+					continue
+				}
+
+				missedIDs = append(missedIDs, securityIDs[i])
+			}
+		}
+	}
+
+	if len(missedIDs) == 0 {
+		return securityMetrics, nil
+	}
+
+	var (
+		placeHolders = make([]string, len(missedIDs))
+		values       = make([]interface{}, 0, len(missedIDs)+1)
+	)
+
+	for i := range missedIDs {
+		placeHolders[i] = "?"
+		values = append(values, missedIDs[i])
+	}
+
+	values = append(values, date.Format(time.DateOnly))
+
+	query := `SELECT id, security_id, metric_id, date, value, created_at, updated_at
+              FROM security_metrics WHERE security_id IN (` + strings.Join(placeHolders, ", ") + `) AND date = ?`
+
+	rows, err := db(ctx).QueryContext(ctx, query, values...)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	defer rows.Close()
+
+	var fetched = make(map[int][]*SecurityMetric, len(missedIDs))
+
+	for rows.Next() {
+		var sm SecurityMetric
+
+		err = rows.Scan(&sm.ID, &sm.SecurityID, &sm.MetricID, &sm.Date, &sm.Value, &sm.CreatedAt, &sm.UpdatedAt)
+		if err != nil {
+			return nil, datasource.ErrorDB{Err: err}
+		}
+
+		fetched[sm.SecurityID] = append(fetched[sm.SecurityID], &sm)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	for i := range missedIDs {
+		securityMetrics = append(securityMetrics, fetched[missedIDs[i]]...)
+	}
+
+	if isCacheable {
+		pipe := ctx.Redis.Pipeline()
+
+		for i := range missedIDs {
+			serialized, _ := json.Marshal(fetched[missedIDs[i]])
+
+			pipe.Set(ctx, securityMetricsCacheKey(missedIDs[i], date), serialized, cacheExpiry)
+		}
+
+		if _, err = pipe.Exec(ctx); err != nil {
+			ctx.Warnf("failed to set cache, keys: %d, err: %v", len(missedIDs), err)
+		}
+	}
+
+	return securityMetrics, nil
+}
+
 func (s *securityMetricStore) Count(ctx *gofr.Context, filter *SecurityMetricFilter) (int, error) {
 	whereClause, values := filter.buildWhereClause()
 
@@ -115,7 +226,7 @@ func (s *securityMetricStore) Count(ctx *gofr.Context, filter *SecurityMetricFil
 
 	var count int
 
-	err := ctx.SQL.QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
+	err := db(ctx).QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
 	if err != nil {
 		return 0, datasource.ErrorDB{Err: err}
 	}
@@ -123,13 +234,49 @@ func (s *securityMetricStore) Count(ctx *gofr.Context, filter *SecurityMetricFil
 	return count, nil
 }
 
+// Stream calls fn for every matching row in security, date and metric order, bypassing the cache and without loading the result
+// set into memory, it stops at the first error fn returns.
+func (s *securityMetricStore) Stream(ctx *gofr.Context, filter *SecurityMetricFilter, fn func(sm *SecurityMetric) error) error {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT id, security_id, metric_id, date, value, created_at, updated_at
+              FROM security_metrics %s
+              ORDER BY security_id, date, metric_id`
+
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var sm SecurityMetric
+
+		err = rows.Scan(&sm.ID, &sm.SecurityID, &sm.MetricID, &sm.Date, &sm.Value, &sm.CreatedAt, &sm.UpdatedAt)
+		if err != nil {
+			return datasource.ErrorDB{Err: err}
+		}
+
+		if err = fn(&sm); err != nil {
+			return err
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
 func (s *securityMetricStore) Retrieve(ctx *gofr.Context, id int) (*SecurityMetric, error) {
 	var sm SecurityMetric
 
 	query := `SELECT id, security_id, metric_id, date, value, created_at, updated_at
               FROM security_metrics WHERE id = ?`
 
-	err := ctx.SQL.QueryRowContext(ctx, query, id).Scan(&sm.ID, &sm.SecurityID, &sm.MetricID, &sm.Date, &sm.Value, &sm.CreatedAt, &sm.UpdatedAt)
+	err := db(ctx).QueryRowContext(ctx, query, id).Scan(&sm.ID, &sm.SecurityID, &sm.MetricID, &sm.Date, &sm.Value, &sm.CreatedAt, &sm.UpdatedAt)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, http.ErrorEntityNotFound{Name: "security-metrics", Value: strconv.Itoa(id)}
@@ -142,14 +289,14 @@ func (s *securityMetricStore) Retrieve(ctx *gofr.Context, id int) (*SecurityMetr
 }
 
 func (s *securityMetricStore) Create(ctx *gofr.Context, sm *SecurityMetric) (*SecurityMetric, error) {
-	cacheKey := fmt.Sprintf("security_metrics:security_id:%d:date:%s", sm.SecurityID, sm.Date.Format(time.DateOnly))
+	cacheKey := securityMetricsCacheKey(sm.SecurityID, sm.Date)
 	if err := ctx.Redis.Del(ctx, cacheKey).Err(); err != nil {
 		return nil, datasource.ErrorDB{Err: err}
 	}
 
 	query := "INSERT INTO security_metrics (security_id, metric_id, date, value, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)"
 
-	result, err := ctx.SQL.ExecContext(ctx, query, sm.SecurityID, sm.MetricID, sm.Date, sm.Value, sm.CreatedAt, sm.UpdatedAt)
+	result, err := db(ctx).ExecContext(ctx, query, sm.SecurityID, sm.MetricID, sm.Date, sm.Value, sm.CreatedAt, sm.UpdatedAt)
 	if err != nil {
 		return nil, datasource.ErrorDB{Err: err}
 	}
@@ -163,7 +310,7 @@ func (s *securityMetricStore) Create(ctx *gofr.Context, sm *SecurityMetric) (*Se
 }
 
 func (s *securityMetricStore) Update(ctx *gofr.Context, id int, sm *SecurityMetric) (*SecurityMetric, error) {
-	cacheKey := fmt.Sprintf("security_metrics:security_id:%d:date:%s", sm.SecurityID, sm.Date.Format(time.DateOnly))
+	cacheKey := securityMetricsCacheKey(sm.SecurityID, sm.Date)
 	if err := ctx.Redis.Del(ctx, cacheKey).Err(); err != nil {
 		return nil, datasource.ErrorDB{Err: err}
 	}
@@ -171,7 +318,7 @@ func (s *securityMetricStore) Update(ctx *gofr.Context, id int, sm *SecurityMetr
 	query := `UPDATE security_metrics SET security_id = ?, metric_id = ?, date = ?, value = ?, created_at = ?, updated_at = ?
               WHERE id = ?`
 
-	_, err := ctx.SQL.ExecContext(ctx, query, sm.SecurityID, sm.MetricID, sm.Date, sm.Value, sm.CreatedAt, sm.UpdatedAt, id)
+	_, err := db(ctx).ExecContext(ctx, query, sm.SecurityID, sm.MetricID, sm.Date, sm.Value, sm.CreatedAt, sm.UpdatedAt, id)
 	if err != nil {
 		return nil, datasource.ErrorDB{Err: err}
 	}
@@ -179,6 +326,55 @@ func (s *securityMetricStore) Update(ctx *gofr.Context, id int, sm *SecurityMetr
 	return s.Retrieve(ctx, id)
 }
 
+// Upsert writes the metrics on their security, metric and date in a single transaction and returns their ids in order, any failing row
+// rolls back the whole batch.
+func (s *securityMetricStore) Upsert(ctx *gofr.Context, securityMetrics []*SecurityMetric) ([]int, error) {
+	var cacheKeys []string
+
+	for _, sm := range securityMetrics {
+		cacheKeys = append(cacheKeys, securityMetricsCacheKey(sm.SecurityID, sm.Date))
+	}
+
+	if len(cacheKeys) > 0 {
+		if err := ctx.Redis.Del(ctx, cacheKeys...).Err(); err != nil {
+			return nil, datasource.ErrorDB{Err: err}
+		}
+	}
+
+	query := `INSERT INTO security_metrics (security_id, metric_id, date, value, created_at, updated_at)
+              VALUES (?, ?, ?, ?, ?, ?)
+              ON DUPLICATE KEY UPDATE id = LAST_INSERT_ID(id), value = VALUES(value), updated_at = VALUES(updated_at)`
+
+	ids := make([]int, len(securityMetrics))
+
+	err := InTx(ctx, func() error {
+		for i, sm := range securityMetrics {
+			result, err := db(ctx).ExecContext(ctx, query, sm.SecurityID, sm.MetricID, sm.Date, sm.Value, sm.CreatedAt, sm.UpdatedAt)
+			if err != nil {
+				return datasource.ErrorDB{Err: err}
+			}
+
+			id, err := result.LastInsertId()
+			if err != nil {
+				return datasource.ErrorDB{Err: err}
+			}
+
+			ids[i] = int(id)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func securityMetricsCacheKey(securityID int, date time.Time) string {
+	return fmt.Sprintf("security_metrics:security_id:%d:date:%s", securityID, date.Format(time.DateOnly))
+}
+
 func (f *SecurityMetricFilter) buildWhereClause() (clause string, values []interface{}) {
 	if f.SecurityID != 0 {
 		clause += " AND security_id = ?"
@@ -186,18 +382,52 @@ func (f *SecurityMetricFilter) buildWhereClause() (clause string, values []inter
 		values = append(values, f.SecurityID)
 	}
 
+	if len(f.SecurityIDs) > 0 {
+		var placeHolders []string
+
+		for i := range f.SecurityIDs {
+			placeHolders = append(placeHolders, "?")
+			values = append(values, f.SecurityIDs[i])
+		}
+
+		clause += " AND security_id IN (" + strings.Join(placeHolders, ", ") + ")"
+	}
+
 	if f.MetricID != 0 {
 		clause += " AND metric_id = ?"
 
 		values = append(values, f.MetricID)
 	}
 
+	if len(f.MetricIDs) > 0 {
+		var placeHolders []string
+
+		for i := range f.MetricIDs {
+			placeHolders = append(placeHolders, "?")
+			values = append(values, f.MetricIDs[i])
+		}
+
+		clause += " AND metric_id IN (" + strings.Join(placeHolders, ", ") + ")"
+	}
+
 	if f.Date != (time.Time{}) {
 		clause += " AND date = ?"
 
 		values = append(values, f.Date.Format(time.DateOnly))
 	}
 
+	if f.MinDate != (time.Time{}) {
+		clause += " AND date >= ?"
+
+		values = append(values, f.MinDate.Format(time.DateOnly))
+	}
+
+	if f.MaxDate != (time.Time{}) {
+		clause += " AND date <= ?"
+
+		values = append(values, f.MaxDate.Format(time.DateOnly))
+	}
+
 	if clause != "" {
 		clause = "WHERE" + strings.TrimPrefix(clause, " AND")
 	}
@@ -210,7 +440,8 @@ func (f *SecurityMetricFilter) isCacheable(limit int) bool {
 		return false
 	}
 
-	if f.SecurityID != 0 && f.MetricID == 0 && !f.Date.IsZero() && time.Since(f.Date) <= cacheExpiry {
+	if f.SecurityID != 0 && len(f.SecurityIDs) == 0 && f.MetricID == 0 && len(f.MetricIDs) == 0 && !f.Date.IsZero() &&
+		f.MinDate.IsZero() && f.MaxDate.IsZero() && time.Since(f.Date) <= cacheExpiry {
 		return true
 	}
 
@@ -15,14 +15,18 @@ import (
 type SecurityStatStore interface {
 	Index(ctx *gofr.Context, filter *SecurityStatFilter, limit, offset int) ([]*SecurityStat, error)
 	Count(ctx *gofr.Context, filter *SecurityStatFilter) (int, error)
+	Stream(ctx *gofr.Context, filter *SecurityStatFilter, fn func(ss *SecurityStat) error) error
 	Retrieve(ctx *gofr.Context, id int) (*SecurityStat, error)
 	Create(ctx *gofr.Context, ss *SecurityStat) (*SecurityStat, error)
 	Update(ctx *gofr.Context, id int, ss *SecurityStat) (*SecurityStat, error)
+	Upsert(ctx *gofr.Context, securityStats []*SecurityStat) ([]int, error)
 }
 
 type SecurityStatFilter struct {
 	SecurityIDs []int
 	Dates       []time.Time
+	MinDate     time.Time
+	MaxDate     time.Time
 }
 
 type SecurityStat struct {
@@ -57,7 +61,7 @@ func (s *securityStatStore) Index(ctx *gofr.Context, filter *SecurityStatFilter,
 		values = append(values, limit, offset)
 	}
 
-	rows, err := ctx.SQL.QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
 	if err != nil {
 		return nil, datasource.ErrorDB{Err: err}
 	}
@@ -91,7 +95,7 @@ func (s *securityStatStore) Count(ctx *gofr.Context, filter *SecurityStatFilter)
 
 	var count int
 
-	err := ctx.SQL.QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
+	err := db(ctx).QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
 	if err != nil {
 		return 0, datasource.ErrorDB{Err: err}
 	}
@@ -99,13 +103,49 @@ func (s *securityStatStore) Count(ctx *gofr.Context, filter *SecurityStatFilter)
 	return count, nil
 }
 
+// Stream calls fn for every matching row in security and date order without loading the result set into memory, it stops at the
+// first error fn returns.
+func (s *securityStatStore) Stream(ctx *gofr.Context, filter *SecurityStatFilter, fn func(ss *SecurityStat) error) error {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT id, security_id, date, open, close, high, low, volume, created_at, updated_at
+              FROM security_stats %s
+              ORDER BY security_id, date`
+
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var ss SecurityStat
+
+		err = rows.Scan(&ss.ID, &ss.SecurityID, &ss.Date, &ss.Open, &ss.Close, &ss.High, &ss.Low, &ss.Volume, &ss.CreatedAt, &ss.UpdatedAt)
+		if err != nil {
+			return datasource.ErrorDB{Err: err}
+		}
+
+		if err = fn(&ss); err != nil {
+			return err
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
 func (s *securityStatStore) Retrieve(ctx *gofr.Context, id int) (*SecurityStat, error) {
 	var ss SecurityStat
 
 	query := `SELECT id, security_id, date, open, close, high, low, volume, created_at, updated_at
               FROM security_stats WHERE id = ?`
 
-	err := ctx.SQL.QueryRowContext(ctx, query, id).Scan(&ss.ID, &ss.SecurityID, &ss.Date, &ss.Open, &ss.Close, &ss.High, &ss.Low, &ss.Volume, &ss.CreatedAt, &ss.UpdatedAt)
+	err := db(ctx).QueryRowContext(ctx, query, id).Scan(&ss.ID, &ss.SecurityID, &ss.Date, &ss.Open, &ss.Close, &ss.High, &ss.Low, &ss.Volume, &ss.CreatedAt, &ss.UpdatedAt)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, http.ErrorEntityNotFound{Name: "security-stats", Value: strconv.Itoa(id)}
@@ -120,7 +160,7 @@ func (s *securityStatStore) Retrieve(ctx *gofr.Context, id int) (*SecurityStat,
 func (s *securityStatStore) Create(ctx *gofr.Context, ss *SecurityStat) (*SecurityStat, error) {
 	query := "INSERT INTO security_stats (security_id, date, open, close, high, low, volume, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)"
 
-	result, err := ctx.SQL.ExecContext(ctx, query, ss.SecurityID, ss.Date, ss.Open, ss.Close, ss.High, ss.Low, ss.Volume, ss.CreatedAt, ss.UpdatedAt)
+	result, err := db(ctx).ExecContext(ctx, query, ss.SecurityID, ss.Date, ss.Open, ss.Close, ss.High, ss.Low, ss.Volume, ss.CreatedAt, ss.UpdatedAt)
 	if err != nil {
 		return nil, datasource.ErrorDB{Err: err}
 	}
@@ -137,7 +177,7 @@ func (s *securityStatStore) Update(ctx *gofr.Context, id int, ss *SecurityStat)
 	query := `UPDATE security_stats SET security_id = ?, date = ?, open = ?, close = ?, high = ?, low = ?, volume = ?, created_at = ?, updated_at = ?
               WHERE id = ?`
 
-	_, err := ctx.SQL.ExecContext(ctx, query, ss.SecurityID, ss.Date, ss.Open, ss.Close, ss.High, ss.Low, ss.Volume, ss.CreatedAt, ss.UpdatedAt, id)
+	_, err := db(ctx).ExecContext(ctx, query, ss.SecurityID, ss.Date, ss.Open, ss.Close, ss.High, ss.Low, ss.Volume, ss.CreatedAt, ss.UpdatedAt, id)
 	if err != nil {
 		return nil, datasource.ErrorDB{Err: err}
 	}
@@ -145,6 +185,40 @@ func (s *securityStatStore) Update(ctx *gofr.Context, id int, ss *SecurityStat)
 	return s.Retrieve(ctx, id)
 }
 
+// Upsert writes the stats on their security and date in a single transaction and returns their ids in order, any failing row rolls
+// back the whole batch.
+func (s *securityStatStore) Upsert(ctx *gofr.Context, securityStats []*SecurityStat) ([]int, error) {
+	query := `INSERT INTO security_stats (security_id, date, open, close, high, low, volume, created_at, updated_at)
+              VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+              ON DUPLICATE KEY UPDATE id = LAST_INSERT_ID(id), open = VALUES(open), close = VALUES(close), high = VALUES(high),
+                                      low = VALUES(low), volume = VALUES(volume), updated_at = VALUES(updated_at)`
+
+	ids := make([]int, len(securityStats))
+
+	err := InTx(ctx, func() error {
+		for i, ss := range securityStats {
+			result, err := db(ctx).ExecContext(ctx, query, ss.SecurityID, ss.Date, ss.Open, ss.Close, ss.High, ss.Low, ss.Volume, ss.CreatedAt, ss.UpdatedAt)
+			if err != nil {
+				return datasource.ErrorDB{Err: err}
+			}
+
+			id, err := result.LastInsertId()
+			if err != nil {
+				return datasource.ErrorDB{Err: err}
+			}
+
+			ids[i] = int(id)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
 func (f *SecurityStatFilter) buildWhereClause() (clause string, values []interface{}) {
 	if len(f.SecurityIDs) > 0 {
 		var placeHolders []string
@@ -168,6 +242,18 @@ func (f *SecurityStatFilter) buildWhereClause() (clause string, values []interfa
 		clause += " AND date IN (" + strings.Join(placeHolders, ", ") + ")"
 	}
 
+	if f.MinDate != (time.Time{}) {
+		clause += " AND date >= ?"
+
+		values = append(values, f.MinDate.Format(time.DateOnly))
+	}
+
+	if f.MaxDate != (time.Time{}) {
+		clause += " AND date <= ?"
+
+		values = append(values, f.MaxDate.Format(time.DateOnly))
+	}
+
 	if clause != "" {
 		clause = "WHERE" + strings.TrimPrefix(clause, " AND")
 	}
@@ -0,0 +1,164 @@
+package stores
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+	"gofr.dev/pkg/gofr/http"
+)
+
+type SecurityTradabilityStore interface {
+	Index(ctx *gofr.Context, filter *SecurityTradabilityFilter, limit, offset int) ([]*SecurityTradability, error)
+	Retrieve(ctx *gofr.Context, id int) (*SecurityTradability, error)
+	Create(ctx *gofr.Context, st *SecurityTradability) (*SecurityTradability, error)
+	Update(ctx *gofr.Context, id int, st *SecurityTradability) (*SecurityTradability, error)
+	Delete(ctx *gofr.Context, id int) error
+}
+
+type SecurityTradabilityFilter struct {
+	SecurityID int
+}
+
+type SecurityTradability struct {
+	ID                   int
+	SecurityID           int
+	LotSize              int
+	TickSize             float64
+	SurveillanceFlag     string
+	SurveillanceCategory *string
+	MTFLeverage          float64
+	EffectiveFrom        time.Time
+	EffectiveTo          *time.Time
+	CreatedAt            time.Time
+}
+
+type securityTradabilityStore struct{}
+
+func NewSecurityTradabilityStore() *securityTradabilityStore {
+	return &securityTradabilityStore{}
+}
+
+func (s *securityTradabilityStore) Index(ctx *gofr.Context, filter *SecurityTradabilityFilter, limit, offset int) ([]*SecurityTradability, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT id, security_id, lot_size, tick_size, surveillance_flag, surveillance_category, mtf_leverage, effective_from,
+                     effective_to, created_at
+              FROM security_tradability %s
+              ORDER BY security_id, effective_from DESC`
+
+	if limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+
+		values = append(values, limit, offset)
+	}
+
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	defer rows.Close()
+
+	var securityTradabilities []*SecurityTradability
+
+	for rows.Next() {
+		var st SecurityTradability
+
+		err = rows.Scan(&st.ID, &st.SecurityID, &st.LotSize, &st.TickSize, &st.SurveillanceFlag, &st.SurveillanceCategory, &st.MTFLeverage,
+			&st.EffectiveFrom, &st.EffectiveTo, &st.CreatedAt)
+		if err != nil {
+			return nil, datasource.ErrorDB{Err: err}
+		}
+
+		securityTradabilities = append(securityTradabilities, &st)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return securityTradabilities, nil
+}
+
+func (s *securityTradabilityStore) Retrieve(ctx *gofr.Context, id int) (*SecurityTradability, error) {
+	var st SecurityTradability
+
+	query := `SELECT id, security_id, lot_size, tick_size, surveillance_flag, surveillance_category, mtf_leverage, effective_from,
+                     effective_to, created_at
+              FROM security_tradability WHERE id = ?`
+
+	err := db(ctx).QueryRowContext(ctx, query, id).Scan(&st.ID, &st.SecurityID, &st.LotSize, &st.TickSize, &st.SurveillanceFlag,
+		&st.SurveillanceCategory, &st.MTFLeverage, &st.EffectiveFrom, &st.EffectiveTo, &st.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, http.ErrorEntityNotFound{Name: "security-tradability", Value: strconv.Itoa(id)}
+		}
+
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return &st, nil
+}
+
+func (s *securityTradabilityStore) Create(ctx *gofr.Context, st *SecurityTradability) (*SecurityTradability, error) {
+	query := `INSERT INTO security_tradability (security_id, lot_size, tick_size, surveillance_flag, surveillance_category, mtf_leverage,
+                                                effective_from, effective_to, created_at)
+              VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := db(ctx).ExecContext(ctx, query, st.SecurityID, st.LotSize, st.TickSize, st.SurveillanceFlag, st.SurveillanceCategory,
+		st.MTFLeverage, st.EffectiveFrom, st.EffectiveTo, st.CreatedAt)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, int(id))
+}
+
+func (s *securityTradabilityStore) Update(ctx *gofr.Context, id int, st *SecurityTradability) (*SecurityTradability, error) {
+	query := `UPDATE security_tradability SET security_id = ?, lot_size = ?, tick_size = ?, surveillance_flag = ?, surveillance_category = ?,
+                     mtf_leverage = ?, effective_from = ?, effective_to = ?, created_at = ?
+              WHERE id = ?`
+
+	_, err := db(ctx).ExecContext(ctx, query, st.SecurityID, st.LotSize, st.TickSize, st.SurveillanceFlag, st.SurveillanceCategory,
+		st.MTFLeverage, st.EffectiveFrom, st.EffectiveTo, st.CreatedAt, id)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, id)
+}
+
+func (s *securityTradabilityStore) Delete(ctx *gofr.Context, id int) error {
+	query := "DELETE FROM security_tradability WHERE id = ?"
+
+	_, err := db(ctx).ExecContext(ctx, query, id)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+func (f *SecurityTradabilityFilter) buildWhereClause() (clause string, values []interface{}) {
+	if f.SecurityID != 0 {
+		clause += " AND security_id = ?"
+
+		values = append(values, f.SecurityID)
+	}
+
+	if clause != "" {
+		clause = "WHERE" + strings.TrimPrefix(clause, " AND")
+	}
+
+	return clause, values
+}
@@ -0,0 +1,241 @@
+package stores
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+	"gofr.dev/pkg/gofr/http"
+)
+
+type ServiceCredentialStore interface {
+	Index(ctx *gofr.Context, filter *ServiceCredentialFilter, limit, offset int) ([]*ServiceCredential, error)
+	Count(ctx *gofr.Context, filter *ServiceCredentialFilter) (int, error)
+	Retrieve(ctx *gofr.Context, id int) (*ServiceCredential, error)
+	RetrieveByKeyHash(ctx *gofr.Context, keyHash string, now time.Time) (*ServiceCredential, error)
+	Create(ctx *gofr.Context, serviceCredential *ServiceCredential) (*ServiceCredential, error)
+	Update(ctx *gofr.Context, id int, serviceCredential *ServiceCredential) (*ServiceCredential, error)
+	UpdateLastUsedAt(ctx *gofr.Context, id int, lastUsedAt time.Time) error
+}
+
+type ServiceCredentialFilter struct {
+	Name    string
+	Revoked *bool
+}
+
+type ServiceCredential struct {
+	ID                   int
+	Name                 string
+	KeyPrefix            string
+	KeyHash              string
+	PreviousKeyHash      string
+	PreviousKeyExpiresAt *time.Time
+	Scopes               []string
+	LastUsedAt           *time.Time
+	RevokedAt            *time.Time
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+type serviceCredentialStore struct{}
+
+const serviceCredentialColumns = `id, name, key_prefix, key_hash, previous_key_hash, previous_key_expires_at, scopes,
+              last_used_at, revoked_at, created_at, updated_at`
+
+func NewServiceCredentialStore() *serviceCredentialStore {
+	return &serviceCredentialStore{}
+}
+
+func (s *serviceCredentialStore) Index(ctx *gofr.Context, filter *ServiceCredentialFilter, limit, offset int) ([]*ServiceCredential, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT ` + serviceCredentialColumns + `
+              FROM service_credentials %s ORDER BY id`
+
+	if limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+
+		values = append(values, limit, offset)
+	}
+
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	defer rows.Close()
+
+	var serviceCredentials []*ServiceCredential
+
+	for rows.Next() {
+		sc, err := scanServiceCredential(rows)
+		if err != nil {
+			return nil, datasource.ErrorDB{Err: err}
+		}
+
+		serviceCredentials = append(serviceCredentials, sc)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return serviceCredentials, nil
+}
+
+func (s *serviceCredentialStore) Count(ctx *gofr.Context, filter *ServiceCredentialFilter) (int, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT COUNT(*) FROM service_credentials %s`
+
+	var count int
+
+	err := db(ctx).QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
+	if err != nil {
+		return 0, datasource.ErrorDB{Err: err}
+	}
+
+	return count, nil
+}
+
+func (s *serviceCredentialStore) Retrieve(ctx *gofr.Context, id int) (*ServiceCredential, error) {
+	query := `SELECT ` + serviceCredentialColumns + `
+              FROM service_credentials WHERE id = ?`
+
+	sc, err := scanServiceCredential(db(ctx).QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, http.ErrorEntityNotFound{Name: "service-credentials", Value: strconv.Itoa(id)}
+		}
+
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return sc, nil
+}
+
+func (s *serviceCredentialStore) RetrieveByKeyHash(ctx *gofr.Context, keyHash string, now time.Time) (*ServiceCredential, error) {
+	query := `SELECT ` + serviceCredentialColumns + `
+              FROM service_credentials
+              WHERE revoked_at IS NULL AND (key_hash = ? OR (previous_key_hash = ? AND previous_key_expires_at > ?))`
+
+	sc, err := scanServiceCredential(db(ctx).QueryRowContext(ctx, query, keyHash, keyHash, now))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, http.ErrorEntityNotFound{Name: "service-credentials", Value: "key"}
+		}
+
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return sc, nil
+}
+
+func (s *serviceCredentialStore) Create(ctx *gofr.Context, sc *ServiceCredential) (*ServiceCredential, error) {
+	query := `INSERT INTO service_credentials (name, key_prefix, key_hash, scopes, created_at, updated_at)
+              VALUES (?, ?, ?, ?, ?, ?)`
+
+	result, err := db(ctx).ExecContext(ctx, query, sc.Name, sc.KeyPrefix, sc.KeyHash, strings.Join(sc.Scopes, ","), sc.CreatedAt, sc.UpdatedAt)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, int(id))
+}
+
+func (s *serviceCredentialStore) Update(ctx *gofr.Context, id int, sc *ServiceCredential) (*ServiceCredential, error) {
+	query := `UPDATE service_credentials SET name = ?, key_prefix = ?, key_hash = ?, previous_key_hash = ?, previous_key_expires_at = ?,
+              scopes = ?, revoked_at = ?, created_at = ?, updated_at = ?
+              WHERE id = ?`
+
+	var previousKeyHash sql.NullString
+
+	if sc.PreviousKeyHash != "" {
+		previousKeyHash = sql.NullString{String: sc.PreviousKeyHash, Valid: true}
+	}
+
+	_, err := db(ctx).ExecContext(ctx, query, sc.Name, sc.KeyPrefix, sc.KeyHash, previousKeyHash, sc.PreviousKeyExpiresAt,
+		strings.Join(sc.Scopes, ","), sc.RevokedAt, sc.CreatedAt, sc.UpdatedAt, id)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, id)
+}
+
+func (s *serviceCredentialStore) UpdateLastUsedAt(ctx *gofr.Context, id int, lastUsedAt time.Time) error {
+	_, err := db(ctx).ExecContext(ctx, `UPDATE service_credentials SET last_used_at = ? WHERE id = ?`, lastUsedAt, id)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+func scanServiceCredential(row interface{ Scan(dest ...any) error }) (*ServiceCredential, error) {
+	var (
+		sc                   ServiceCredential
+		previousKeyHash      sql.NullString
+		previousKeyExpiresAt sql.NullTime
+		scopes               string
+		lastUsedAt           sql.NullTime
+		revokedAt            sql.NullTime
+	)
+
+	err := row.Scan(&sc.ID, &sc.Name, &sc.KeyPrefix, &sc.KeyHash, &previousKeyHash, &previousKeyExpiresAt, &scopes,
+		&lastUsedAt, &revokedAt, &sc.CreatedAt, &sc.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	sc.PreviousKeyHash = previousKeyHash.String
+
+	if previousKeyExpiresAt.Valid {
+		sc.PreviousKeyExpiresAt = &previousKeyExpiresAt.Time
+	}
+
+	if scopes != "" {
+		sc.Scopes = strings.Split(scopes, ",")
+	}
+
+	if lastUsedAt.Valid {
+		sc.LastUsedAt = &lastUsedAt.Time
+	}
+
+	if revokedAt.Valid {
+		sc.RevokedAt = &revokedAt.Time
+	}
+
+	return &sc, nil
+}
+
+func (f *ServiceCredentialFilter) buildWhereClause() (clause string, values []interface{}) {
+	if f.Name != "" {
+		clause += " AND name = ?"
+
+		values = append(values, f.Name)
+	}
+
+	if f.Revoked != nil {
+		if *f.Revoked {
+			clause += " AND revoked_at IS NOT NULL"
+		} else {
+			clause += " AND revoked_at IS NULL"
+		}
+	}
+
+	if clause != "" {
+		clause = "WHERE" + strings.TrimPrefix(clause, " AND")
+	}
+
+	return clause, values
+}
@@ -0,0 +1,65 @@
+package stores
+
+import (
+	"context"
+	"database/sql"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+)
+
+type txKey struct{}
+
+// executor is what the stores need of either the connection pool or an open transaction.
+type executor interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// InTx runs fn in a database transaction, every store called with ctx inside fn runs its statements on that transaction. The
+// transaction is rolled back if fn returns an error or panics, and committed otherwise. Nested calls join the outer transaction.
+func InTx(ctx *gofr.Context, fn func() error) error {
+	if _, ok := ctx.Value(txKey{}).(executor); ok {
+		return fn()
+	}
+
+	tx, err := ctx.SQL.Begin()
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	parent := ctx.Context
+	ctx.Context = context.WithValue(parent, txKey{}, executor(tx))
+
+	committed := false
+
+	defer func() {
+		ctx.Context = parent
+
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if err = fn(); err != nil {
+		return err
+	}
+
+	committed = true
+
+	if err = tx.Commit(); err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+// db returns the transaction ctx is running in, or the connection pool outside of InTx.
+func db(ctx *gofr.Context) executor {
+	if tx, ok := ctx.Value(txKey{}).(executor); ok {
+		return tx
+	}
+
+	return ctx.SQL
+}
@@ -0,0 +1,162 @@
+package stores
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+	"gofr.dev/pkg/gofr/http"
+)
+
+type WatchlistStore interface {
+	Index(ctx *gofr.Context, filter *WatchlistFilter, limit, offset int) ([]*Watchlist, error)
+	Count(ctx *gofr.Context, filter *WatchlistFilter) (int, error)
+	Retrieve(ctx *gofr.Context, id int) (*Watchlist, error)
+	Create(ctx *gofr.Context, watchlist *Watchlist) (*Watchlist, error)
+	Update(ctx *gofr.Context, id int, watchlist *Watchlist) (*Watchlist, error)
+	Delete(ctx *gofr.Context, id int) error
+}
+
+type WatchlistFilter struct {
+	UserID int
+}
+
+type Watchlist struct {
+	ID        int
+	UserID    int
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type watchlistStore struct{}
+
+func NewWatchlistStore() *watchlistStore {
+	return &watchlistStore{}
+}
+
+func (s *watchlistStore) Index(ctx *gofr.Context, filter *WatchlistFilter, limit, offset int) ([]*Watchlist, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT id, user_id, name, created_at, updated_at
+              FROM watchlists %s ORDER BY id`
+
+	if limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+
+		values = append(values, limit, offset)
+	}
+
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	defer rows.Close()
+
+	var watchlists []*Watchlist
+
+	for rows.Next() {
+		var w Watchlist
+
+		err = rows.Scan(&w.ID, &w.UserID, &w.Name, &w.CreatedAt, &w.UpdatedAt)
+		if err != nil {
+			return nil, datasource.ErrorDB{Err: err}
+		}
+
+		watchlists = append(watchlists, &w)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return watchlists, nil
+}
+
+func (s *watchlistStore) Count(ctx *gofr.Context, filter *WatchlistFilter) (int, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT COUNT(*) FROM watchlists %s`
+
+	var count int
+
+	err := db(ctx).QueryRowContext(ctx, fmt.Sprintf(query, whereClause), values...).Scan(&count)
+	if err != nil {
+		return 0, datasource.ErrorDB{Err: err}
+	}
+
+	return count, nil
+}
+
+func (s *watchlistStore) Retrieve(ctx *gofr.Context, id int) (*Watchlist, error) {
+	var w Watchlist
+
+	query := `SELECT id, user_id, name, created_at, updated_at
+              FROM watchlists WHERE id = ?`
+
+	err := db(ctx).QueryRowContext(ctx, query, id).Scan(&w.ID, &w.UserID, &w.Name, &w.CreatedAt, &w.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, http.ErrorEntityNotFound{Name: "watchlists", Value: strconv.Itoa(id)}
+		}
+
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return &w, nil
+}
+
+func (s *watchlistStore) Create(ctx *gofr.Context, w *Watchlist) (*Watchlist, error) {
+	query := "INSERT INTO watchlists (user_id, name, created_at, updated_at) VALUES (?, ?, ?, ?)"
+
+	result, err := db(ctx).ExecContext(ctx, query, w.UserID, w.Name, w.CreatedAt, w.UpdatedAt)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, int(id))
+}
+
+func (s *watchlistStore) Update(ctx *gofr.Context, id int, w *Watchlist) (*Watchlist, error) {
+	query := `UPDATE watchlists SET name = ?, created_at = ?, updated_at = ? WHERE id = ?`
+
+	_, err := db(ctx).ExecContext(ctx, query, w.Name, w.CreatedAt, w.UpdatedAt, id)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return s.Retrieve(ctx, id)
+}
+
+func (s *watchlistStore) Delete(ctx *gofr.Context, id int) error {
+	_, err := db(ctx).ExecContext(ctx, `DELETE FROM watchlists WHERE id = ?`, id)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+func (f *WatchlistFilter) buildWhereClause() (clause string, values []interface{}) {
+	if f.UserID != 0 {
+		clause += " AND user_id = ?"
+
+		values = append(values, f.UserID)
+	}
+
+	if clause != "" {
+		clause = "WHERE" + strings.TrimPrefix(clause, " AND")
+	}
+
+	return clause, values
+}
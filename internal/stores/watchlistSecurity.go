@@ -0,0 +1,135 @@
+package stores
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+)
+
+type WatchlistSecurityStore interface {
+	Index(ctx *gofr.Context, filter *WatchlistSecurityFilter) ([]*WatchlistSecurity, error)
+	Create(ctx *gofr.Context, watchlistSecurity *WatchlistSecurity) error
+	Delete(ctx *gofr.Context, watchlistID, securityID int) error
+	Reorder(ctx *gofr.Context, watchlistID int, securityIDs []int) error
+}
+
+type WatchlistSecurityFilter struct {
+	WatchlistIDs []int
+}
+
+type WatchlistSecurity struct {
+	WatchlistID int
+	SecurityID  int
+	Position    int
+	CreatedAt   time.Time
+}
+
+type watchlistSecurityStore struct{}
+
+func NewWatchlistSecurityStore() *watchlistSecurityStore {
+	return &watchlistSecurityStore{}
+}
+
+func (s *watchlistSecurityStore) Index(ctx *gofr.Context, filter *WatchlistSecurityFilter) ([]*WatchlistSecurity, error) {
+	whereClause, values := filter.buildWhereClause()
+
+	query := `SELECT watchlist_id, security_id, position, created_at
+              FROM watchlist_securities %s ORDER BY watchlist_id, position`
+
+	rows, err := db(ctx).QueryContext(ctx, fmt.Sprintf(query, whereClause), values...)
+	if err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	defer rows.Close()
+
+	var watchlistSecurities []*WatchlistSecurity
+
+	for rows.Next() {
+		var ws WatchlistSecurity
+
+		err = rows.Scan(&ws.WatchlistID, &ws.SecurityID, &ws.Position, &ws.CreatedAt)
+		if err != nil {
+			return nil, datasource.ErrorDB{Err: err}
+		}
+
+		watchlistSecurities = append(watchlistSecurities, &ws)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, datasource.ErrorDB{Err: err}
+	}
+
+	return watchlistSecurities, nil
+}
+
+func (s *watchlistSecurityStore) Create(ctx *gofr.Context, ws *WatchlistSecurity) error {
+	query := "INSERT INTO watchlist_securities (watchlist_id, security_id, position, created_at) VALUES (?, ?, ?, ?)"
+
+	_, err := db(ctx).ExecContext(ctx, query, ws.WatchlistID, ws.SecurityID, ws.Position, ws.CreatedAt)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+func (s *watchlistSecurityStore) Delete(ctx *gofr.Context, watchlistID, securityID int) error {
+	_, err := db(ctx).ExecContext(ctx, `DELETE FROM watchlist_securities WHERE watchlist_id = ? AND security_id = ?`, watchlistID, securityID)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+// Reorder rewrites positions in a single statement so a concurrent read never sees a half applied order.
+func (s *watchlistSecurityStore) Reorder(ctx *gofr.Context, watchlistID int, securityIDs []int) error {
+	if len(securityIDs) == 0 {
+		return nil
+	}
+
+	var (
+		cases  []string
+		values []interface{}
+	)
+
+	for i := range securityIDs {
+		cases = append(cases, "WHEN ? THEN ?")
+		values = append(values, securityIDs[i], i+1)
+	}
+
+	values = append(values, watchlistID)
+
+	query := `UPDATE watchlist_securities SET position = CASE security_id ` + strings.Join(cases, " ") + ` ELSE position END
+              WHERE watchlist_id = ?`
+
+	_, err := db(ctx).ExecContext(ctx, query, values...)
+	if err != nil {
+		return datasource.ErrorDB{Err: err}
+	}
+
+	return nil
+}
+
+func (f *WatchlistSecurityFilter) buildWhereClause() (clause string, values []interface{}) {
+	if len(f.WatchlistIDs) > 0 {
+		var placeHolders []string
+
+		for i := range f.WatchlistIDs {
+			placeHolders = append(placeHolders, "?")
+			values = append(values, f.WatchlistIDs[i])
+		}
+
+		clause += " AND watchlist_id IN (" + strings.Join(placeHolders, ", ") + ")"
+	}
+
+	if clause != "" {
+		clause = "WHERE" + strings.TrimPrefix(clause, " AND")
+	}
+
+	return clause, values
+}
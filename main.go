@@ -2,7 +2,9 @@ package main
 
 import (
 	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/websocket"
 
+	"github.com/stratifyr/security-service/internal/auth"
 	"github.com/stratifyr/security-service/internal/handlers"
 	"github.com/stratifyr/security-service/internal/handlers/grpc"
 	"github.com/stratifyr/security-service/internal/services"
@@ -15,34 +17,125 @@ func main() {
 
 	app.AddHTTPService("account-service", app.Config.Get("ACCOUNT_SERVICE_HOST"))
 
+	app.Metrics().NewCounter("app_user_tier_cache_lookups", "Number of user tier lookups by cache result.")
+	app.Metrics().NewHistogram("app_user_tier_fetch_duration", "Response time of account-service user tier lookups in seconds.",
+		0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5)
+
 	app.Migrate(migrations.All())
 
 	industryStore := stores.NewIndustryStore()
+	securityIndustryStore := stores.NewSecurityIndustryStore()
+	marketIndexStore := stores.NewMarketIndexStore()
+	indexConstituentStore := stores.NewIndexConstituentStore()
 	metricStore := stores.NewMetricStore()
 	securityStore := stores.NewSecurityStore()
 	marketHolidayStore := stores.NewMarketHolidayStore()
 	securityStatStore := stores.NewSecurityStatStore()
 	securityMetricStore := stores.NewSecurityMetricStore()
-
-	industryService := services.NewIndustryService(industryStore)
-	metricService := services.NewMetricService(metricStore)
-	marketHolidayService := services.NewMarketHolidayService(marketHolidayStore)
-	marketDayService := services.NewMarketDayService(marketHolidayStore)
-	securityStatService := services.NewSecurityStatService(marketDayService, securityStatStore)
-	securityMetricService := services.NewSecurityMetricService(marketDayService, metricStore, securityStatStore, securityMetricStore)
-	securityService := services.NewSecurityService(marketDayService, metricStore, securityMetricStore, securityStatStore, securityStore)
+	securityLTPStore := stores.NewSecurityLTPStore()
+	securityFundamentalStore := stores.NewSecurityFundamentalStore()
+	securityTradabilityStore := stores.NewSecurityTradabilityStore()
+	serviceCredentialStore := stores.NewServiceCredentialStore()
+	auditEventStore := stores.NewAuditEventStore()
+	outboxEventStore := stores.NewOutboxEventStore()
+	alertRuleStore := stores.NewAlertRuleStore()
+	alertDeliveryStore := stores.NewAlertDeliveryStore()
+	watchlistStore := stores.NewWatchlistStore()
+	watchlistSecurityStore := stores.NewWatchlistSecurityStore()
+	portfolioStore := stores.NewPortfolioStore()
+	portfolioTransactionStore := stores.NewPortfolioTransactionStore()
+	backtestStore := stores.NewBacktestStore()
+
+	marketCalendar := services.NewMarketCalendar(marketHolidayStore)
+	priceHub := services.NewPriceHub()
+	userTierService := services.NewUserTierService(app.Config.GetOrDefault("USER_TIER_CACHE", "memory") == "redis")
+
+	auditEventService := services.NewAuditEventService(auditEventStore)
+	domainEventService := services.NewDomainEventService(outboxEventStore)
+	entitlementService := services.NewEntitlementService(userTierService, services.DefaultTierEntitlements)
+	metricService := services.NewMetricService(entitlementService, auditEventService, metricStore)
+	marketHolidayService := services.NewMarketHolidayService(marketCalendar, auditEventService, domainEventService, marketHolidayStore)
+	marketDayService := services.NewMarketDayService(marketCalendar)
+	alertService := services.NewAlertService(entitlementService, userTierService, marketDayService, auditEventService,
+		services.NewAlertWebhookSender(), metricStore, securityStore, securityStatStore, securityMetricStore, marketIndexStore, indexConstituentStore,
+		alertDeliveryStore, alertRuleStore)
+	securityStatService := services.NewSecurityStatService(marketDayService, entitlementService, auditEventService, domainEventService,
+		alertService, securityStore, securityStatStore)
+	securityMetricService := services.NewSecurityMetricService(marketDayService, entitlementService, auditEventService, domainEventService,
+		alertService, metricStore, securityStore, securityStatStore, securityFundamentalStore, securityMetricStore, priceHub)
+	securityFundamentalService := services.NewSecurityFundamentalService(entitlementService, auditEventService, securityStore, securityStatStore,
+		securityFundamentalStore)
+	securityTradabilityService := services.NewSecurityTradabilityService(auditEventService, securityStore, securityTradabilityStore)
+	watchlistService := services.NewWatchlistService(entitlementService, auditEventService, securityStore, watchlistSecurityStore, watchlistStore)
+	industryService := services.NewIndustryService(entitlementService, auditEventService, marketDayService, metricStore, securityStore,
+		securityStatStore, securityMetricStore, securityIndustryStore, securityFundamentalStore, industryStore)
+	marketIndexService := services.NewMarketIndexService(auditEventService, securityStore, indexConstituentStore, marketIndexStore)
+	securityService := services.NewSecurityService(marketDayService, entitlementService, auditEventService, domainEventService, watchlistService,
+		industryService, industryStore, metricStore, securityMetricStore, securityStatStore, securityLTPStore, securityStore, priceHub)
+	portfolioService := services.NewPortfolioService(entitlementService, auditEventService, marketDayService, securityService, industryStore,
+		securityStore, securityStatStore, portfolioTransactionStore, portfolioStore)
+	backtestService := services.NewBacktestService(entitlementService, marketDayService, industryService, metricStore, securityStore,
+		securityStatStore, securityMetricStore, backtestStore)
+	exportService := services.NewExportService(entitlementService, metricStore, securityStore, securityStatStore, securityMetricStore)
+	serviceCredentialService := services.NewServiceCredentialService(auditEventService, serviceCredentialStore)
+
+	rateLimits, err := services.ParseRateLimits(app.Config.GetOrDefault("RATE_LIMITS", "anonymous:30,service:6000,0:60,1:300,2:1200"))
+	if err != nil {
+		app.Logger().Fatalf("failed to parse rate limits, err: %v", err)
+	}
+
+	rateLimitService := services.NewRateLimitService(userTierService, rateLimits, app.Config.GetOrDefault("RATE_LIMIT_STORE", "memory") == "redis")
+
+	trustedProxies, err := handlers.ParseTrustedProxies(app.Config.Get("RATE_LIMIT_TRUSTED_PROXIES"))
+	if err != nil {
+		app.Logger().Fatalf("failed to parse rate limit trusted proxies, err: %v", err)
+	}
+
+	authenticator, err := auth.NewAuthenticator(app.Config.Get("AUTH_JWT_SECRET"), app.Config.Get("AUTH_API_KEYS"), serviceCredentialService)
+	if err != nil {
+		app.Logger().Fatalf("failed to initialise authenticator, err: %v", err)
+	}
+
+	app.UseMiddlewareWithContainer(authenticator.Middleware)
+	app.UseMiddlewareWithContainer(handlers.NewRateLimitMiddleware(rateLimitService, trustedProxies).Handle)
+	app.UseMiddlewareWithContainer(handlers.NewExportMiddleware(exportService).Handle)
 
 	industryHandler := handlers.NewIndustryHandler(industryService)
+	marketIndexHandler := handlers.NewMarketIndexHandler(marketIndexService)
 	metricHandler := handlers.NewMetricHandler(metricService)
 	marketHolidayHandler := handlers.NewMarketHolidayHandler(marketHolidayService)
 	marketDayHandler := handlers.NewMarketDayHandler(marketDayService)
 	securityHandler := handlers.NewSecurityHandler(securityService)
 	securityStatHandler := handlers.NewSecurityStatHandler(securityStatService)
 	securityMetricHandler := handlers.NewSecurityMetricHandler(securityMetricService)
-
-	grpc.RegisterSecurityServiceServerWithGofr(app, grpc.NewSecurityServiceGoFrServer(securityService))
+	securityFundamentalHandler := handlers.NewSecurityFundamentalHandler(securityFundamentalService)
+	securityTradabilityHandler := handlers.NewSecurityTradabilityHandler(securityTradabilityService)
+	serviceCredentialHandler := handlers.NewServiceCredentialHandler(serviceCredentialService)
+	auditEventHandler := handlers.NewAuditEventHandler(auditEventService)
+	alertHandler := handlers.NewAlertHandler(alertService)
+	watchlistHandler := handlers.NewWatchlistHandler(watchlistService, securityService)
+	portfolioHandler := handlers.NewPortfolioHandler(portfolioService)
+	backtestHandler := handlers.NewBacktestHandler(backtestService)
+	priceFeedHandler := handlers.NewPriceFeedHandler(securityService, entitlementService, priceHub)
+
+	grpc.RegisterSecurityServiceServerWithGofr(app, grpc.NewSecurityServer(securityService, metricService, securityStatService,
+		securityMetricService, marketDayService, marketHolidayService, entitlementService, rateLimitService, priceHub, authenticator))
 
 	app.GET("/industries", industryHandler.Index)
+	app.POST("/industries", industryHandler.Create)
+	app.GET("/industries/{id}", industryHandler.Read)
+	app.PATCH("/industries/{id}", industryHandler.Patch)
+	app.DELETE("/industries/{id}", industryHandler.Delete)
+	app.GET("/industries/{name}/summary", industryHandler.Summary)
+	app.GET("/industries/{name}/summaries", handlers.RequireFeature(entitlementService, services.FeatureHistory, industryHandler.Summaries))
+
+	app.GET("/indices", marketIndexHandler.Index)
+	app.POST("/indices", marketIndexHandler.Create)
+	app.GET("/indices/{id}", marketIndexHandler.Read)
+	app.PATCH("/indices/{id}", marketIndexHandler.Patch)
+	app.DELETE("/indices/{id}", marketIndexHandler.Delete)
+	app.GET("/indices/{id}/constituents", marketIndexHandler.Constituents)
+	app.PUT("/indices/{id}/constituents", marketIndexHandler.ReplaceConstituents)
 
 	app.GET("/metrics", metricHandler.Index)
 	app.POST("/metrics", metricHandler.Create)
@@ -57,20 +150,85 @@ func main() {
 
 	app.GET("/market-days", marketDayHandler.Index)
 
-	app.GET("/securities", securityHandler.Index)
+	app.GET("/securities", handlers.RequireFeature(entitlementService, services.FeatureScreener, securityHandler.Index))
 	app.POST("/securities", securityHandler.Create)
 	app.GET("/securities/{id}", securityHandler.Read)
 	app.PATCH("/securities/{id}", securityHandler.Patch)
-
-	app.GET("/security-stats", securityStatHandler.Index)
+	app.GET("/securities/{id}/industries", industryHandler.Assignments)
+	app.POST("/securities/{id}/industries", industryHandler.Assign)
+	app.DELETE("/securities/{id}/industries/{assignmentId}", industryHandler.Unassign)
+	app.GET("/securities/{id}/valuation", securityFundamentalHandler.Valuation)
+	app.GET("/securities/{id}/tradability", securityTradabilityHandler.History)
+	app.PUT("/securities/{id}/tradability", securityTradabilityHandler.Update)
+
+	app.GET("/security-stats", handlers.RequireFeature(entitlementService, services.FeatureHistory, securityStatHandler.Index))
 	app.POST("/security-stats", securityStatHandler.Create)
-	app.GET("/security-stats/{id}", securityStatHandler.Read)
+	app.PUT("/security-stats:bulk", securityStatHandler.BulkUpsert)
+	app.POST("/security-stats/import", securityStatHandler.Import)
+	app.GET("/security-stats/{id}", handlers.RequireFeature(entitlementService, services.FeatureHistory, securityStatHandler.Read))
 	app.PATCH("/security-stats/{id}", securityStatHandler.Patch)
 
-	app.GET("/security-metrics", securityMetricHandler.Index)
+	app.GET("/security-metrics", handlers.RequireFeature(entitlementService, services.FeatureHistory, securityMetricHandler.Index))
 	app.POST("/security-metrics", securityMetricHandler.Create)
-	app.GET("/security-metrics/{id}", securityMetricHandler.Read)
+	app.PUT("/security-metrics:bulk", securityMetricHandler.BulkUpsert)
+	app.GET("/security-metrics/{id}", handlers.RequireFeature(entitlementService, services.FeatureHistory, securityMetricHandler.Read))
 	app.PATCH("/security-metrics/{id}", securityMetricHandler.Patch)
 
+	app.GET("/security-fundamentals", securityFundamentalHandler.Index)
+	app.POST("/security-fundamentals", securityFundamentalHandler.Create)
+	app.GET("/security-fundamentals/{id}", securityFundamentalHandler.Read)
+	app.PATCH("/security-fundamentals/{id}", securityFundamentalHandler.Patch)
+
+	app.GET("/service-credentials", serviceCredentialHandler.Index)
+	app.POST("/service-credentials", serviceCredentialHandler.Create)
+	app.GET("/service-credentials/{id}", serviceCredentialHandler.Read)
+	app.PATCH("/service-credentials/{id}", serviceCredentialHandler.Patch)
+	app.POST("/service-credentials/{id}/rotate", serviceCredentialHandler.Rotate)
+	app.DELETE("/service-credentials/{id}", serviceCredentialHandler.Revoke)
+
+	app.GET("/audit-events", auditEventHandler.Index)
+
+	app.GET("/alerts", alertHandler.Index)
+	app.POST("/alerts", alertHandler.Create)
+	app.GET("/alerts/{id}", alertHandler.Read)
+	app.PATCH("/alerts/{id}", alertHandler.Patch)
+	app.DELETE("/alerts/{id}", alertHandler.Delete)
+	app.GET("/alerts/{id}/deliveries", alertHandler.Deliveries)
+	app.POST("/alerts/{id}/deliveries/{deliveryId}/retry", alertHandler.RetryDelivery)
+
+	app.GET("/watchlists", watchlistHandler.Index)
+	app.POST("/watchlists", watchlistHandler.Create)
+	app.GET("/watchlists/{id}", watchlistHandler.Read)
+	app.PATCH("/watchlists/{id}", watchlistHandler.Patch)
+	app.DELETE("/watchlists/{id}", watchlistHandler.Delete)
+	app.GET("/watchlists/{id}/securities", handlers.RequireFeature(entitlementService, services.FeatureScreener, watchlistHandler.Securities))
+	app.POST("/watchlists/{id}/securities", watchlistHandler.AddSecurity)
+	app.PUT("/watchlists/{id}/securities", watchlistHandler.Reorder)
+	app.DELETE("/watchlists/{id}/securities/{securityId}", watchlistHandler.RemoveSecurity)
+
+	app.GET("/portfolios", portfolioHandler.Index)
+	app.POST("/portfolios", portfolioHandler.Create)
+	app.GET("/portfolios/{id}", portfolioHandler.Read)
+	app.PATCH("/portfolios/{id}", portfolioHandler.Patch)
+	app.DELETE("/portfolios/{id}", portfolioHandler.Delete)
+	app.GET("/portfolios/{id}/transactions", portfolioHandler.Transactions)
+	app.POST("/portfolios/{id}/transactions", portfolioHandler.CreateTransaction)
+	app.DELETE("/portfolios/{id}/transactions/{transactionId}", portfolioHandler.DeleteTransaction)
+	app.GET("/portfolios/{id}/holdings", portfolioHandler.Holdings)
+	app.GET("/portfolios/{id}/summary", portfolioHandler.Summary)
+	app.GET("/portfolios/{id}/valuations", portfolioHandler.Valuations)
+
+	app.GET("/backtests", backtestHandler.Index)
+	app.POST("/backtests", backtestHandler.Create)
+	app.GET("/backtests/{id}", backtestHandler.Read)
+	app.DELETE("/backtests/{id}", backtestHandler.Delete)
+
+	app.OverrideWebsocketUpgrader(websocket.NewWSUpgrader(websocket.WithSubprotocols(auth.WebSocketProtocol)))
+	app.WebSocket("/ws/prices", priceFeedHandler.Stream)
+
+	app.AddCronJob("*/5 * * * * *", "domain-event-relay", domainEventService.Relay)
+	app.AddCronJob("*/10 * * * * *", "alert-delivery", alertService.Deliver)
+	app.AddCronJob("*/5 * * * * *", "backtest-runner", backtestService.Run)
+
 	app.Run()
 }
@@ -8,5 +8,20 @@ func All() map[int64]migration.Migrate {
 	return map[int64]migration.Migrate{
 		1742025361: setupInitialSchemas(),
 		1753808918: addTierField(),
+		1792314000: addServiceCredentials(),
+		1792317600: addAuditEvents(),
+		1792321200: addSecurityLTPHistory(),
+		1792324800: addOutboxEvents(),
+		1792328400: addAlerts(),
+		1792332000: addWatchlists(),
+		1792335600: addPortfolios(),
+		1792339200: addBacktests(),
+		1792342800: addIndustryHierarchy(),
+		1792346400: addMarketIndices(),
+		1792350000: addSecurityFundamentals(),
+		1792353600: addSecurityTradability(),
+		1792357200: addSecurityInstrumentType(),
+		1792360800: addAlertRuleIndex(),
+		1792364400: makeSecurityISINNullable(),
 	}
 }
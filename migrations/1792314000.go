@@ -0,0 +1,32 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+func addServiceCredentials() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`CREATE TABLE service_credentials (
+										id INT PRIMARY KEY AUTO_INCREMENT,
+										name VARCHAR(100) NOT NULL,
+										key_prefix VARCHAR(20) NOT NULL,
+										key_hash CHAR(64) NOT NULL,
+										previous_key_hash CHAR(64) NULL,
+										previous_key_expires_at TIMESTAMP NULL,
+										scopes VARCHAR(500) NOT NULL,
+										last_used_at TIMESTAMP NULL,
+										revoked_at TIMESTAMP NULL,
+										created_at TIMESTAMP NOT NULL,
+										updated_at TIMESTAMP NOT NULL,
+
+										CONSTRAINT uk_service_credentials_name UNIQUE (name),
+										CONSTRAINT uk_service_credentials_key_hash UNIQUE (key_hash),
+										INDEX idx_service_credentials_previous_key_hash (previous_key_hash)
+									);`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
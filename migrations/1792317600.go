@@ -0,0 +1,30 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+func addAuditEvents() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`CREATE TABLE audit_events (
+										id BIGINT PRIMARY KEY AUTO_INCREMENT,
+										actor VARCHAR(100) NOT NULL,
+										actor_type VARCHAR(20) NOT NULL,
+										entity VARCHAR(50) NOT NULL,
+										entity_id INT NOT NULL,
+										action VARCHAR(20) NOT NULL,
+										source VARCHAR(20) NOT NULL,
+										changes JSON NOT NULL,
+										created_at TIMESTAMP NOT NULL,
+
+										INDEX idx_audit_events_entity_entity_id (entity, entity_id),
+										INDEX idx_audit_events_actor (actor),
+										INDEX idx_audit_events_created_at (created_at)
+									);`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,24 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+func addSecurityLTPHistory() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`CREATE TABLE security_ltp_history (
+										id BIGINT PRIMARY KEY AUTO_INCREMENT,
+										security_id INT NOT NULL,
+										ltp DECIMAL(10,2) NOT NULL,
+										recorded_at TIMESTAMP NOT NULL,
+
+										CONSTRAINT fk_security_ltp_history_security_id FOREIGN KEY (security_id) REFERENCES securities(id),
+										INDEX idx_security_ltp_history_security_id_recorded_at (security_id, recorded_at)
+									);`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
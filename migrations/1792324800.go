@@ -0,0 +1,27 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+func addOutboxEvents() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`CREATE TABLE outbox_events (
+										id BIGINT PRIMARY KEY AUTO_INCREMENT,
+										event_id VARCHAR(64) NOT NULL UNIQUE,
+										topic VARCHAR(100) NOT NULL,
+										payload TEXT NOT NULL,
+										attempts INT NOT NULL DEFAULT 0,
+										last_error TEXT,
+										published_at TIMESTAMP NULL,
+										created_at TIMESTAMP NOT NULL,
+
+										INDEX idx_outbox_events_published_at_id (published_at, id)
+									);`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
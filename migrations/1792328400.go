@@ -0,0 +1,59 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+func addAlerts() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`CREATE TABLE alert_rules (
+										id INT PRIMARY KEY AUTO_INCREMENT,
+										user_id INT NOT NULL,
+										name VARCHAR(100) NOT NULL,
+										security_id INT,
+										field VARCHAR(20) NOT NULL,
+										metric_id INT,
+										operator VARCHAR(20) NOT NULL,
+										threshold DECIMAL(18,4) NOT NULL,
+										webhook_url VARCHAR(500) NOT NULL,
+										webhook_secret VARCHAR(100) NOT NULL,
+										active BOOLEAN NOT NULL DEFAULT TRUE,
+										last_triggered_at TIMESTAMP NULL,
+										created_at TIMESTAMP NOT NULL,
+										updated_at TIMESTAMP NOT NULL,
+
+										CONSTRAINT fk_alert_rules_security_id FOREIGN KEY (security_id) REFERENCES securities(id),
+										CONSTRAINT fk_alert_rules_metric_id FOREIGN KEY (metric_id) REFERENCES metrics(id),
+										INDEX idx_alert_rules_user_id (user_id),
+										INDEX idx_alert_rules_field_metric_id (field, metric_id, active)
+									);`)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(`CREATE TABLE alert_deliveries (
+										id BIGINT PRIMARY KEY AUTO_INCREMENT,
+										alert_rule_id INT NOT NULL,
+										security_id INT NOT NULL,
+										date DATE NOT NULL,
+										value DECIMAL(18,4) NOT NULL,
+										payload TEXT NOT NULL,
+										status VARCHAR(20) NOT NULL,
+										attempts INT NOT NULL DEFAULT 0,
+										last_error TEXT,
+										next_attempt_at TIMESTAMP NOT NULL,
+										delivered_at TIMESTAMP NULL,
+										created_at TIMESTAMP NOT NULL,
+
+										CONSTRAINT fk_alert_deliveries_alert_rule_id FOREIGN KEY (alert_rule_id) REFERENCES alert_rules(id) ON DELETE CASCADE,
+										CONSTRAINT fk_alert_deliveries_security_id FOREIGN KEY (security_id) REFERENCES securities(id),
+										UNIQUE KEY uk_alert_deliveries_rule_security_date (alert_rule_id, security_id, date),
+										INDEX idx_alert_deliveries_status_next_attempt_at (status, next_attempt_at)
+									);`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
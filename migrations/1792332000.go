@@ -0,0 +1,39 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+func addWatchlists() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`CREATE TABLE watchlists (
+										id INT PRIMARY KEY AUTO_INCREMENT,
+										user_id INT NOT NULL,
+										name VARCHAR(100) NOT NULL,
+										created_at TIMESTAMP NOT NULL,
+										updated_at TIMESTAMP NOT NULL,
+
+										UNIQUE KEY uk_watchlists_user_id_name (user_id, name)
+									);`)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(`CREATE TABLE watchlist_securities (
+										watchlist_id INT NOT NULL,
+										security_id INT NOT NULL,
+										position INT NOT NULL,
+										created_at TIMESTAMP NOT NULL,
+
+										PRIMARY KEY (watchlist_id, security_id),
+										CONSTRAINT fk_watchlist_securities_watchlist_id FOREIGN KEY (watchlist_id) REFERENCES watchlists(id) ON DELETE CASCADE,
+										CONSTRAINT fk_watchlist_securities_security_id FOREIGN KEY (security_id) REFERENCES securities(id),
+										INDEX idx_watchlist_securities_watchlist_id_position (watchlist_id, position)
+									);`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+func addPortfolios() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`CREATE TABLE portfolios (
+										id INT PRIMARY KEY AUTO_INCREMENT,
+										user_id INT NOT NULL,
+										name VARCHAR(100) NOT NULL,
+										created_at TIMESTAMP NOT NULL,
+										updated_at TIMESTAMP NOT NULL,
+
+										UNIQUE KEY uk_portfolios_user_id_name (user_id, name)
+									);`)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(`CREATE TABLE portfolio_transactions (
+										id BIGINT PRIMARY KEY AUTO_INCREMENT,
+										portfolio_id INT NOT NULL,
+										security_id INT NOT NULL,
+										type VARCHAR(10) NOT NULL,
+										quantity DECIMAL(18,4) NOT NULL,
+										price DECIMAL(18,4) NOT NULL,
+										date DATE NOT NULL,
+										created_at TIMESTAMP NOT NULL,
+
+										CONSTRAINT fk_portfolio_transactions_portfolio_id FOREIGN KEY (portfolio_id) REFERENCES portfolios(id) ON DELETE CASCADE,
+										CONSTRAINT fk_portfolio_transactions_security_id FOREIGN KEY (security_id) REFERENCES securities(id),
+										INDEX idx_portfolio_transactions_portfolio_id_date (portfolio_id, date)
+									);`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+func addBacktests() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`CREATE TABLE backtests (
+										id INT PRIMARY KEY AUTO_INCREMENT,
+										user_id INT NOT NULL,
+										name VARCHAR(100) NOT NULL,
+										strategy TEXT NOT NULL,
+										max_tier INT,
+										status VARCHAR(20) NOT NULL,
+										result LONGTEXT,
+										error TEXT,
+										started_at TIMESTAMP NULL,
+										completed_at TIMESTAMP NULL,
+										created_at TIMESTAMP NOT NULL,
+
+										INDEX idx_backtests_user_id (user_id),
+										INDEX idx_backtests_status_created_at (status, created_at)
+									);`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,88 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+func addIndustryHierarchy() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`CREATE TABLE industries (
+										id INT PRIMARY KEY AUTO_INCREMENT,
+										name VARCHAR(100) NOT NULL,
+										level VARCHAR(20) NOT NULL,
+										parent_id INT NULL,
+										created_at TIMESTAMP NOT NULL,
+										updated_at TIMESTAMP NOT NULL,
+
+										CONSTRAINT uk_industries_name UNIQUE (name),
+										CONSTRAINT fk_industries_parent_id FOREIGN KEY (parent_id) REFERENCES industries(id),
+										INDEX idx_industries_level (level)
+									);`)
+			if err != nil {
+				return err
+			}
+
+			// ids follow the order of the former stores.Industry enum, offset by one, so securities.industry maps onto them directly.
+			_, err = d.SQL.Exec(`INSERT INTO industries (id, name, level, parent_id, created_at, updated_at) VALUES
+										(1, 'Automobile and Auto Components', 'sector', NULL, NOW(), NOW()),
+										(2, 'Capital Goods', 'sector', NULL, NOW(), NOW()),
+										(3, 'Chemicals', 'sector', NULL, NOW(), NOW()),
+										(4, 'Construction', 'sector', NULL, NOW(), NOW()),
+										(5, 'Construction Materials', 'sector', NULL, NOW(), NOW()),
+										(6, 'Consumer Durables', 'sector', NULL, NOW(), NOW()),
+										(7, 'Consumer Services', 'sector', NULL, NOW(), NOW()),
+										(8, 'Diversified', 'sector', NULL, NOW(), NOW()),
+										(9, 'Fast Moving Consumer Goods', 'sector', NULL, NOW(), NOW()),
+										(10, 'Financial Services', 'sector', NULL, NOW(), NOW()),
+										(11, 'Forest Materials', 'sector', NULL, NOW(), NOW()),
+										(12, 'Healthcare', 'sector', NULL, NOW(), NOW()),
+										(13, 'Information Technology', 'sector', NULL, NOW(), NOW()),
+										(14, 'Media Entertainment & Publication', 'sector', NULL, NOW(), NOW()),
+										(15, 'Metals & Mining', 'sector', NULL, NOW(), NOW()),
+										(16, 'Oil Gas & Consumable Fuels', 'sector', NULL, NOW(), NOW()),
+										(17, 'Power', 'sector', NULL, NOW(), NOW()),
+										(18, 'Realty', 'sector', NULL, NOW(), NOW()),
+										(19, 'Services', 'sector', NULL, NOW(), NOW()),
+										(20, 'Telecommunication', 'sector', NULL, NOW(), NOW()),
+										(21, 'Textiles', 'sector', NULL, NOW(), NOW()),
+										(22, 'Index', 'sector', NULL, NOW(), NOW()),
+										(23, 'Bond', 'sector', NULL, NOW(), NOW()),
+										(24, 'Gold', 'sector', NULL, NOW(), NOW()),
+										(25, 'Silver', 'sector', NULL, NOW(), NOW()),
+										(26, 'Utilities', 'sector', NULL, NOW(), NOW());`)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(`CREATE TABLE security_industries (
+										id INT PRIMARY KEY AUTO_INCREMENT,
+										security_id INT NOT NULL,
+										industry_id INT NOT NULL,
+										effective_from DATE NOT NULL,
+										effective_to DATE NULL,
+										created_at TIMESTAMP NOT NULL,
+										updated_at TIMESTAMP NOT NULL,
+
+										CONSTRAINT uk_security_industries_security_id_effective_from UNIQUE (security_id, effective_from),
+										CONSTRAINT fk_security_industries_security_id FOREIGN KEY (security_id) REFERENCES securities(id),
+										CONSTRAINT fk_security_industries_industry_id FOREIGN KEY (industry_id) REFERENCES industries(id),
+										INDEX idx_security_industries_industry_id_effective_from (industry_id, effective_from)
+									);`)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(`INSERT INTO security_industries (security_id, industry_id, effective_from, effective_to, created_at, updated_at)
+									SELECT id, industry + 1, '1970-01-01', NULL, NOW(), NOW() FROM securities;`)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(`ALTER TABLE securities DROP COLUMN industry;`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+func addMarketIndices() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`CREATE TABLE market_indices (
+										id INT PRIMARY KEY AUTO_INCREMENT,
+										name VARCHAR(100) NOT NULL,
+										symbol VARCHAR(50) NOT NULL,
+										created_at TIMESTAMP NOT NULL,
+										updated_at TIMESTAMP NOT NULL,
+
+										CONSTRAINT uk_market_indices_name UNIQUE (name),
+										CONSTRAINT uk_market_indices_symbol UNIQUE (symbol)
+									);`)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(`CREATE TABLE index_constituents (
+										id INT PRIMARY KEY AUTO_INCREMENT,
+										index_id INT NOT NULL,
+										security_id INT NOT NULL,
+										weight DECIMAL(9,6) NULL,
+										effective_from DATE NOT NULL,
+										effective_to DATE NULL,
+										created_at TIMESTAMP NOT NULL,
+
+										CONSTRAINT uk_index_constituents_index_id_security_id_effective_from UNIQUE (index_id, security_id, effective_from),
+										CONSTRAINT fk_index_constituents_index_id FOREIGN KEY (index_id) REFERENCES market_indices(id) ON DELETE CASCADE,
+										CONSTRAINT fk_index_constituents_security_id FOREIGN KEY (security_id) REFERENCES securities(id),
+										INDEX idx_index_constituents_index_id_effective_from (index_id, effective_from),
+										INDEX idx_index_constituents_security_id (security_id)
+									);`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+func addSecurityFundamentals() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`CREATE TABLE security_fundamentals (
+										id INT PRIMARY KEY AUTO_INCREMENT,
+										security_id INT NOT NULL,
+										period_end DATE NOT NULL,
+										reported_at DATE NULL,
+										shares_outstanding BIGINT NOT NULL,
+										revenue DECIMAL(20,2) NULL,
+										net_income DECIMAL(20,2) NULL,
+										eps DECIMAL(14,4) NULL,
+										book_value DECIMAL(20,2) NULL,
+										dividend_per_share DECIMAL(14,4) NULL,
+										created_at TIMESTAMP NOT NULL,
+										updated_at TIMESTAMP NOT NULL,
+
+										CONSTRAINT uk_security_fundamentals_security_id_period_end UNIQUE (security_id, period_end),
+										CONSTRAINT fk_security_fundamentals_security_id FOREIGN KEY (security_id) REFERENCES securities(id)
+									);`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
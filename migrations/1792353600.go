@@ -0,0 +1,41 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+func addSecurityTradability() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`ALTER TABLE securities
+										ADD COLUMN lot_size INT NULL,
+										ADD COLUMN tick_size DECIMAL(10,4) NULL,
+										ADD COLUMN surveillance_flag VARCHAR(5) NULL,
+										ADD COLUMN surveillance_category VARCHAR(20) NULL,
+										ADD COLUMN mtf_leverage DECIMAL(10,6) NULL,
+										ADD INDEX idx_securities_surveillance_flag (surveillance_flag);`)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(`CREATE TABLE security_tradability (
+										id INT PRIMARY KEY AUTO_INCREMENT,
+										security_id INT NOT NULL,
+										lot_size INT NOT NULL,
+										tick_size DECIMAL(10,4) NOT NULL,
+										surveillance_flag VARCHAR(5) NOT NULL,
+										surveillance_category VARCHAR(20) NULL,
+										mtf_leverage DECIMAL(10,6) NOT NULL,
+										effective_from DATE NOT NULL,
+										effective_to DATE NULL,
+										created_at TIMESTAMP NOT NULL,
+
+										CONSTRAINT uk_security_tradability_security_id_effective_from UNIQUE (security_id, effective_from),
+										CONSTRAINT fk_security_tradability_security_id FOREIGN KEY (security_id) REFERENCES securities(id)
+									);`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
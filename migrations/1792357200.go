@@ -0,0 +1,32 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+func addSecurityInstrumentType() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`ALTER TABLE securities
+										ADD COLUMN instrument_type INT NOT NULL DEFAULT 0,
+										ADD INDEX idx_securities_instrument_type (instrument_type);`)
+			if err != nil {
+				return err
+			}
+
+			// securities in the Index, Bond, Gold and Silver industries are exchange traded funds over that asset, values follow the
+			// stores.InstrumentType enum.
+			_, err = d.SQL.Exec(`UPDATE securities
+										JOIN security_industries ON security_industries.security_id = securities.id
+											AND security_industries.effective_to IS NULL
+										JOIN industries ON industries.id = security_industries.industry_id
+									SET securities.instrument_type = CASE industries.name
+										WHEN 'Index' THEN 1
+										WHEN 'Bond' THEN 3
+										WHEN 'Gold' THEN 4
+										WHEN 'Silver' THEN 5
+									END
+									WHERE industries.name IN ('Index', 'Bond', 'Gold', 'Silver');`)
+
+			return err
+		},
+	}
+}
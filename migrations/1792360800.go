@@ -0,0 +1,15 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+func addAlertRuleIndex() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`ALTER TABLE alert_rules
+										ADD COLUMN index_id INT NULL AFTER security_id,
+										ADD CONSTRAINT fk_alert_rules_index_id FOREIGN KEY (index_id) REFERENCES market_indices(id);`)
+
+			return err
+		},
+	}
+}
@@ -0,0 +1,19 @@
+package migrations
+
+import "gofr.dev/pkg/gofr/migration"
+
+func makeSecurityISINNullable() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`ALTER TABLE securities MODIFY COLUMN isin VARCHAR(50) NULL;`)
+			if err != nil {
+				return err
+			}
+
+			// indices have no ISIN, they were loaded with their symbol in its place, instrument_type 2 is stores.Index.
+			_, err = d.SQL.Exec(`UPDATE securities SET isin = NULL WHERE instrument_type = 2;`)
+
+			return err
+		},
+	}
+}